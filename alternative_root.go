@@ -0,0 +1,56 @@
+package hqgourl
+
+import "github.com/hueristiq/hqgourl/tlds"
+
+// AlternativeRoot is a naming system with TLDs outside of the ICANN DNS root, e.g. a blockchain
+// naming system or an alternative DNS root. Domains under its TLDs parse with its Namespace, so
+// that they are never mistaken for ICANN DNS domains.
+type AlternativeRoot struct {
+	Namespace DomainNamespace
+	TLDs      []string
+}
+
+var (
+	// AlternativeRootENS is the Ethereum Name Service, ".eth".
+	AlternativeRootENS = AlternativeRoot{
+		Namespace: DomainNamespaceENS,
+		TLDs:      tlds.ENSTLDs,
+	}
+	// AlternativeRootHandshake is Handshake, whose TLDs are auctioned on its blockchain, with no
+	// fixed list. It has no TLDs: extend it with the Handshake TLDs seen in your data.
+	AlternativeRootHandshake = AlternativeRoot{
+		Namespace: DomainNamespaceHandshake,
+	}
+	// AlternativeRootOpenNIC is the OpenNIC alternative DNS root, e.g. ".geek" or ".oss".
+	AlternativeRootOpenNIC = AlternativeRoot{
+		Namespace: DomainNamespaceOpenNIC,
+		TLDs:      tlds.OpenNICTLDs,
+	}
+	// AlternativeRootUnstoppableDomains is Unstoppable Domains, e.g. ".crypto" or ".nft".
+	AlternativeRootUnstoppableDomains = AlternativeRoot{
+		Namespace: DomainNamespaceUnstoppableDomains,
+		TLDs:      tlds.UnstoppableDomainsTLDs,
+	}
+)
+
+// DomainParserWithAlternativeRoots returns a DomainParserOptionsFunc to recognize the TLDs of
+// alternative roots, e.g. AlternativeRootENS, or AlternativeRootOpenNIC extended with more of its
+// TLDs. Parsed domains under these TLDs, and these TLDs themselves, have the root's namespace as
+// their TopLevelNamespace. Alternative-root TLDs take precedence over ICANN TLDs of the same name.
+// Use URLExtractorWithAlternativeRoots with the same roots for extraction to agree with parsing.
+func DomainParserWithAlternativeRoots(roots ...AlternativeRoot) DomainParserOptionsFunc {
+	return func(dp *DomainParser) {
+		dp.withAlternativeRoots = append(dp.withAlternativeRoots, roots...)
+	}
+}
+
+// URLExtractorWithAlternativeRoots returns an option function to match domains with the TLDs of
+// alternative roots in addition to the default ones. It is the extraction counterpart of
+// DomainParserWithAlternativeRoots.
+func URLExtractorWithAlternativeRoots(roots ...AlternativeRoot) URLExtractorOptionsFunc {
+	return func(e *URLExtractor) {
+		for _, root := range roots {
+			e.withAdditionalTLDs = append(e.withAdditionalTLDs, root.TLDs...)
+		}
+	}
+}
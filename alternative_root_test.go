@@ -0,0 +1,137 @@
+package hqgourl_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hueristiq/hqgourl"
+)
+
+func TestDomainParsingWithAlternativeRoots(t *testing.T) {
+	t.Parallel()
+
+	openNIC := hqgourl.AlternativeRoot{
+		Namespace: hqgourl.DomainNamespaceOpenNIC,
+		TLDs:      []string{"fur"},
+	}
+
+	handshake := hqgourl.AlternativeRootHandshake
+	handshake.TLDs = append(handshake.TLDs, "forever")
+
+	cases := []struct {
+		rawDomain         string
+		expectedTopLevel  string
+		expectedNamespace hqgourl.DomainNamespace
+	}{
+		{"app.vitalik.eth", "eth", hqgourl.DomainNamespaceENS},
+		{"www.example.geek", "geek", hqgourl.DomainNamespaceOpenNIC},
+		{"brad.crypto", "crypto", hqgourl.DomainNamespaceUnstoppableDomains},
+		{"www.example.fur", "fur", hqgourl.DomainNamespaceOpenNIC},
+		{"welcome.forever", "forever", hqgourl.DomainNamespaceHandshake},
+		{"www.example.com", "com", hqgourl.DomainNamespaceSpecialUse},
+		{"www.google.com", "com", hqgourl.DomainNamespacePublic},
+	}
+
+	dp := hqgourl.NewDomainParser(
+		hqgourl.DomainParserWithAlternativeRoots(
+			hqgourl.AlternativeRootENS,
+			hqgourl.AlternativeRootOpenNIC,
+			hqgourl.AlternativeRootUnstoppableDomains,
+			openNIC,
+			handshake,
+		),
+	)
+
+	for _, c := range cases {
+		c := c
+
+		t.Run(fmt.Sprintf("Parse(%q)", c.rawDomain), func(t *testing.T) {
+			t.Parallel()
+
+			parsedDomain, err := dp.Parse(c.rawDomain)
+			if err != nil {
+				t.Fatalf("Parse(%q) error = %v", c.rawDomain, err)
+			}
+
+			if parsedDomain.TopLevel != c.expectedTopLevel || !parsedDomain.TopLevelKnown {
+				t.Errorf("Parse(%q).TopLevel = %q (known %v), want %q (known)", c.rawDomain, parsedDomain.TopLevel, parsedDomain.TopLevelKnown, c.expectedTopLevel)
+			}
+
+			if namespace := parsedDomain.Namespace(); namespace != c.expectedNamespace {
+				t.Errorf("Parse(%q).Namespace() = %s, want %s", c.rawDomain, namespace, c.expectedNamespace)
+			}
+		})
+	}
+
+	// Alternative-root TLDs themselves are in the root's namespace too.
+	for _, rawDomain := range []string{"eth", "eth.", "vitalik.eth."} {
+		parsedDomain, err := dp.Parse(rawDomain)
+		if err != nil {
+			t.Fatalf("Parse(%q) error = %v", rawDomain, err)
+		}
+
+		if !parsedDomain.TopLevelKnown || parsedDomain.Namespace() != hqgourl.DomainNamespaceENS {
+			t.Errorf("Parse(%q) = %+v, namespace %s, want %s", rawDomain, parsedDomain, parsedDomain.Namespace(), hqgourl.DomainNamespaceENS)
+		}
+	}
+
+	// Without the option, alternative-root TLDs are unknown.
+	parsedDomain, err := hqgourl.NewDomainParser().Parse("app.vitalik.eth")
+	if err != nil {
+		t.Fatalf("Parse(%q) error = %v", "app.vitalik.eth", err)
+	}
+
+	if parsedDomain.TopLevelKnown || parsedDomain.Namespace() != hqgourl.DomainNamespaceUnknown {
+		t.Errorf("Parse(%q) without alternative roots = %+v, want an unknown TLD", "app.vitalik.eth", parsedDomain)
+	}
+}
+
+func TestURLExtractionWithAlternativeRoots(t *testing.T) {
+	t.Parallel()
+
+	extractor := hqgourl.NewURLExtractor(
+		hqgourl.URLExtractorWithHost(),
+		hqgourl.URLExtractorWithAlternativeRoots(hqgourl.AlternativeRootENS),
+	)
+
+	regex := extractor.CompileRegex()
+
+	text := `
+	https://app.vitalik.eth/path
+	app.opennic.geek
+	`
+
+	want := []string{
+		"https://app.vitalik.eth/path",
+	}
+
+	got := regex.FindAllString(text, -1)
+
+	if !equalSlices(got, want) {
+		t.Errorf("Extracted URLs = %v, want %v", got, want)
+	}
+}
+
+func TestAlternativeRootHandshake(t *testing.T) {
+	t.Parallel()
+
+	if len(hqgourl.AlternativeRootHandshake.TLDs) != 0 {
+		t.Errorf("AlternativeRootHandshake.TLDs = %v, want none", hqgourl.AlternativeRootHandshake.TLDs)
+	}
+
+	// Without TLDs, the root recognizes no domain.
+	parsedDomain, err := hqgourl.NewDomainParser(
+		hqgourl.DomainParserWithAlternativeRoots(hqgourl.AlternativeRootHandshake),
+	).Parse("welcome.forever")
+	if err != nil {
+		t.Fatalf("Parse(%q) error = %v", "welcome.forever", err)
+	}
+
+	if parsedDomain.TopLevelKnown || parsedDomain.Namespace() != hqgourl.DomainNamespaceUnknown {
+		t.Errorf("Parse(%q) = %+v, want an unknown TLD", "welcome.forever", parsedDomain)
+	}
+
+	if namespace := hqgourl.DomainNamespaceHandshake.String(); namespace != "handshake" {
+		t.Errorf("DomainNamespaceHandshake.String() = %q, want %q", namespace, "handshake")
+	}
+}
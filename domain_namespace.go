@@ -0,0 +1,169 @@
+package hqgourl
+
+import "strings"
+
+// DomainNamespace identifies the namespace a domain belongs to, i.e. how, if at all, it resolves.
+type DomainNamespace int
+
+const (
+	// DomainNamespaceUnknown is for domains under a suffix that is neither in the public suffix list
+	// nor a known special-use or alternative namespace, e.g. "intranet.corp". They are not resolvable
+	// on the internet, but may be on a private network.
+	DomainNamespaceUnknown DomainNamespace = iota
+	// DomainNamespacePublic is for domains under a suffix of the public suffix list, resolvable in the
+	// public DNS.
+	DomainNamespacePublic
+	// DomainNamespaceSpecialUse is for the special-use domains of RFC 6761 and later RFCs, e.g.
+	// "example.com", "test", "localhost", "invalid", "home.arpa" or the reverse zones of the private
+	// IPv4 ranges, e.g. "10.in-addr.arpa", which are never delegated in the public DNS.
+	DomainNamespaceSpecialUse
+	// DomainNamespaceMDNS is for ".local" domains and the link-local reverse zones, e.g.
+	// "254.169.in-addr.arpa", resolved with multicast DNS (RFC 6762).
+	DomainNamespaceMDNS
+	// DomainNamespaceTor is for ".onion" (RFC 7686) and ".exit" domains, resolved by Tor.
+	DomainNamespaceTor
+	// DomainNamespaceI2P is for ".i2p" domains, resolved by I2P.
+	DomainNamespaceI2P
+	// DomainNamespaceNamecoin is for ".bit" domains, resolved with the Namecoin blockchain.
+	DomainNamespaceNamecoin
+	// DomainNamespaceGNS is for ".gnu" and ".zkey" domains, resolved by the GNU Name System.
+	DomainNamespaceGNS
+	// DomainNamespaceENS is for Ethereum Name Service domains, e.g. ".eth", recognized with
+	// DomainParserWithAlternativeRoots and AlternativeRootENS.
+	DomainNamespaceENS
+	// DomainNamespaceHandshake is for Handshake domains, recognized with DomainParserWithAlternativeRoots
+	// and AlternativeRootHandshake, extended with the Handshake TLDs seen in your data.
+	DomainNamespaceHandshake
+	// DomainNamespaceOpenNIC is for OpenNIC domains, e.g. ".geek" or ".oss", recognized with
+	// DomainParserWithAlternativeRoots and AlternativeRootOpenNIC.
+	DomainNamespaceOpenNIC
+	// DomainNamespaceUnstoppableDomains is for Unstoppable Domains domains, e.g. ".crypto" or ".nft",
+	// recognized with DomainParserWithAlternativeRoots and AlternativeRootUnstoppableDomains.
+	DomainNamespaceUnstoppableDomains
+)
+
+// String returns the name of the namespace.
+func (n DomainNamespace) String() (namespace string) {
+	switch n {
+	case DomainNamespaceUnknown:
+		namespace = "unknown"
+	case DomainNamespacePublic:
+		namespace = "public"
+	case DomainNamespaceSpecialUse:
+		namespace = "special-use"
+	case DomainNamespaceMDNS:
+		namespace = "mdns"
+	case DomainNamespaceTor:
+		namespace = "tor"
+	case DomainNamespaceI2P:
+		namespace = "i2p"
+	case DomainNamespaceNamecoin:
+		namespace = "namecoin"
+	case DomainNamespaceGNS:
+		namespace = "gns"
+	case DomainNamespaceENS:
+		namespace = "ens"
+	case DomainNamespaceHandshake:
+		namespace = "handshake"
+	case DomainNamespaceOpenNIC:
+		namespace = "opennic"
+	case DomainNamespaceUnstoppableDomains:
+		namespace = "unstoppable-domains"
+	}
+
+	return
+}
+
+// domainNamespaces maps the suffixes of the special-use and alternative namespaces to these
+// namespaces. Domains under other suffixes are public or unknown, depending on their TLD section.
+var domainNamespaces = map[string]DomainNamespace{
+	// https://www.iana.org/assignments/special-use-domain-names/special-use-domain-names.xhtml
+	"6tisch.arpa":              DomainNamespaceSpecialUse,
+	"10.in-addr.arpa":          DomainNamespaceSpecialUse,
+	"16.172.in-addr.arpa":      DomainNamespaceSpecialUse,
+	"17.172.in-addr.arpa":      DomainNamespaceSpecialUse,
+	"18.172.in-addr.arpa":      DomainNamespaceSpecialUse,
+	"19.172.in-addr.arpa":      DomainNamespaceSpecialUse,
+	"20.172.in-addr.arpa":      DomainNamespaceSpecialUse,
+	"21.172.in-addr.arpa":      DomainNamespaceSpecialUse,
+	"22.172.in-addr.arpa":      DomainNamespaceSpecialUse,
+	"23.172.in-addr.arpa":      DomainNamespaceSpecialUse,
+	"24.172.in-addr.arpa":      DomainNamespaceSpecialUse,
+	"25.172.in-addr.arpa":      DomainNamespaceSpecialUse,
+	"26.172.in-addr.arpa":      DomainNamespaceSpecialUse,
+	"27.172.in-addr.arpa":      DomainNamespaceSpecialUse,
+	"28.172.in-addr.arpa":      DomainNamespaceSpecialUse,
+	"29.172.in-addr.arpa":      DomainNamespaceSpecialUse,
+	"30.172.in-addr.arpa":      DomainNamespaceSpecialUse,
+	"31.172.in-addr.arpa":      DomainNamespaceSpecialUse,
+	"168.192.in-addr.arpa":     DomainNamespaceSpecialUse,
+	"170.0.0.192.in-addr.arpa": DomainNamespaceSpecialUse,
+	"171.0.0.192.in-addr.arpa": DomainNamespaceSpecialUse,
+	"254.169.in-addr.arpa":     DomainNamespaceMDNS,
+	"8.e.f.ip6.arpa":           DomainNamespaceMDNS,
+	"9.e.f.ip6.arpa":           DomainNamespaceMDNS,
+	"a.e.f.ip6.arpa":           DomainNamespaceMDNS,
+	"b.e.f.ip6.arpa":           DomainNamespaceMDNS,
+	"alt":                      DomainNamespaceSpecialUse,
+	"eap-noob.arpa":            DomainNamespaceSpecialUse,
+	"example":                  DomainNamespaceSpecialUse,
+	"example.com":              DomainNamespaceSpecialUse,
+	"example.net":              DomainNamespaceSpecialUse,
+	"example.org":              DomainNamespaceSpecialUse,
+	"home.arpa":                DomainNamespaceSpecialUse,
+	"invalid":                  DomainNamespaceSpecialUse,
+	"ipv4only.arpa":            DomainNamespaceSpecialUse,
+	"local":                    DomainNamespaceMDNS,
+	"localhost":                DomainNamespaceSpecialUse,
+	"onion":                    DomainNamespaceTor,
+	"resolver.arpa":            DomainNamespaceSpecialUse,
+	"service.arpa":             DomainNamespaceSpecialUse,
+	"test":                     DomainNamespaceSpecialUse,
+	// https://en.wikipedia.org/wiki/Pseudo-top-level_domain
+	"exit": DomainNamespaceTor,
+	"i2p":  DomainNamespaceI2P,
+	"bit":  DomainNamespaceNamecoin,
+	"gnu":  DomainNamespaceGNS,
+	"zkey": DomainNamespaceGNS,
+}
+
+// domainNamespacesMaxLabels is the number of labels of the longest suffix in domainNamespaces.
+var domainNamespacesMaxLabels = func() (maxLabels int) {
+	for suffix := range domainNamespaces {
+		if labels := strings.Count(suffix, ".") + 1; labels > maxLabels {
+			maxLabels = labels
+		}
+	}
+
+	return
+}()
+
+// Namespace classifies the domain, to tell domains resolvable in the public DNS from special-use
+// domains and domains of alternative, non-DNS, namespaces, e.g. "www.example.com" is special-use,
+// "example.onion" is Tor and "www.google.com" is public. Domains under a TLD of neither the ICANN
+// nor the private section of the public suffix list are unknown, unless special-use or alternative.
+// Domains under an alternative-root TLD, as matched with DomainParserWithAlternativeRoots, are in
+// the namespace of that root, their TopLevelNamespace.
+func (d *Domain) Namespace() (namespace DomainNamespace) {
+	if d.TopLevelNamespace != DomainNamespaceUnknown {
+		return d.TopLevelNamespace
+	}
+
+	labels := strings.Split(normalizeDomain(d.String()), ".")
+
+	switch d.TopLevelSection {
+	case TLDSectionICANN, TLDSectionPrivate:
+		namespace = DomainNamespacePublic
+	case TLDSectionNone:
+		namespace = DomainNamespaceUnknown
+	}
+
+	// The longest matching suffix wins, e.g. "example.com" over "com".
+	for i := len(labels) - 1; i >= 0 && i >= len(labels)-domainNamespacesMaxLabels; i-- {
+		if suffixNamespace, ok := domainNamespaces[strings.Join(labels[i:], ".")]; ok {
+			namespace = suffixNamespace
+		}
+	}
+
+	return
+}
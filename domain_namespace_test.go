@@ -0,0 +1,60 @@
+package hqgourl_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hueristiq/hqgourl"
+)
+
+func TestDomain_Namespace(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		rawDomain         string
+		expectedNamespace hqgourl.DomainNamespace
+	}{
+		{"www.google.com", hqgourl.DomainNamespacePublic},
+		{"www.example.co.uk", hqgourl.DomainNamespacePublic},
+		{"www.example.com", hqgourl.DomainNamespaceSpecialUse},
+		{"notexample.com", hqgourl.DomainNamespacePublic},
+		{"app.test", hqgourl.DomainNamespaceSpecialUse},
+		{"localhost", hqgourl.DomainNamespaceSpecialUse},
+		{"router.home.arpa", hqgourl.DomainNamespaceSpecialUse},
+		{"ipv4only.arpa", hqgourl.DomainNamespaceSpecialUse},
+		{"_dns.resolver.arpa", hqgourl.DomainNamespaceSpecialUse},
+		{"printer.service.arpa", hqgourl.DomainNamespaceSpecialUse},
+		{"1.0.0.10.in-addr.arpa", hqgourl.DomainNamespaceSpecialUse},
+		{"1.0.31.172.in-addr.arpa", hqgourl.DomainNamespaceSpecialUse},
+		{"1.0.32.172.in-addr.arpa", hqgourl.DomainNamespacePublic},
+		{"170.0.0.192.in-addr.arpa", hqgourl.DomainNamespaceSpecialUse},
+		{"1.0.254.169.in-addr.arpa", hqgourl.DomainNamespaceMDNS},
+		{"1.0.0.0.8.e.f.ip6.arpa", hqgourl.DomainNamespaceMDNS},
+		{"printer.LOCAL", hqgourl.DomainNamespaceMDNS},
+		{"duckduckgogg42xjoc72x3sjasowoarfbgcmvfimaftt6twagswzczad.onion", hqgourl.DomainNamespaceTor},
+		{"www.example.com.0123456789abcdef.exit", hqgourl.DomainNamespaceTor},
+		{"forum.i2p", hqgourl.DomainNamespaceI2P},
+		{"example.bit", hqgourl.DomainNamespaceNamecoin},
+		{"example.gnu", hqgourl.DomainNamespaceGNS},
+		{"intranet.corp", hqgourl.DomainNamespaceUnknown},
+	}
+
+	dp := hqgourl.NewDomainParser()
+
+	for _, c := range cases {
+		c := c
+
+		t.Run(fmt.Sprintf("Parse(%q).Namespace()", c.rawDomain), func(t *testing.T) {
+			t.Parallel()
+
+			parsedDomain, err := dp.Parse(c.rawDomain)
+			if err != nil {
+				t.Fatalf("Parse(%q) error = %v", c.rawDomain, err)
+			}
+
+			if namespace := parsedDomain.Namespace(); namespace != c.expectedNamespace {
+				t.Errorf("Parse(%q).Namespace() = %s, want %s", c.rawDomain, namespace, c.expectedNamespace)
+			}
+		})
+	}
+}
@@ -1,10 +1,15 @@
 package hqgourl
 
 import (
-	"index/suffixarray"
+	"errors"
+	"fmt"
+	"net/url"
 	"strings"
+	"sync/atomic"
+	"unicode/utf8"
 
 	"github.com/hueristiq/hqgourl/tlds"
+	"golang.org/x/net/idna"
 )
 
 // Domain struct represents the structure of a parsed domain name, including its subdomain, root domain, and top-level domain (TLD).
@@ -12,6 +17,38 @@ type Domain struct {
 	Sub      string
 	Root     string
 	TopLevel string
+
+	TopLevelSection TLDSection // Section of the public suffix list the TLD is listed in.
+	TopLevelKnown   bool       // Whether the TLD was matched by a suffix rule, rather than by the UnknownTLDPolicy.
+
+	TopLevelNamespace DomainNamespace // Namespace of an alternative-root TLD, see DomainParserWithAlternativeRoots.
+}
+
+// TLDSection identifies the section of the public suffix list a TLD is listed in.
+type TLDSection int
+
+const (
+	// TLDSectionNone is for TLDs not listed in the public suffix list: pseudo-TLDs, custom TLDs
+	// and unknown TLDs matched by the implicit "*" rule.
+	TLDSectionNone TLDSection = iota
+	// TLDSectionICANN is for TLDs listed in the ICANN domains section, e.g. "com" or "co.uk".
+	TLDSectionICANN
+	// TLDSectionPrivate is for TLDs listed in the private domains section, e.g. "github.io".
+	TLDSectionPrivate
+)
+
+// String returns the name of the section.
+func (s TLDSection) String() (section string) {
+	switch s {
+	case TLDSectionICANN:
+		section = "icann"
+	case TLDSectionPrivate:
+		section = "private"
+	case TLDSectionNone:
+		section = "none"
+	}
+
+	return
 }
 
 // String assembles the domain components back into a full domain string.
@@ -35,38 +72,230 @@ func (d *Domain) String() (domain string) {
 	return
 }
 
+// RegistrableDomain returns the registrable domain, the root domain with its TLD (eTLD+1), e.g.
+// "example.co.uk" for "www.example.co.uk". It returns an empty string if the domain has no TLD,
+// e.g. if the domain is itself a TLD.
+func (d *Domain) RegistrableDomain() (domain string) {
+	if d.Root == "" || d.TopLevel == "" {
+		return
+	}
+
+	domain = d.Root + "." + d.TopLevel
+
+	return
+}
+
+// TopLevelMetadata returns the metadata of the domain's TLD, e.g. its type and, for ccTLDs, its
+// country code, and whether it has any. See tlds.LookupMetadata. For eTLDs of several labels, e.g.
+// "co.uk", it is that of their last label, "uk".
+func (d *Domain) TopLevelMetadata() (metadata tlds.TLDMetadata, ok bool) {
+	if d.TopLevel == "" {
+		return
+	}
+
+	return tlds.LookupMetadata(d.TopLevel[strings.LastIndex(d.TopLevel, ".")+1:])
+}
+
+// SubLabels returns the labels of the subdomain, from left to right, e.g. ["a", "b"] for
+// "a.b.example.com". It returns nil if the domain has no subdomain.
+func (d *Domain) SubLabels() (labels []string) {
+	if d.Sub == "" {
+		return
+	}
+
+	labels = strings.Split(d.Sub, ".")
+
+	return
+}
+
+// SubDepth returns the number of labels of the subdomain, e.g. 2 for "a.b.example.com", 0 for
+// "example.com".
+func (d *Domain) SubDepth() (depth int) {
+	if d.Sub == "" {
+		return
+	}
+
+	depth = strings.Count(d.Sub, ".") + 1
+
+	return
+}
+
+// Parents calls yield with each parent domain, from the nearest up to the registrable domain, e.g.
+// "b.example.com" then "example.com" for "a.b.example.com", until yield returns false. Its signature
+// is that of an iter.Seq[string], so with Go 1.23 and later it can be ranged over:
+//
+//	for parent := range parsedDomain.Parents {
+//		...
+//	}
+func (d *Domain) Parents(yield func(parent string) bool) {
+	registrable := d.RegistrableDomain()
+
+	if registrable == "" {
+		return
+	}
+
+	labels := d.SubLabels()
+
+	for i := 1; i < len(labels); i++ {
+		if !yield(strings.Join(labels[i:], ".") + "." + registrable) {
+			return
+		}
+	}
+
+	if len(labels) > 0 {
+		yield(registrable)
+	}
+}
+
+// ToASCII returns a copy of the domain with each of its components converted to its ASCII form,
+// Punycode-encoded with the "xn--" prefix, e.g. "例子.公司.cn" has "xn--fsqu00a" as its ASCII root
+// domain and "xn--55qx5d.cn" as its ASCII TLD.
+func (d *Domain) ToASCII() (domain *Domain, err error) {
+	return d.convert(idnaProfile.ToASCII)
+}
+
+// ToUnicode returns a copy of the domain with each of its components converted to its Unicode form,
+// e.g. "xn--fsqu00a.xn--55qx5d.cn" has "例子" as its Unicode root domain and "公司.cn" as its Unicode TLD.
+func (d *Domain) ToUnicode() (domain *Domain, err error) {
+	return d.convert(idnaProfile.ToUnicode)
+}
+
+// convert returns a copy of the domain with each of its components converted by fn.
+func (d *Domain) convert(fn func(s string) (string, error)) (domain *Domain, err error) {
+	converted := *d
+
+	domain = &converted
+
+	for _, component := range []*string{&domain.Sub, &domain.Root, &domain.TopLevel} {
+		if *component == "" {
+			continue
+		}
+
+		if *component, err = fn(*component); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
 // DomainInterface defines a standard interface for any domain representation.
 type DomainInterface interface {
 	String() (domain string)
 }
 
 // DomainParser encapsulates the logic for parsing full domain strings into their constituent parts:
-// subdomains, root domains, and top-level domains (TLDs). It leverages a suffix array for efficient
-// search and extraction of these components from a full domain string.
+// subdomains, root domains, and top-level domains (TLDs). It leverages a SuffixMatcher, by default
+// a PublicSuffixList of suffixes keyed by their labels in reverse order, precomputed into the tlds
+// package, for efficient search and extraction of these components from a full domain string.
+//
+// A DomainParser is safe for concurrent use by multiple goroutines, including swapping its
+// SuffixMatcher with SetSuffixMatcher while parsing. DomainParsers with the default options, with
+// or without DomainParserWithPrivateTLDs, share a read-only PublicSuffixList of the built-in TLDs,
+// built once on first use, so creating them is cheap. The Domains it returns belong to the caller.
 type DomainParser struct {
-	sa *suffixarray.Index
+	matcher atomic.Pointer[SuffixMatcher]
+
+	withTLDs           []string
+	withCustomTLDs     bool
+	withPrivateTLDs    bool
+	withAdditionalTLDs []string
+	withoutTLDs        []string
+	unknownTLDPolicy   UnknownTLDPolicy
+
+	withAlternativeRoots []AlternativeRoot
+	alternativeRoots     map[string]DomainNamespace
+}
+
+// SetSuffixMatcher atomically replaces the DomainParser's SuffixMatcher, e.g. with a newly read
+// PublicSuffixList. Parses in flight complete with the previous matcher, later ones use the new
+// one. This lets long-running services pick up new TLDs without creating a new DomainParser.
+func (dp *DomainParser) SetSuffixMatcher(matcher SuffixMatcher) {
+	dp.matcher.Store(&matcher)
+}
+
+// Snapshot returns the snapshot of the public suffix list the DomainParser's SuffixMatcher is
+// built from, and whether it is built from one at all, e.g. tlds.PublicSuffixListSnapshot for the
+// built-in TLDs, or the hash of a list read at runtime with ReadPublicSuffixList. Custom TLDs,
+// built-in TLDs modified with additional, alternative-root or removed TLDs and SuffixMatchers
+// other than a PublicSuffixList have none.
+func (dp *DomainParser) Snapshot() (snapshot tlds.Snapshot, ok bool) {
+	if list, isList := (*dp.matcher.Load()).(*PublicSuffixList); isList {
+		snapshot, ok = list.Snapshot()
+	}
+
+	return
 }
 
 // Parse takes a full domain string and splits it into its constituent parts: subdomain,
-// root domain, and TLD. This method efficiently identifies the TLD using a suffix array
-// and separates the remaining parts of the domain accordingly.
-func (dp *DomainParser) Parse(domain string) (parsedDomain *Domain) {
+// root domain, and TLD. This method efficiently identifies the TLD using a suffix trie
+// and separates the remaining parts of the domain accordingly. Internationalized domains
+// are accepted both in their Unicode ("例子.公司.cn") and ASCII ("xn--fsqu00a.xn--55qx5d.cn")
+// forms, the components keeping the form of the input. Domains with a TLD matched by no
+// suffix rule are handled as per the DomainParser's UnknownTLDPolicy, which may return an
+// error wrapping ErrUnknownTLD. Fully qualified domains, with a trailing dot ("example.com."),
+// are parsed without it. A domain that is itself a public suffix, e.g. "co.uk", "com" or, with
+// AlternativeRootENS, "eth", is put into Domain.Root, with its TLD section, known flag and namespace.
+func (dp *DomainParser) Parse(domain string) (parsedDomain *Domain, err error) {
 	parsedDomain = &Domain{}
 
+	// The trailing dot of a fully qualified domain is the root of the DNS, not part of the TLD.
+	domain = strings.TrimSuffix(domain, ".")
+
 	// Split the domain into parts based on '.'
 	parts := strings.Split(domain, ".")
 
 	if len(parts) <= 1 {
+		// A single label is only matched to tell whether it is itself a TLD, e.g. "com" or "eth".
+		_, TLDSection, TLDKnown := dp.findTLDOffset(parts)
+
+		if !TLDKnown && dp.unknownTLDPolicy == UnknownTLDPolicyError {
+			parsedDomain = nil
+
+			err = fmt.Errorf("error parsing domain %q: %w", domain, ErrUnknownTLD)
+
+			return
+		}
+
 		parsedDomain.Root = domain
 
+		if TLDKnown {
+			parsedDomain.TopLevelSection = TLDSection
+			parsedDomain.TopLevelKnown = TLDKnown
+			parsedDomain.TopLevelNamespace = dp.alternativeRoots[normalizeDomain(domain)]
+		}
+
 		return
 	}
 
 	// Identify the index where the TLD begins using the findTLDOffset method.
-	TLDOffset := dp.findTLDOffset(parts)
+	TLDOffset, TLDSection, TLDKnown := dp.findTLDOffset(parts)
+
+	if !TLDKnown {
+		switch dp.unknownTLDPolicy {
+		case UnknownTLDPolicyError:
+			parsedDomain = nil
+
+			err = fmt.Errorf("error parsing domain %q: %w", domain, ErrUnknownTLD)
+
+			return
+		case UnknownTLDPolicyRoot:
+			parsedDomain.Root = domain
+
+			return
+		case UnknownTLDPolicyLastLabel:
+			// The implicit "*" rule is already applied by findTLDOffset.
+		}
+	}
 
 	if TLDOffset < 0 {
 		parsedDomain.Root = domain
+		parsedDomain.TopLevelSection = TLDSection
+		parsedDomain.TopLevelKnown = TLDKnown
+
+		if TLDKnown {
+			parsedDomain.TopLevelNamespace = dp.alternativeRoots[normalizeDomain(domain)]
+		}
 
 		return
 	}
@@ -75,41 +304,70 @@ func (dp *DomainParser) Parse(domain string) (parsedDomain *Domain) {
 	parsedDomain.Sub = strings.Join(parts[:TLDOffset], ".")
 	parsedDomain.Root = parts[TLDOffset]
 	parsedDomain.TopLevel = strings.Join(parts[TLDOffset+1:], ".")
+	parsedDomain.TopLevelSection = TLDSection
+	parsedDomain.TopLevelKnown = TLDKnown
+
+	if namespace, ok := dp.alternativeRoots[normalizeDomain(parsedDomain.TopLevel)]; ok && TLDKnown {
+		parsedDomain.TopLevelNamespace = namespace
+	}
 
 	return
 }
 
-// findTLDOffset determines the starting index of the TLD within a domain split into parts.
-// It reverses through the parts of the domain to accurately handle cases where subdomains may
-// mimic TLDs. The method uses the suffix array to find known TLDs efficiently.
-func (dp *DomainParser) findTLDOffset(parts []string) (offset int) {
-	offset = -1
+// findTLDOffset determines the index of the root domain within a domain split into parts, i.e.
+// the TLD starts at offset+1, as matched by the DomainParser's SuffixMatcher. If no rule matches
+// at all, the implicit "*" rule makes the last label the TLD, and known is false. A negative offset
+// means the whole domain is itself a TLD. It also returns the public suffix list section of the
+// prevailing rule.
+func (dp *DomainParser) findTLDOffset(parts []string) (offset int, section TLDSection, known bool) {
+	labels := make([]string, len(parts))
 
-	partsLength := len(parts)
-	partsLastIndex := partsLength - 1
+	for i := range parts {
+		labels[i] = toUnicodeLabel(parts[i])
+	}
 
-	for i := partsLastIndex; i >= 0; i-- {
-		// Construct a potential TLD from the current part to the end.
-		TLD := strings.Join(parts[i:], ".")
+	TLDLength, section := (*dp.matcher.Load()).MatchSuffix(labels)
 
-		// Search for the TLD in the suffix array.
-		indices := dp.sa.Lookup([]byte(TLD), -1)
+	known = TLDLength > 0
 
-		// If a match is found, update the offset, else break.
-		if len(indices) > 0 {
-			offset = i - 1
-		} else {
-			break
-		}
+	// The implicit "*" rule: the last label is the TLD.
+	if !known {
+		TLDLength = 1
 	}
 
+	offset = len(parts) - 1 - TLDLength
+
 	return
 }
 
+// UnknownTLDPolicy defines how a DomainParser handles domains with a TLD matched by no suffix rule,
+// e.g. "intranet.corp".
+type UnknownTLDPolicy int
+
+const (
+	// UnknownTLDPolicyLastLabel applies the implicit "*" rule of the public suffix list: the last
+	// label is the TLD, e.g. "intranet.corp" parses with "intranet" as its root domain and "corp"
+	// as its TLD. It is the default.
+	UnknownTLDPolicyLastLabel UnknownTLDPolicy = iota
+	// UnknownTLDPolicyError makes Parse return an error wrapping ErrUnknownTLD, for single labels
+	// too, e.g. "corp", that are not themselves a known TLD.
+	UnknownTLDPolicyError
+	// UnknownTLDPolicyRoot puts the whole domain into Domain.Root, leaving Domain.Sub and
+	// Domain.TopLevel empty, e.g. "intranet.corp" parses with "intranet.corp" as its root domain.
+	UnknownTLDPolicyRoot
+)
+
+// ErrUnknownTLD is returned by Parse, with UnknownTLDPolicyError, for domains with a TLD matched
+// by no suffix rule.
+var ErrUnknownTLD = errors.New("unknown TLD")
+
 // DomainParserInterface defines a standard interface for any DomainParser representation.
 type DomainParserInterface interface {
-	Parse(domain string) (parsedDomain *Domain)
-	findTLDOffset(parts []string) (offset int)
+	Parse(domain string) (parsedDomain *Domain, err error)
+	SetSuffixMatcher(matcher SuffixMatcher)
+	Snapshot() (snapshot tlds.Snapshot, ok bool)
+	SameRegistrableDomain(a, b string) bool
+	SameSite(a, b *url.URL) bool
 }
 
 // DomainParserOptionsFunc is a function type designed for configuring a DomainParser instance.
@@ -125,30 +383,172 @@ var (
 // NewDomainParser creates and initializes a DomainParser with a comprehensive list of TLDs,
 // including both standard and pseudo-TLDs. This setup ensures accurate parsing across a wide
 // range of domain names. Additional options can be applied to customize the parser further.
+// Options modifying the TLDs, e.g. DomainParserWithAdditionalTLDs, build a PublicSuffixList of
+// the DomainParser's own, so such DomainParsers are better created once and shared.
 func NewDomainParser(opts ...DomainParserOptionsFunc) (dp *DomainParser) {
 	dp = &DomainParser{}
 
-	// Combine standard and pseudo-TLDs for comprehensive coverage.
-	TLDs := []string{}
-
-	TLDs = append(TLDs, tlds.TLDs...)
-	TLDs = append(TLDs, tlds.PseudoTLDs...)
-
-	// Initialize the suffix array with TLD data.
-	dp.sa = suffixarray.New([]byte("\x00" + strings.Join(TLDs, "\x00") + "\x00"))
-
 	// Apply any additional options
 	for _, opt := range opts {
 		opt(dp)
 	}
 
+	for _, root := range dp.withAlternativeRoots {
+		if dp.alternativeRoots == nil {
+			dp.alternativeRoots = map[string]DomainNamespace{}
+		}
+
+		for _, TLD := range root.TLDs {
+			dp.alternativeRoots[normalizeDomain(TLD)] = root.Namespace
+		}
+	}
+
+	// Unless options set a SuffixMatcher, combine standard and pseudo-TLDs for comprehensive coverage.
+	if dp.matcher.Load() == nil {
+		dp.SetSuffixMatcher(dp.newPublicSuffixList())
+	}
+
+	return
+}
+
+// newPublicSuffixList builds the PublicSuffixList the DomainParser's options describe: the
+// default or custom TLDs, plus the additional and alternative-root TLDs, minus the removed ones.
+func (dp *DomainParser) newPublicSuffixList() (list *PublicSuffixList) {
+	sections := []TLDSection{TLDSectionNone, TLDSectionICANN}
+
+	if dp.withPrivateTLDs {
+		sections = append(sections, TLDSectionPrivate)
+	}
+
+	switch {
+	case dp.withCustomTLDs:
+		list = &PublicSuffixList{trie: &suffixTrie{}}
+
+		list.trie.addRules(TLDSectionNone, dp.withTLDs, nil, nil)
+	case len(dp.withAdditionalTLDs) == 0 && len(dp.withAlternativeRoots) == 0 && len(dp.withoutTLDs) == 0:
+		// The built-in TLDs, unmodified, are matched against the shared, precomputed suffix table.
+		return sharedPublicSuffixList(dp.withPrivateTLDs)
+	default:
+		list = newPublicSuffixListTrie(sections...)
+
+		// Added or removed rules make it no longer the snapshot of the built-in TLDs.
+		list.snapshot = nil
+	}
+
+	for _, rule := range dp.withAdditionalTLDs {
+		suffix, kind := splitPublicSuffixRule(rule)
+
+		list.trie.add(suffix, kind, TLDSectionNone)
+	}
+
+	for _, root := range dp.withAlternativeRoots {
+		list.trie.addRules(TLDSectionNone, root.TLDs, nil, nil)
+	}
+
+	for _, rule := range dp.withoutTLDs {
+		suffix, kind := splitPublicSuffixRule(rule)
+
+		list.trie.remove(suffix, kind)
+	}
+
 	return
 }
 
 // DomainParserWithTLDs allows for the initialization of the DomainParser with a custom set of TLDs.
 // This is particularly useful for applications requiring parsing of non-standard or niche TLDs.
+// The custom TLDs replace all default rules, including wildcard and exception rules.
 func DomainParserWithTLDs(TLDs ...string) DomainParserOptionsFunc {
 	return func(dp *DomainParser) {
-		dp.sa = suffixarray.New([]byte("\x00" + strings.Join(TLDs, "\x00") + "\x00"))
+		dp.withTLDs = TLDs
+		dp.withCustomTLDs = true
+	}
+}
+
+// DomainParserWithAdditionalTLDs appends TLDs to the DomainParser's default (or custom) TLDs,
+// e.g. an internal suffix like "corp.internal", without rebuilding the whole list. TLDs may use
+// the public suffix list rule syntax, "*.<TLD>" for wildcard rules and "!<domain>" for exceptions.
+// Use URLExtractorWithAdditionalTLDs with the same TLDs for extraction to agree with parsing.
+func DomainParserWithAdditionalTLDs(TLDs ...string) DomainParserOptionsFunc {
+	return func(dp *DomainParser) {
+		dp.withAdditionalTLDs = append(dp.withAdditionalTLDs, TLDs...)
+	}
+}
+
+// DomainParserWithoutTLDs removes TLDs from the DomainParser's default (or custom) TLDs, e.g.
+// tlds.PseudoTLDs. TLDs may use the public suffix list rule syntax, to remove wildcard ("*.<TLD>")
+// or exception ("!<domain>") rules. Use URLExtractorWithoutTLDs with the same TLDs for extraction
+// to agree with parsing.
+func DomainParserWithoutTLDs(TLDs ...string) DomainParserOptionsFunc {
+	return func(dp *DomainParser) {
+		dp.withoutTLDs = append(dp.withoutTLDs, TLDs...)
+	}
+}
+
+// DomainParserWithPrivateTLDs adds the private domains section of the public suffix list, e.g.
+// "github.io" or "herokuapp.com", to the DomainParser's default TLDs. By default only the ICANN
+// domains section is used, so "example.github.io" parses with "github" as its root domain. With
+// this option it parses with "example" as its root domain and "github.io" as its TLD.
+func DomainParserWithPrivateTLDs() DomainParserOptionsFunc {
+	return func(dp *DomainParser) {
+		dp.withPrivateTLDs = true
+	}
+}
+
+// DomainParserWithUnknownTLDPolicy sets how the DomainParser handles domains with a TLD matched
+// by no suffix rule. Whatever the policy, Domain.TopLevelKnown tells such domains apart.
+func DomainParserWithUnknownTLDPolicy(policy UnknownTLDPolicy) DomainParserOptionsFunc {
+	return func(dp *DomainParser) {
+		dp.unknownTLDPolicy = policy
+	}
+}
+
+// DomainParserWithSuffixMatcher allows for the initialization of the DomainParser with a custom
+// SuffixMatcher instead of the default TLDs, e.g. a public suffix list read at runtime with
+// ReadPublicSuffixListFile, or a CompositeSuffixMatcher layering custom TLDs on the defaults.
+// It takes precedence over the options setting TLDs.
+func DomainParserWithSuffixMatcher(matcher SuffixMatcher) DomainParserOptionsFunc {
+	return func(dp *DomainParser) {
+		dp.SetSuffixMatcher(matcher)
 	}
 }
+
+// idnaProfile is the IDNA profile used to convert domains between their ASCII and Unicode forms:
+// UTS #46 nontransitional processing with the mapping for lookup, but lenient about hyphens
+// and non-LDH ASCII characters, such as the underscores of SRV names.
+var idnaProfile = idna.New(
+	idna.MapForLookup(),
+	idna.Transitional(false),
+	idna.StrictDomainName(false),
+	idna.CheckHyphens(false),
+)
+
+// toUnicodeLabel returns the Unicode form of label, case-folded and mapped as per UTS #46, the
+// form TLDs are matched in. Labels that fail IDNA processing are only lowercased.
+func toUnicodeLabel(label string) (unicodeLabel string) {
+	isASCII, isLower := true, true
+
+	for i := 0; i < len(label); i++ {
+		switch c := label[i]; {
+		case c >= utf8.RuneSelf:
+			isASCII = false
+		case 'A' <= c && c <= 'Z':
+			isLower = false
+		}
+	}
+
+	// Fast path: plain ASCII labels need no IDNA processing.
+	if isASCII && !(len(label) >= 4 && strings.EqualFold(label[:4], "xn--")) {
+		if isLower {
+			return label
+		}
+
+		return strings.ToLower(label)
+	}
+
+	unicodeLabel, err := idnaProfile.ToUnicode(label)
+	if err != nil {
+		unicodeLabel = strings.ToLower(label)
+	}
+
+	return
+}
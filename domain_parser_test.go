@@ -1,8 +1,12 @@
 package hqgourl_test
 
 import (
+	"errors"
 	"fmt"
+	"index/suffixarray"
 	"reflect"
+	"strings"
+	"sync"
 	"testing"
 
 	"github.com/hueristiq/hqgourl"
@@ -18,33 +22,33 @@ func TestDomain_String(t *testing.T) {
 	}{
 		{
 			&hqgourl.Domain{
-				"",
-				"example",
-				"",
+				Sub:      "",
+				Root:     "example",
+				TopLevel: "",
 			},
 			"example",
 		},
 		{
 			&hqgourl.Domain{
-				"",
-				"example",
-				"com",
+				Sub:      "",
+				Root:     "example",
+				TopLevel: "com",
 			},
 			"example.com",
 		},
 		{
 			&hqgourl.Domain{
-				"www",
-				"example",
-				"com",
+				Sub:      "www",
+				Root:     "example",
+				TopLevel: "com",
 			},
 			"www.example.com",
 		},
 		{
 			&hqgourl.Domain{
-				"blog.www",
-				"example",
-				"com",
+				Sub:      "blog.www",
+				Root:     "example",
+				TopLevel: "com",
 			},
 			"blog.www.example.com",
 		},
@@ -65,6 +69,236 @@ func TestDomain_String(t *testing.T) {
 	}
 }
 
+func TestDomain_ToASCII(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		domain         *hqgourl.Domain
+		expectedDomain *hqgourl.Domain
+	}{
+		{
+			&hqgourl.Domain{
+				Sub:      "www",
+				Root:     "例子",
+				TopLevel: "公司.cn",
+			},
+			&hqgourl.Domain{
+				Sub:      "www",
+				Root:     "xn--fsqu00a",
+				TopLevel: "xn--55qx5d.cn",
+			},
+		},
+		{
+			&hqgourl.Domain{
+				Root:     "example",
+				TopLevel: "com",
+			},
+			&hqgourl.Domain{
+				Root:     "example",
+				TopLevel: "com",
+			},
+		},
+	}
+
+	for _, c := range cases {
+		c := c
+
+		t.Run(fmt.Sprintf("Domain.ToASCII(%q)", c.domain), func(t *testing.T) {
+			t.Parallel()
+
+			domain, err := c.domain.ToASCII()
+			if err != nil {
+				t.Fatalf("Domain.ToASCII(%q) error = %v", c.domain, err)
+			}
+
+			if !reflect.DeepEqual(domain, c.expectedDomain) {
+				t.Errorf("Domain.ToASCII(%q) = %+v, want %+v", c.domain, domain, c.expectedDomain)
+			}
+		})
+	}
+}
+
+func TestDomain_ToUnicode(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		domain         *hqgourl.Domain
+		expectedDomain *hqgourl.Domain
+	}{
+		{
+			&hqgourl.Domain{
+				Sub:      "www",
+				Root:     "xn--fsqu00a",
+				TopLevel: "xn--55qx5d.cn",
+			},
+			&hqgourl.Domain{
+				Sub:      "www",
+				Root:     "例子",
+				TopLevel: "公司.cn",
+			},
+		},
+		{
+			&hqgourl.Domain{
+				Root:     "例子",
+				TopLevel: "公司.cn",
+			},
+			&hqgourl.Domain{
+				Root:     "例子",
+				TopLevel: "公司.cn",
+			},
+		},
+	}
+
+	for _, c := range cases {
+		c := c
+
+		t.Run(fmt.Sprintf("Domain.ToUnicode(%q)", c.domain), func(t *testing.T) {
+			t.Parallel()
+
+			domain, err := c.domain.ToUnicode()
+			if err != nil {
+				t.Fatalf("Domain.ToUnicode(%q) error = %v", c.domain, err)
+			}
+
+			if !reflect.DeepEqual(domain, c.expectedDomain) {
+				t.Errorf("Domain.ToUnicode(%q) = %+v, want %+v", c.domain, domain, c.expectedDomain)
+			}
+		})
+	}
+}
+
+func TestDomain_RegistrableDomainAndParents(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		rawDomain                 string
+		expectedRegistrableDomain string
+		expectedSubLabels         []string
+		expectedSubDepth          int
+		expectedParents           []string
+	}{
+		{"a.b.example.co.uk", "example.co.uk", []string{"a", "b"}, 2, []string{"b.example.co.uk", "example.co.uk"}},
+		{"www.example.com", "example.com", []string{"www"}, 1, []string{"example.com"}},
+		{"example.com", "example.com", nil, 0, nil},
+		{"co.uk", "", nil, 0, nil},
+		{"localhost", "", nil, 0, nil},
+	}
+
+	dp := hqgourl.NewDomainParser()
+
+	for _, c := range cases {
+		c := c
+
+		t.Run(fmt.Sprintf("Parse(%q)", c.rawDomain), func(t *testing.T) {
+			t.Parallel()
+
+			parsedDomain, err := dp.Parse(c.rawDomain)
+			if err != nil {
+				t.Fatalf("Parse(%q) error = %v", c.rawDomain, err)
+			}
+
+			if registrable := parsedDomain.RegistrableDomain(); registrable != c.expectedRegistrableDomain {
+				t.Errorf("Domain.RegistrableDomain() = %q, want %q", registrable, c.expectedRegistrableDomain)
+			}
+
+			if labels := parsedDomain.SubLabels(); !reflect.DeepEqual(labels, c.expectedSubLabels) {
+				t.Errorf("Domain.SubLabels() = %q, want %q", labels, c.expectedSubLabels)
+			}
+
+			if depth := parsedDomain.SubDepth(); depth != c.expectedSubDepth {
+				t.Errorf("Domain.SubDepth() = %d, want %d", depth, c.expectedSubDepth)
+			}
+
+			var parents []string
+
+			parsedDomain.Parents(func(parent string) bool {
+				parents = append(parents, parent)
+
+				return true
+			})
+
+			if !reflect.DeepEqual(parents, c.expectedParents) {
+				t.Errorf("Domain.Parents() = %q, want %q", parents, c.expectedParents)
+			}
+		})
+	}
+}
+
+func TestDomain_ParentsStop(t *testing.T) {
+	t.Parallel()
+
+	parsedDomain, err := hqgourl.NewDomainParser().Parse("a.b.c.example.com")
+	if err != nil {
+		t.Fatalf("Parse(%q) error = %v", "a.b.c.example.com", err)
+	}
+
+	var parents []string
+
+	parsedDomain.Parents(func(parent string) bool {
+		parents = append(parents, parent)
+
+		return len(parents) < 2
+	})
+
+	expectedParents := []string{"b.c.example.com", "c.example.com"}
+
+	if !reflect.DeepEqual(parents, expectedParents) {
+		t.Errorf("Domain.Parents() = %q, want %q", parents, expectedParents)
+	}
+}
+
+func TestDomain_TopLevelMetadata(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		rawDomain           string
+		expectedType        tlds.TLDType
+		expectedCountryCode string
+		expectedOK          bool
+	}{
+		{"www.example.com", tlds.TLDTypeGeneric, "", true},
+		{"www.example.co.uk", tlds.TLDTypeCountryCode, "GB", true},
+		{"www.例子.中国", tlds.TLDTypeCountryCode, "CN", true},
+		{"www.xn--fsqu00a.xn--fiqs8s", tlds.TLDTypeCountryCode, "CN", true},
+		{"1.0.0.127.in-addr.arpa", tlds.TLDTypeInfrastructure, "", true},
+		{"www.example.biz", tlds.TLDTypeGenericRestricted, "", true},
+		{"www.example.museum", tlds.TLDTypeSponsored, "", true},
+		{"www.intranet.corp", tlds.TLDTypeUnknown, "", false},
+	}
+
+	dp := hqgourl.NewDomainParser()
+
+	for _, c := range cases {
+		c := c
+
+		t.Run(fmt.Sprintf("Parse(%q).TopLevelMetadata()", c.rawDomain), func(t *testing.T) {
+			t.Parallel()
+
+			parsedDomain, err := dp.Parse(c.rawDomain)
+			if err != nil {
+				t.Fatalf("Parse(%q) error = %v", c.rawDomain, err)
+			}
+
+			metadata, ok := parsedDomain.TopLevelMetadata()
+
+			if metadata.Type != c.expectedType || metadata.CountryCode != c.expectedCountryCode || ok != c.expectedOK {
+				t.Errorf("Parse(%q).TopLevelMetadata() = %s, %q, %v, want %s, %q, %v", c.rawDomain, metadata.Type, metadata.CountryCode, ok, c.expectedType, c.expectedCountryCode, c.expectedOK)
+			}
+		})
+	}
+
+	if _, ok := (&hqgourl.Domain{Root: "localhost"}).TopLevelMetadata(); ok {
+		t.Error("Domain.TopLevelMetadata() of a domain without TLD ok = true, want false")
+	}
+
+	// Derived from the public suffix list, the metadata leaves out the new gTLDs, generic or brand.
+	if tlds.RootZoneDatabaseSource == "" {
+		if metadata, ok := tlds.LookupMetadata("google"); ok {
+			t.Errorf("tlds.LookupMetadata(%q) = %s, true, want no metadata without the root zone database", "google", metadata.Type)
+		}
+	}
+}
+
 func TestNewDomainParser(t *testing.T) {
 	t.Parallel()
 
@@ -84,57 +318,108 @@ func TestDomainParsing(t *testing.T) {
 		{
 			"localhost",
 			&hqgourl.Domain{
-				Sub:      "",
-				Root:     "localhost",
-				TopLevel: "",
+				Sub:           "",
+				Root:          "localhost",
+				TopLevel:      "",
+				TopLevelKnown: true,
 			},
 		},
 		{
 			"co.uk",
 			&hqgourl.Domain{
-				Sub:      "",
-				Root:     "co.uk",
-				TopLevel: "",
+				Sub:             "",
+				Root:            "co.uk",
+				TopLevel:        "",
+				TopLevelSection: hqgourl.TLDSectionICANN,
+				TopLevelKnown:   true,
 			},
 		},
 		{
 			"example.com",
 			&hqgourl.Domain{
-				Sub:      "",
-				Root:     "example",
-				TopLevel: "com",
+				Sub:             "",
+				Root:            "example",
+				TopLevel:        "com",
+				TopLevelSection: hqgourl.TLDSectionICANN,
+				TopLevelKnown:   true,
 			},
 		},
 		{
 			"www.example.com",
 			&hqgourl.Domain{
-				Sub:      "www",
-				Root:     "example",
-				TopLevel: "com",
+				Sub:             "www",
+				Root:            "example",
+				TopLevel:        "com",
+				TopLevelSection: hqgourl.TLDSectionICANN,
+				TopLevelKnown:   true,
 			},
 		},
 		{
 			"example.co.uk",
 			&hqgourl.Domain{
-				Sub:      "",
-				Root:     "example",
-				TopLevel: "co.uk",
+				Sub:             "",
+				Root:            "example",
+				TopLevel:        "co.uk",
+				TopLevelSection: hqgourl.TLDSectionICANN,
+				TopLevelKnown:   true,
 			},
 		},
 		{
 			"www.example.co.uk",
 			&hqgourl.Domain{
-				Sub:      "www",
-				Root:     "example",
-				TopLevel: "co.uk",
+				Sub:             "www",
+				Root:            "example",
+				TopLevel:        "co.uk",
+				TopLevelSection: hqgourl.TLDSectionICANN,
+				TopLevelKnown:   true,
+			},
+		},
+		{
+			"WWW.Example.CO.UK",
+			&hqgourl.Domain{
+				Sub:             "WWW",
+				Root:            "Example",
+				TopLevel:        "CO.UK",
+				TopLevelSection: hqgourl.TLDSectionICANN,
+				TopLevelKnown:   true,
+			},
+		},
+		{
+			"www.例子.公司.cn",
+			&hqgourl.Domain{
+				Sub:             "www",
+				Root:            "例子",
+				TopLevel:        "公司.cn",
+				TopLevelSection: hqgourl.TLDSectionICANN,
+				TopLevelKnown:   true,
+			},
+		},
+		{
+			"www.xn--fsqu00a.xn--55qx5d.cn",
+			&hqgourl.Domain{
+				Sub:             "www",
+				Root:            "xn--fsqu00a",
+				TopLevel:        "xn--55qx5d.cn",
+				TopLevelSection: hqgourl.TLDSectionICANN,
+				TopLevelKnown:   true,
+			},
+		},
+		{
+			"example.o.uk",
+			&hqgourl.Domain{
+				Sub:             "example",
+				Root:            "o",
+				TopLevel:        "uk",
+				TopLevelSection: hqgourl.TLDSectionICANN,
+				TopLevelKnown:   true,
 			},
 		},
 		{
 			"www.example.custom",
 			&hqgourl.Domain{
-				Sub:      "",
-				Root:     "www.example.custom",
-				TopLevel: "",
+				Sub:      "www",
+				Root:     "example",
+				TopLevel: "custom",
 			},
 		},
 	}
@@ -147,7 +432,10 @@ func TestDomainParsing(t *testing.T) {
 		t.Run(fmt.Sprintf("Parse(%q)", c.rawDomain), func(t *testing.T) {
 			t.Parallel()
 
-			parsedDomain := dp.Parse(c.rawDomain)
+			parsedDomain, err := dp.Parse(c.rawDomain)
+			if err != nil {
+				t.Fatalf("Parse(%q) error = %v", c.rawDomain, err)
+			}
 
 			if !reflect.DeepEqual(parsedDomain, c.expectedParsedDomain) {
 				t.Errorf("Parse(%q) = %+v, want %+v", c.rawDomain, parsedDomain, c.expectedParsedDomain)
@@ -166,17 +454,19 @@ func TestDomainParsingWithCustomTLDs(t *testing.T) {
 		{
 			"example.custom",
 			&hqgourl.Domain{
-				Sub:      "",
-				Root:     "example",
-				TopLevel: "custom",
+				Sub:           "",
+				Root:          "example",
+				TopLevel:      "custom",
+				TopLevelKnown: true,
 			},
 		},
 		{
 			"subdomain.example.custom",
 			&hqgourl.Domain{
-				Sub:      "subdomain",
-				Root:     "example",
-				TopLevel: "custom",
+				Sub:           "subdomain",
+				Root:          "example",
+				TopLevel:      "custom",
+				TopLevelKnown: true,
 			},
 		},
 	}
@@ -191,7 +481,10 @@ func TestDomainParsingWithCustomTLDs(t *testing.T) {
 		t.Run(fmt.Sprintf("Parse(%q)", c.rawDomain), func(t *testing.T) {
 			t.Parallel()
 
-			parsedDomain := dp.Parse(c.rawDomain)
+			parsedDomain, err := dp.Parse(c.rawDomain)
+			if err != nil {
+				t.Fatalf("Parse(%q) error = %v", c.rawDomain, err)
+			}
 
 			if !reflect.DeepEqual(parsedDomain, c.expectedParsedDomain) {
 				t.Errorf("Parse(%q) = %+v, want %+v", c.rawDomain, parsedDomain, c.expectedParsedDomain)
@@ -210,12 +503,591 @@ func TestDomainParserWithStandardAndPseudoTLDs(t *testing.T) {
 	TLDs = append(TLDs, tlds.TLDs...)
 	TLDs = append(TLDs, tlds.PseudoTLDs...)
 
+	wildcards := map[string]bool{}
+
+	for _, TLD := range tlds.WildcardTLDs {
+		wildcards[TLD] = true
+	}
+
 	for _, TLD := range TLDs {
+		// Under a wildcard rule "example.<TLD>" is itself a TLD, see TestDomainParsingWithWildcardAndExceptionRules.
+		if wildcards[TLD] {
+			continue
+		}
+
 		domain := "example." + TLD
 
-		parsedDomain := dp.Parse(domain)
+		parsedDomain, err := dp.Parse(domain)
+		if err != nil {
+			t.Fatalf("Parse(%q) error = %v", domain, err)
+		}
+
 		if parsedDomain.TopLevel != TLD {
 			t.Errorf("Parse(%q) = %q, %q, %q; want %q, %q, %q", domain, "", "example", TLD, "", "example", parsedDomain.TopLevel)
 		}
 	}
 }
+
+func TestDomainParsingWithWildcardAndExceptionRules(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		rawDomain            string
+		expectedParsedDomain *hqgourl.Domain
+	}{
+		{
+			"example.ck",
+			&hqgourl.Domain{
+				Sub:             "",
+				Root:            "example.ck",
+				TopLevel:        "",
+				TopLevelSection: hqgourl.TLDSectionICANN,
+				TopLevelKnown:   true,
+			},
+		},
+		{
+			"www.example.ck",
+			&hqgourl.Domain{
+				Sub:             "",
+				Root:            "www",
+				TopLevel:        "example.ck",
+				TopLevelSection: hqgourl.TLDSectionICANN,
+				TopLevelKnown:   true,
+			},
+		},
+		{
+			"www.ck",
+			&hqgourl.Domain{
+				Sub:             "",
+				Root:            "www",
+				TopLevel:        "ck",
+				TopLevelSection: hqgourl.TLDSectionICANN,
+				TopLevelKnown:   true,
+			},
+		},
+		{
+			"blog.www.ck",
+			&hqgourl.Domain{
+				Sub:             "blog",
+				Root:            "www",
+				TopLevel:        "ck",
+				TopLevelSection: hqgourl.TLDSectionICANN,
+				TopLevelKnown:   true,
+			},
+		},
+		{
+			"kawasaki.jp",
+			&hqgourl.Domain{
+				Sub:             "",
+				Root:            "kawasaki",
+				TopLevel:        "jp",
+				TopLevelSection: hqgourl.TLDSectionICANN,
+				TopLevelKnown:   true,
+			},
+		},
+		{
+			"www.example.kawasaki.jp",
+			&hqgourl.Domain{
+				Sub:             "",
+				Root:            "www",
+				TopLevel:        "example.kawasaki.jp",
+				TopLevelSection: hqgourl.TLDSectionICANN,
+				TopLevelKnown:   true,
+			},
+		},
+		{
+			"www.city.kawasaki.jp",
+			&hqgourl.Domain{
+				Sub:             "www",
+				Root:            "city",
+				TopLevel:        "kawasaki.jp",
+				TopLevelSection: hqgourl.TLDSectionICANN,
+				TopLevelKnown:   true,
+			},
+		},
+		{
+			"example.jp",
+			&hqgourl.Domain{
+				Sub:             "",
+				Root:            "example",
+				TopLevel:        "jp",
+				TopLevelSection: hqgourl.TLDSectionICANN,
+				TopLevelKnown:   true,
+			},
+		},
+	}
+
+	dp := hqgourl.NewDomainParser()
+
+	for _, c := range cases {
+		c := c
+
+		t.Run(fmt.Sprintf("Parse(%q)", c.rawDomain), func(t *testing.T) {
+			t.Parallel()
+
+			parsedDomain, err := dp.Parse(c.rawDomain)
+			if err != nil {
+				t.Fatalf("Parse(%q) error = %v", c.rawDomain, err)
+			}
+
+			if !reflect.DeepEqual(parsedDomain, c.expectedParsedDomain) {
+				t.Errorf("Parse(%q) = %+v, want %+v", c.rawDomain, parsedDomain, c.expectedParsedDomain)
+			}
+		})
+	}
+}
+
+func TestDomainParsingWithAdditionalAndRemovedTLDs(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		rawDomain            string
+		expectedParsedDomain *hqgourl.Domain
+	}{
+		{
+			"www.example.corp.internal",
+			&hqgourl.Domain{
+				Sub:             "www",
+				Root:            "example",
+				TopLevel:        "corp.internal",
+				TopLevelSection: hqgourl.TLDSectionNone,
+				TopLevelKnown:   true,
+			},
+		},
+		{
+			"www.example.dev.internal",
+			&hqgourl.Domain{
+				Sub:             "",
+				Root:            "www",
+				TopLevel:        "example.dev.internal",
+				TopLevelSection: hqgourl.TLDSectionNone,
+				TopLevelKnown:   true,
+			},
+		},
+		{
+			"www.example.co.uk",
+			&hqgourl.Domain{
+				Sub:             "www.example",
+				Root:            "co",
+				TopLevel:        "uk",
+				TopLevelSection: hqgourl.TLDSectionICANN,
+				TopLevelKnown:   true,
+			},
+		},
+		{
+			"www.example.com",
+			&hqgourl.Domain{
+				Sub:             "www",
+				Root:            "example",
+				TopLevel:        "com",
+				TopLevelSection: hqgourl.TLDSectionICANN,
+				TopLevelKnown:   true,
+			},
+		},
+	}
+
+	dp := hqgourl.NewDomainParser(
+		hqgourl.DomainParserWithAdditionalTLDs("corp.internal", "*.dev.internal"),
+		hqgourl.DomainParserWithoutTLDs("co.uk"),
+	)
+
+	for _, c := range cases {
+		c := c
+
+		t.Run(fmt.Sprintf("Parse(%q)", c.rawDomain), func(t *testing.T) {
+			t.Parallel()
+
+			parsedDomain, err := dp.Parse(c.rawDomain)
+			if err != nil {
+				t.Fatalf("Parse(%q) error = %v", c.rawDomain, err)
+			}
+
+			if !reflect.DeepEqual(parsedDomain, c.expectedParsedDomain) {
+				t.Errorf("Parse(%q) = %+v, want %+v", c.rawDomain, parsedDomain, c.expectedParsedDomain)
+			}
+		})
+	}
+}
+
+func TestDomainParsingWithPrivateTLDs(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		rawDomain                   string
+		expectedParsedDomain        *hqgourl.Domain
+		expectedParsedDomainPrivate *hqgourl.Domain
+	}{
+		{
+			"example.github.io",
+			&hqgourl.Domain{
+				Sub:             "example",
+				Root:            "github",
+				TopLevel:        "io",
+				TopLevelSection: hqgourl.TLDSectionICANN,
+				TopLevelKnown:   true,
+			},
+			&hqgourl.Domain{
+				Sub:             "",
+				Root:            "example",
+				TopLevel:        "github.io",
+				TopLevelSection: hqgourl.TLDSectionPrivate,
+				TopLevelKnown:   true,
+			},
+		},
+		{
+			"www.example.herokuapp.com",
+			&hqgourl.Domain{
+				Sub:             "www.example",
+				Root:            "herokuapp",
+				TopLevel:        "com",
+				TopLevelSection: hqgourl.TLDSectionICANN,
+				TopLevelKnown:   true,
+			},
+			&hqgourl.Domain{
+				Sub:             "www",
+				Root:            "example",
+				TopLevel:        "herokuapp.com",
+				TopLevelSection: hqgourl.TLDSectionPrivate,
+				TopLevelKnown:   true,
+			},
+		},
+		{
+			"x.s3.amazonaws.com",
+			&hqgourl.Domain{
+				Sub:             "x.s3",
+				Root:            "amazonaws",
+				TopLevel:        "com",
+				TopLevelSection: hqgourl.TLDSectionICANN,
+				TopLevelKnown:   true,
+			},
+			&hqgourl.Domain{
+				Sub:             "",
+				Root:            "x",
+				TopLevel:        "s3.amazonaws.com",
+				TopLevelSection: hqgourl.TLDSectionPrivate,
+				TopLevelKnown:   true,
+			},
+		},
+		{
+			"www.example.co.uk",
+			&hqgourl.Domain{
+				Sub:             "www",
+				Root:            "example",
+				TopLevel:        "co.uk",
+				TopLevelSection: hqgourl.TLDSectionICANN,
+				TopLevelKnown:   true,
+			},
+			&hqgourl.Domain{
+				Sub:             "www",
+				Root:            "example",
+				TopLevel:        "co.uk",
+				TopLevelSection: hqgourl.TLDSectionICANN,
+				TopLevelKnown:   true,
+			},
+		},
+	}
+
+	dp := hqgourl.NewDomainParser()
+	dpPrivate := hqgourl.NewDomainParser(
+		hqgourl.DomainParserWithPrivateTLDs(),
+	)
+
+	for _, c := range cases {
+		c := c
+
+		t.Run(fmt.Sprintf("Parse(%q)", c.rawDomain), func(t *testing.T) {
+			t.Parallel()
+
+			parsedDomain, err := dp.Parse(c.rawDomain)
+			if err != nil {
+				t.Fatalf("Parse(%q) error = %v", c.rawDomain, err)
+			}
+
+			if !reflect.DeepEqual(parsedDomain, c.expectedParsedDomain) {
+				t.Errorf("Parse(%q) = %+v, want %+v", c.rawDomain, parsedDomain, c.expectedParsedDomain)
+			}
+
+			parsedDomain, err = dpPrivate.Parse(c.rawDomain)
+			if err != nil {
+				t.Fatalf("Parse(%q) error = %v", c.rawDomain, err)
+			}
+
+			if !reflect.DeepEqual(parsedDomain, c.expectedParsedDomainPrivate) {
+				t.Errorf("Parse(%q) with private TLDs = %+v, want %+v", c.rawDomain, parsedDomain, c.expectedParsedDomainPrivate)
+			}
+		})
+	}
+}
+
+func TestDomainParsingWithUnknownTLDPolicy(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		policy               hqgourl.UnknownTLDPolicy
+		rawDomain            string
+		expectedParsedDomain *hqgourl.Domain
+		expectedErr          error
+	}{
+		{
+			hqgourl.UnknownTLDPolicyLastLabel,
+			"www.intranet.corp",
+			&hqgourl.Domain{
+				Sub:      "www",
+				Root:     "intranet",
+				TopLevel: "corp",
+			},
+			nil,
+		},
+		{
+			hqgourl.UnknownTLDPolicyRoot,
+			"www.intranet.corp",
+			&hqgourl.Domain{
+				Root: "www.intranet.corp",
+			},
+			nil,
+		},
+		{
+			hqgourl.UnknownTLDPolicyError,
+			"www.intranet.corp",
+			nil,
+			hqgourl.ErrUnknownTLD,
+		},
+		// Single labels.
+		{
+			hqgourl.UnknownTLDPolicyError,
+			"corp",
+			nil,
+			hqgourl.ErrUnknownTLD,
+		},
+		{
+			hqgourl.UnknownTLDPolicyError,
+			"com",
+			&hqgourl.Domain{
+				Root:            "com",
+				TopLevelSection: hqgourl.TLDSectionICANN,
+				TopLevelKnown:   true,
+			},
+			nil,
+		},
+		{
+			hqgourl.UnknownTLDPolicyLastLabel,
+			"corp",
+			&hqgourl.Domain{
+				Root: "corp",
+			},
+			nil,
+		},
+		{
+			hqgourl.UnknownTLDPolicyError,
+			"www.example.com",
+			&hqgourl.Domain{
+				Sub:             "www",
+				Root:            "example",
+				TopLevel:        "com",
+				TopLevelSection: hqgourl.TLDSectionICANN,
+				TopLevelKnown:   true,
+			},
+			nil,
+		},
+		// Fully qualified domains, with a trailing dot.
+		{
+			hqgourl.UnknownTLDPolicyError,
+			"www.example.com.",
+			&hqgourl.Domain{
+				Sub:             "www",
+				Root:            "example",
+				TopLevel:        "com",
+				TopLevelSection: hqgourl.TLDSectionICANN,
+				TopLevelKnown:   true,
+			},
+			nil,
+		},
+		{
+			hqgourl.UnknownTLDPolicyLastLabel,
+			"EXAMPLE.COM.",
+			&hqgourl.Domain{
+				Root:            "EXAMPLE",
+				TopLevel:        "COM",
+				TopLevelSection: hqgourl.TLDSectionICANN,
+				TopLevelKnown:   true,
+			},
+			nil,
+		},
+		{
+			hqgourl.UnknownTLDPolicyError,
+			"www.intranet.corp.",
+			nil,
+			hqgourl.ErrUnknownTLD,
+		},
+		// Public suffixes themselves.
+		{
+			hqgourl.UnknownTLDPolicyError,
+			"co.uk",
+			&hqgourl.Domain{
+				Root:            "co.uk",
+				TopLevelSection: hqgourl.TLDSectionICANN,
+				TopLevelKnown:   true,
+			},
+			nil,
+		},
+		{
+			hqgourl.UnknownTLDPolicyLastLabel,
+			"co.uk.",
+			&hqgourl.Domain{
+				Root:            "co.uk",
+				TopLevelSection: hqgourl.TLDSectionICANN,
+				TopLevelKnown:   true,
+			},
+			nil,
+		},
+	}
+
+	for _, c := range cases {
+		c := c
+
+		t.Run(fmt.Sprintf("Parse(%q) with policy %d", c.rawDomain, c.policy), func(t *testing.T) {
+			t.Parallel()
+
+			dp := hqgourl.NewDomainParser(
+				hqgourl.DomainParserWithUnknownTLDPolicy(c.policy),
+			)
+
+			parsedDomain, err := dp.Parse(c.rawDomain)
+
+			if !errors.Is(err, c.expectedErr) {
+				t.Fatalf("Parse(%q) error = %v, want %v", c.rawDomain, err, c.expectedErr)
+			}
+
+			if !reflect.DeepEqual(parsedDomain, c.expectedParsedDomain) {
+				t.Errorf("Parse(%q) = %+v, want %+v", c.rawDomain, parsedDomain, c.expectedParsedDomain)
+			}
+		})
+	}
+}
+
+// TestDomainParserConcurrency parses from many goroutines, with DomainParsers created per
+// goroutine, sharing the default suffix index, and with one shared DomainParser. Run with -race.
+func TestDomainParserConcurrency(t *testing.T) {
+	t.Parallel()
+
+	domains := []string{
+		"www.example.com",
+		"blog.www.example.co.uk",
+		"example.github.io",
+		"www.example.any.ck",
+		"例子.公司.cn",
+		"www.example.local",
+	}
+
+	cases := []struct {
+		name string
+		opts []hqgourl.DomainParserOptionsFunc
+	}{
+		{
+			name: "Default options",
+		},
+		{
+			name: "With private TLDs",
+			opts: []hqgourl.DomainParserOptionsFunc{hqgourl.DomainParserWithPrivateTLDs()},
+		},
+		{
+			name: "With additional TLDs",
+			opts: []hqgourl.DomainParserOptionsFunc{hqgourl.DomainParserWithAdditionalTLDs("corp.internal")},
+		},
+	}
+
+	for _, c := range cases {
+		c := c
+
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			shared := hqgourl.NewDomainParser(c.opts...)
+
+			expected := make([]*hqgourl.Domain, len(domains))
+
+			for i, domain := range domains {
+				expected[i], _ = shared.Parse(domain)
+			}
+
+			var wg sync.WaitGroup
+
+			for g := 0; g < 8; g++ {
+				wg.Add(1)
+
+				go func() {
+					defer wg.Done()
+
+					own := hqgourl.NewDomainParser(c.opts...)
+
+					for i, domain := range domains {
+						for _, dp := range []*hqgourl.DomainParser{own, shared} {
+							if parsedDomain, _ := dp.Parse(domain); !reflect.DeepEqual(parsedDomain, expected[i]) {
+								t.Errorf("Parse(%q) = %+v, want %+v", domain, parsedDomain, expected[i])
+							}
+						}
+					}
+				}()
+			}
+
+			wg.Wait()
+		})
+	}
+}
+
+func BenchmarkNewDomainParser(b *testing.B) {
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		hqgourl.NewDomainParser()
+	}
+}
+
+func BenchmarkDomainParser_Parse(b *testing.B) {
+	dp := hqgourl.NewDomainParser()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_, _ = dp.Parse("blog.www.example.co.uk")
+	}
+}
+
+// BenchmarkNewSuffixArray and BenchmarkSuffixArray_Lookup measure the suffix array the DomainParser
+// used to be built on, as a baseline for the DomainParser benchmarks above.
+func BenchmarkNewSuffixArray(b *testing.B) {
+	TLDs := []string{}
+
+	TLDs = append(TLDs, tlds.TLDs...)
+	TLDs = append(TLDs, tlds.PseudoTLDs...)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		suffixarray.New([]byte("\x00" + strings.Join(TLDs, "\x00") + "\x00"))
+	}
+}
+
+func BenchmarkSuffixArray_Lookup(b *testing.B) {
+	TLDs := []string{}
+
+	TLDs = append(TLDs, tlds.TLDs...)
+	TLDs = append(TLDs, tlds.PseudoTLDs...)
+
+	sa := suffixarray.New([]byte("\x00" + strings.Join(TLDs, "\x00") + "\x00"))
+
+	parts := strings.Split("blog.www.example.co.uk", ".")
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		for j := len(parts) - 1; j >= 0; j-- {
+			if len(sa.Lookup([]byte("\x00"+strings.Join(parts[j:], ".")+"\x00"), 1)) == 0 {
+				break
+			}
+		}
+	}
+}
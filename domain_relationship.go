@@ -0,0 +1,111 @@
+package hqgourl
+
+import (
+	"net"
+	"net/url"
+	"strings"
+)
+
+// IsSubdomainOf reports whether domain is a subdomain of parent, at any depth, comparing whole
+// labels: "www.example.com" is a subdomain of "example.com", "notexample.com" is not. A domain is
+// not a subdomain of itself. Case, IDNA forms and trailing dots are ignored.
+func IsSubdomainOf(domain, parent string) bool {
+	domain, parent = normalizeDomain(domain), normalizeDomain(parent)
+
+	if parent == "" {
+		return false
+	}
+
+	return strings.HasSuffix(domain, "."+parent)
+}
+
+// SameRegistrableDomain reports whether domains a and b have the same registrable domain (eTLD+1),
+// e.g. "www.example.co.uk" and "api.example.co.uk", but not "example.co.uk" and "other.co.uk".
+// Domains with no registrable domain, e.g. TLDs, have the same registrable domain as no other domain.
+// As in browsers, the private domains section of the public suffix list is matched whether or not
+// the DomainParser was created with DomainParserWithPrivateTLDs, so "alice.github.io" and
+// "bob.github.io" do not have the same registrable domain. Case, IDNA forms and trailing dots are
+// ignored.
+func (dp *DomainParser) SameRegistrableDomain(a, b string) bool {
+	registrableA := dp.registrableDomain(a)
+	registrableB := dp.registrableDomain(b)
+
+	return registrableA != "" && registrableA == registrableB
+}
+
+// privatePublicSuffixList is the PublicSuffixList of the private domains section of the built-in
+// public suffix list, matched by SameRegistrableDomain and SameSite on top of the DomainParser's
+// own SuffixMatcher.
+var privatePublicSuffixList = NewPublicSuffixList(TLDSectionPrivate)
+
+// registrableDomain returns the registrable domain of domain, normalized, or an empty string if
+// it has none or fails to parse. Unless the DomainParser already matches them, a rule of the
+// private domains section longer than the TLD the DomainParser matches takes precedence over it.
+func (dp *DomainParser) registrableDomain(domain string) (registrable string) {
+	domain = normalizeDomain(domain)
+
+	parsedDomain, err := dp.Parse(domain)
+	if err != nil {
+		return
+	}
+
+	registrable = parsedDomain.RegistrableDomain()
+
+	if dp.withPrivateTLDs || parsedDomain.TopLevel == "" {
+		return
+	}
+
+	labels := strings.Split(domain, ".")
+
+	length, section := privatePublicSuffixList.MatchSuffix(labels)
+	if section != TLDSectionPrivate || length <= len(strings.Split(parsedDomain.TopLevel, ".")) {
+		return
+	}
+
+	registrable = ""
+
+	if length < len(labels) {
+		registrable = strings.Join(labels[len(labels)-length-1:], ".")
+	}
+
+	return
+}
+
+// SameSite reports whether URLs a and b are schemefully same-site, as defined by the HTML standard
+// (https://html.spec.whatwg.org/multipage/browsers.html#same-site): their schemes are the same and
+// either their hosts are the same or they have the same registrable domain. Ports are not compared.
+// IP addresses, and domains with no registrable domain, are only same-site with themselves. As in
+// SameRegistrableDomain, the private domains section of the public suffix list is always matched,
+// so "https://alice.github.io" and "https://bob.github.io" are not same-site. Case, IDNA forms and
+// trailing dots of domains are ignored.
+func (dp *DomainParser) SameSite(a, b *url.URL) bool {
+	if !strings.EqualFold(a.Scheme, b.Scheme) {
+		return false
+	}
+
+	hostA, hostB := a.Hostname(), b.Hostname()
+
+	if net.ParseIP(hostA) != nil || net.ParseIP(hostB) != nil {
+		return net.ParseIP(hostA).Equal(net.ParseIP(hostB))
+	}
+
+	hostA, hostB = normalizeDomain(hostA), normalizeDomain(hostB)
+
+	if hostA == hostB {
+		return hostA != ""
+	}
+
+	return dp.SameRegistrableDomain(hostA, hostB)
+}
+
+// normalizeDomain returns domain without its trailing dot, and with each of its labels in the
+// Unicode, case-folded form TLDs are matched in, so that equivalent domains compare equal.
+func normalizeDomain(domain string) string {
+	labels := strings.Split(strings.TrimSuffix(domain, "."), ".")
+
+	for i := range labels {
+		labels[i] = toUnicodeLabel(labels[i])
+	}
+
+	return strings.Join(labels, ".")
+}
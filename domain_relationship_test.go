@@ -0,0 +1,117 @@
+package hqgourl_test
+
+import (
+	"fmt"
+	"net/url"
+	"testing"
+
+	"github.com/hueristiq/hqgourl"
+)
+
+func TestIsSubdomainOf(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		domain, parent string
+		expected       bool
+	}{
+		{"www.example.com", "example.com", true},
+		{"a.b.example.com", "example.com", true},
+		{"WWW.Example.COM.", "example.com", true},
+		{"www.例子.公司.cn", "xn--fsqu00a.xn--55qx5d.cn", true},
+		{"example.com", "example.com", false},
+		{"notexample.com", "example.com", false},
+		{"example.com", "www.example.com", false},
+		{"example.com", "", false},
+	}
+
+	for _, c := range cases {
+		c := c
+
+		t.Run(fmt.Sprintf("IsSubdomainOf(%q, %q)", c.domain, c.parent), func(t *testing.T) {
+			t.Parallel()
+
+			if got := hqgourl.IsSubdomainOf(c.domain, c.parent); got != c.expected {
+				t.Errorf("IsSubdomainOf(%q, %q) = %v, want %v", c.domain, c.parent, got, c.expected)
+			}
+		})
+	}
+}
+
+func TestDomainParser_SameRegistrableDomain(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		a, b     string
+		expected bool
+	}{
+		{"www.example.co.uk", "api.example.co.uk", true},
+		{"example.com", "WWW.EXAMPLE.COM.", true},
+		{"example.co.uk", "other.co.uk", false},
+		{"example.com", "notexample.com", false},
+		{"co.uk", "co.uk", false},
+		{"alice.github.io", "bob.github.io", false},
+		{"www.alice.github.io", "alice.github.io", true},
+		{"github.io", "github.io", false},
+		{"example.github.com", "other.github.com", true},
+	}
+
+	dp := hqgourl.NewDomainParser()
+
+	for _, c := range cases {
+		c := c
+
+		t.Run(fmt.Sprintf("SameRegistrableDomain(%q, %q)", c.a, c.b), func(t *testing.T) {
+			t.Parallel()
+
+			if got := dp.SameRegistrableDomain(c.a, c.b); got != c.expected {
+				t.Errorf("SameRegistrableDomain(%q, %q) = %v, want %v", c.a, c.b, got, c.expected)
+			}
+		})
+	}
+}
+
+func TestDomainParser_SameSite(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		a, b     string
+		expected bool
+	}{
+		{"https://www.example.com", "https://api.example.com:8443/path", true},
+		{"https://Example.COM.", "https://www.example.com", true},
+		{"https://www.example.com", "http://www.example.com", false},
+		{"https://alice.github.io", "https://bob.github.io", false},
+		{"https://www.alice.github.io", "https://alice.github.io", true},
+		{"https://example.com", "https://notexample.com", false},
+		{"https://co.uk", "https://co.uk", true},
+		{"https://co.uk", "https://example.co.uk", false},
+		{"https://127.0.0.1", "https://127.0.0.1:8443", true},
+		{"https://[::1]", "https://[0:0::1]", true},
+		{"https://127.0.0.1", "https://127.0.0.2", false},
+	}
+
+	dp := hqgourl.NewDomainParser()
+
+	for _, c := range cases {
+		c := c
+
+		t.Run(fmt.Sprintf("SameSite(%q, %q)", c.a, c.b), func(t *testing.T) {
+			t.Parallel()
+
+			a, err := url.Parse(c.a)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			b, err := url.Parse(c.b)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got := dp.SameSite(a, b); got != c.expected {
+				t.Errorf("SameSite(%q, %q) = %v, want %v", c.a, c.b, got, c.expected)
+			}
+		})
+	}
+}
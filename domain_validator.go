@@ -0,0 +1,178 @@
+package hqgourl
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// DomainValidator checks domain names against the syntax rules of RFC 1035 and RFC 1123:
+// labels of letters, digits and hyphens (LDH), neither starting nor ending with a hyphen,
+// at most 63 characters long, and at most 253 characters overall. Internationalized labels
+// are checked in their ASCII form. Optionally, it allows the underscored labels of SRV
+// ("_sip._tcp.example.com") and DKIM ("selector._domainkey.example.com") names. A DomainValidator
+// is read-only once created, so it is safe for concurrent use by multiple goroutines.
+type DomainValidator struct {
+	withUnderscoreLabels bool
+	withUnderscores      bool
+}
+
+// Validate checks domain, with or without a trailing dot, and returns a *DomainValidationError
+// describing the first rule it breaks, or nil if it breaks none.
+func (dv *DomainValidator) Validate(domain string) (err error) {
+	if domain == "" {
+		return &DomainValidationError{Domain: domain, LabelIndex: -1, Err: ErrDomainEmpty}
+	}
+
+	labels := strings.Split(strings.TrimSuffix(domain, "."), ".")
+
+	length := len(labels) - 1
+
+	for i, label := range labels {
+		ASCIILabel := label
+
+		if !isASCII(label) {
+			if ASCIILabel, err = idnaProfile.ToASCII(label); err != nil {
+				return &DomainValidationError{Domain: domain, Label: label, LabelIndex: i, Err: ErrLabelInvalidIDN}
+			}
+		}
+
+		if err = dv.validateLabel(ASCIILabel); err != nil {
+			return &DomainValidationError{Domain: domain, Label: label, LabelIndex: i, Err: err}
+		}
+
+		length += len(ASCIILabel)
+	}
+
+	if length > 253 {
+		return &DomainValidationError{Domain: domain, LabelIndex: -1, Err: ErrDomainTooLong}
+	}
+
+	return
+}
+
+// validateLabel checks a label, in its ASCII form, and returns the sentinel error of the first
+// rule it breaks.
+func (dv *DomainValidator) validateLabel(label string) (err error) {
+	switch {
+	case label == "":
+		return ErrLabelEmpty
+	case len(label) > 63:
+		return ErrLabelTooLong
+	case label[0] == '-':
+		return ErrLabelLeadingHyphen
+	case label[len(label)-1] == '-':
+		return ErrLabelTrailingHyphen
+	}
+
+	for i := 0; i < len(label); i++ {
+		c := label[i]
+
+		switch {
+		case 'a' <= c && c <= 'z', 'A' <= c && c <= 'Z', '0' <= c && c <= '9', c == '-':
+			continue
+		case c == '_' && dv.withUnderscores:
+			continue
+		case c == '_' && i == 0 && dv.withUnderscoreLabels:
+			if len(label) == 1 {
+				return ErrLabelUnderscore
+			}
+
+			continue
+		case c == '_':
+			return ErrLabelUnderscore
+		default:
+			return ErrLabelInvalidCharacter
+		}
+	}
+
+	return
+}
+
+// DomainValidatorOptionsFunc defines a function type for configuring a DomainValidator.
+type DomainValidatorOptionsFunc func(*DomainValidator)
+
+// DomainValidatorInterface defines the interface for domain validation functionality.
+type DomainValidatorInterface interface {
+	Validate(domain string) (err error)
+}
+
+// DomainValidationError is the error returned by DomainValidator.Validate. It records which rule
+// failed, as one of the ErrDomain* and ErrLabel* sentinel errors, and at which label.
+type DomainValidationError struct {
+	Domain     string // Domain being validated.
+	Label      string // Label breaking the rule, empty for rules on the whole domain.
+	LabelIndex int    // Index of the label breaking the rule, from the left, -1 for rules on the whole domain.
+	Err        error  // Sentinel error of the rule broken.
+}
+
+// Error returns a description of the rule broken, and where.
+func (e *DomainValidationError) Error() string {
+	if e.LabelIndex < 0 {
+		return fmt.Sprintf("invalid domain %q: %s", e.Domain, e.Err)
+	}
+
+	return fmt.Sprintf("invalid domain %q: label %d %q: %s", e.Domain, e.LabelIndex, e.Label, e.Err)
+}
+
+// Unwrap returns the sentinel error of the rule broken, for use with errors.Is.
+func (e *DomainValidationError) Unwrap() error {
+	return e.Err
+}
+
+var (
+	// ErrDomainEmpty is the rule broken by an empty domain.
+	ErrDomainEmpty = errors.New("empty domain")
+	// ErrDomainTooLong is the rule broken by a domain longer than 253 characters.
+	ErrDomainTooLong = errors.New("domain longer than 253 characters")
+	// ErrLabelEmpty is the rule broken by an empty label, e.g. in "www..example.com".
+	ErrLabelEmpty = errors.New("empty label")
+	// ErrLabelTooLong is the rule broken by a label longer than 63 characters.
+	ErrLabelTooLong = errors.New("label longer than 63 characters")
+	// ErrLabelLeadingHyphen is the rule broken by a label starting with a hyphen.
+	ErrLabelLeadingHyphen = errors.New("label starting with a hyphen")
+	// ErrLabelTrailingHyphen is the rule broken by a label ending with a hyphen.
+	ErrLabelTrailingHyphen = errors.New("label ending with a hyphen")
+	// ErrLabelUnderscore is the rule broken by a label with an underscore, other than the leading
+	// underscore of SRV and DKIM labels when allowed with DomainValidatorWithUnderscoreLabels.
+	ErrLabelUnderscore = errors.New("label with a misplaced underscore")
+	// ErrLabelInvalidCharacter is the rule broken by a label with a character other than letters,
+	// digits and hyphens.
+	ErrLabelInvalidCharacter = errors.New("label with a character other than letters, digits and hyphens")
+	// ErrLabelInvalidIDN is the rule broken by an internationalized label with no valid ASCII form.
+	ErrLabelInvalidIDN = errors.New("label with no valid IDNA ASCII form")
+
+	_ DomainValidatorInterface = &DomainValidator{}
+)
+
+// NewDomainValidator creates a new DomainValidator with the given options.
+func NewDomainValidator(opts ...DomainValidatorOptionsFunc) (dv *DomainValidator) {
+	dv = &DomainValidator{}
+
+	for _, opt := range opts {
+		opt(dv)
+	}
+
+	return
+}
+
+// DomainValidatorWithUnderscoreLabels returns a DomainValidatorOptionsFunc to allow labels starting
+// with an underscore, as used by SRV ("_sip._tcp.example.com"), DKIM ("selector._domainkey.example.com")
+// or DMARC ("_dmarc.example.com") names. Underscores anywhere else in a label remain invalid.
+func DomainValidatorWithUnderscoreLabels() DomainValidatorOptionsFunc {
+	return func(dv *DomainValidator) {
+		dv.withUnderscoreLabels = true
+	}
+}
+
+// isASCII reports whether s is made of ASCII characters only.
+func isASCII(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] >= utf8.RuneSelf {
+			return false
+		}
+	}
+
+	return true
+}
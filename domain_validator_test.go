@@ -0,0 +1,102 @@
+package hqgourl_test
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hueristiq/hqgourl"
+)
+
+func TestDomainValidator_Validate(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		domain             string
+		expectedErr        error
+		expectedLabelIndex int
+	}{
+		{"example.com", nil, 0},
+		{"www.example.com.", nil, 0},
+		{"WWW.Example.COM", nil, 0},
+		{"123.example.com", nil, 0},
+		{"my-site.example.com", nil, 0},
+		{"例子.公司.cn", nil, 0},
+		{"xn--fsqu00a.xn--55qx5d.cn", nil, 0},
+		{strings.Repeat("a", 63) + ".com", nil, 0},
+		{"", hqgourl.ErrDomainEmpty, -1},
+		{strings.Repeat(strings.Repeat("a", 62)+".", 4) + "com", hqgourl.ErrDomainTooLong, -1},
+		{"www..example.com", hqgourl.ErrLabelEmpty, 1},
+		{".example.com", hqgourl.ErrLabelEmpty, 0},
+		{strings.Repeat("a", 64) + ".com", hqgourl.ErrLabelTooLong, 0},
+		{"-www.example.com", hqgourl.ErrLabelLeadingHyphen, 0},
+		{"www.example-.com", hqgourl.ErrLabelTrailingHyphen, 1},
+		{"www.exa mple.com", hqgourl.ErrLabelInvalidCharacter, 1},
+		{"www.example.com/", hqgourl.ErrLabelInvalidCharacter, 2},
+		{"_sip._tcp.example.com", hqgourl.ErrLabelUnderscore, 0},
+		{"my_host.example.com", hqgourl.ErrLabelUnderscore, 0},
+	}
+
+	dv := hqgourl.NewDomainValidator()
+
+	for _, c := range cases {
+		c := c
+
+		t.Run(fmt.Sprintf("Validate(%q)", c.domain), func(t *testing.T) {
+			t.Parallel()
+
+			err := dv.Validate(c.domain)
+
+			if !errors.Is(err, c.expectedErr) {
+				t.Fatalf("Validate(%q) error = %v, want %v", c.domain, err, c.expectedErr)
+			}
+
+			if c.expectedErr == nil {
+				return
+			}
+
+			var validationErr *hqgourl.DomainValidationError
+
+			if !errors.As(err, &validationErr) {
+				t.Fatalf("Validate(%q) error = %T, want *hqgourl.DomainValidationError", c.domain, err)
+			}
+
+			if validationErr.LabelIndex != c.expectedLabelIndex {
+				t.Errorf("Validate(%q) error LabelIndex = %d, want %d", c.domain, validationErr.LabelIndex, c.expectedLabelIndex)
+			}
+		})
+	}
+}
+
+func TestDomainValidator_ValidateWithUnderscoreLabels(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		domain      string
+		expectedErr error
+	}{
+		{"_sip._tcp.example.com", nil},
+		{"selector._domainkey.example.com", nil},
+		{"_dmarc.example.com", nil},
+		{"my_host.example.com", hqgourl.ErrLabelUnderscore},
+		{"_.example.com", hqgourl.ErrLabelUnderscore},
+		{"-_sip.example.com", hqgourl.ErrLabelLeadingHyphen},
+	}
+
+	dv := hqgourl.NewDomainValidator(
+		hqgourl.DomainValidatorWithUnderscoreLabels(),
+	)
+
+	for _, c := range cases {
+		c := c
+
+		t.Run(fmt.Sprintf("Validate(%q)", c.domain), func(t *testing.T) {
+			t.Parallel()
+
+			if err := dv.Validate(c.domain); !errors.Is(err, c.expectedErr) {
+				t.Errorf("Validate(%q) error = %v, want %v", c.domain, err, c.expectedErr)
+			}
+		})
+	}
+}
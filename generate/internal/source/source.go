@@ -0,0 +1,199 @@
+// Package source reads the data sources of the generators, from URLs or local files, along with
+// their provenance, and writes the generated files, or their differences with the current ones.
+package source
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"go/format"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+)
+
+// Source is the content of a data source, with its provenance.
+type Source struct {
+	Location string // URL or local file path the content is read from.
+	Date     string // Date the content is fetched, or, for local files, last modified, as YYYY-MM-DD.
+	SHA256   string // Hex-encoded SHA-256 hash of the content.
+	Content  []byte
+}
+
+// Read reads the source at location, an HTTP(S) URL or a local file path, e.g. a snapshot of the
+// URL's content for offline generation.
+func Read(location string) (source *Source, err error) {
+	source = &Source{
+		Location: location,
+	}
+
+	var date time.Time
+
+	if strings.HasPrefix(location, "http://") || strings.HasPrefix(location, "https://") {
+		source.Content, err = fetch(location)
+
+		date = time.Now()
+	} else {
+		var info os.FileInfo
+
+		if info, err = os.Stat(location); err == nil {
+			source.Content, err = os.ReadFile(location)
+
+			date = info.ModTime()
+		}
+	}
+
+	if err != nil {
+		err = fmt.Errorf("error reading source %s: %w", location, err)
+
+		return
+	}
+
+	sum := sha256.Sum256(source.Content)
+
+	source.Date = date.UTC().Format("2006-01-02")
+	source.SHA256 = hex.EncodeToString(sum[:])
+
+	return
+}
+
+func fetch(URL string) (content []byte, err error) {
+	var res *http.Response
+
+	res, err = http.Get(URL)
+	if err != nil {
+		return
+	}
+
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		err = fmt.Errorf("unexpected status %s", res.Status)
+
+		return
+	}
+
+	return io.ReadAll(res.Body)
+}
+
+// Write formats content as Go source and writes it to output, see WriteFile. In diff mode, only
+// the entries of the generated lists and maps, and the constants, are compared, see goEntries.
+func Write(output string, content []byte, diff bool, w io.Writer) (err error) {
+	if content, err = format.Source(content); err != nil {
+		err = fmt.Errorf("error formatting %s: %w", output, err)
+
+		return
+	}
+
+	if diff {
+		return printDiff(output, content, goEntries, w)
+	}
+
+	return os.WriteFile(output, content, 0o644)
+}
+
+// WriteFile writes content to output. In diff mode, it writes nothing and instead prints to w the
+// lines, other than comments, content adds ("+") to and removes ("-") from the current output,
+// e.g. the rules of a public suffix list.
+func WriteFile(output string, content []byte, diff bool, w io.Writer) (err error) {
+	if diff {
+		return printDiff(output, content, textEntries, w)
+	}
+
+	return os.WriteFile(output, content, 0o644)
+}
+
+// printDiff prints to w the entries content adds ("+") to and removes ("-") from the current
+// output, as returned by entries, and a summary.
+func printDiff(output string, content []byte, entries func(content []byte) []string, w io.Writer) (err error) {
+	current, err := os.ReadFile(output)
+	if err != nil && !os.IsNotExist(err) {
+		return
+	}
+
+	added, removed := Diff(entries(current), entries(content))
+
+	for _, line := range removed {
+		fmt.Fprintf(w, "- %s\n", line)
+	}
+
+	for _, line := range added {
+		fmt.Fprintf(w, "+ %s\n", line)
+	}
+
+	fmt.Fprintf(w, "%s: %d added, %d removed\n", output, len(added), len(removed))
+
+	return nil
+}
+
+// Diff returns the entries of next not in current, and the entries of current not in next, in
+// their order.
+func Diff(current, next []string) (added, removed []string) {
+	inCurrent := make(map[string]bool, len(current))
+
+	for _, entry := range current {
+		inCurrent[entry] = true
+	}
+
+	inNext := make(map[string]bool, len(next))
+
+	for _, entry := range next {
+		inNext[entry] = true
+
+		if !inCurrent[entry] {
+			added = append(added, entry)
+		}
+	}
+
+	for _, entry := range current {
+		if !inNext[entry] {
+			removed = append(removed, entry)
+		}
+	}
+
+	return
+}
+
+// goEntries returns the entries of generated Go source content: the elements of its lists and
+// maps, which the generators write as raw string literals, e.g. "`com`,", and its constants,
+// e.g. "UnicodeVersion = `15.0.0`". Other lines, e.g. of precomputed tables, are left out.
+func goEntries(content []byte) (entries []string) {
+	for _, line := range lines(content) {
+		switch {
+		case strings.HasPrefix(line, "`"):
+		case strings.HasPrefix(line, "const ") && strings.Contains(line, " = "):
+		case strings.Contains(line, " = `"):
+		default:
+			continue
+		}
+
+		entries = append(entries, line)
+	}
+
+	return
+}
+
+// textEntries returns the non-blank lines of text content, other than "//" comments.
+func textEntries(content []byte) (entries []string) {
+	for _, line := range lines(content) {
+		if !strings.HasPrefix(line, "//") {
+			entries = append(entries, line)
+		}
+	}
+
+	return
+}
+
+// lines returns the non-blank lines of content, trimmed.
+func lines(content []byte) (lines []string) {
+	for _, line := range bytes.Split(content, []byte("\n")) {
+		if line = bytes.TrimSpace(line); len(line) > 0 {
+			lines = append(lines, string(line))
+		}
+	}
+
+	return
+}
@@ -0,0 +1,237 @@
+// Package suffixtable builds the precomputed suffix table of the internal/table package: a trie
+// of the public suffix list rules, flattened into an array of nodes the package reads in place,
+// with no construction at runtime.
+package suffixtable
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/net/idna"
+)
+
+// Rules holds the rules of one section of the public suffix list, split by rule type: normal
+// rules, wildcard rules ("*." stripped) and exception rules ("!" stripped).
+type Rules struct {
+	Normal    []string
+	Wildcard  []string
+	Exception []string
+}
+
+// Rule bits, as read by the internal/table package.
+const (
+	ruleNormal uint64 = 1 << iota
+	ruleWildcard
+	ruleException
+)
+
+// Layout of a node, a uint64, from the least significant bit:
+//   - 3 bits: rules of the ICANN section
+//   - 3 bits: rules of the private section
+//   - 8 bits: length of the label, in bytes
+//   - 20 bits: offset of the label in the text
+//   - 14 bits: number of children
+//   - 16 bits: index of the first child
+//
+// The children of a node are contiguous and sorted by label.
+const (
+	privateShift     = 3
+	labelLengthShift = 6
+	labelLengthBits  = 8
+	labelOffsetShift = labelLengthShift + labelLengthBits
+	labelOffsetBits  = 20
+	childrenShift    = labelOffsetShift + labelOffsetBits
+	childrenBits     = 14
+	firstChildShift  = childrenShift + childrenBits
+	firstChildBits   = 64 - firstChildShift
+)
+
+// idnaProfile matches the profile the hqgourl package converts labels with.
+var idnaProfile = idna.New(
+	idna.MapForLookup(),
+	idna.Transitional(false),
+	idna.StrictDomainName(false),
+	idna.CheckHyphens(false),
+)
+
+type node struct {
+	label    string
+	rules    uint64
+	children map[string]*node
+}
+
+func (n *node) add(suffix string, rule uint64) {
+	labels := strings.Split(suffix, ".")
+
+	for i := len(labels) - 1; i >= 0; i-- {
+		label := strings.ToLower(labels[i])
+
+		if unicodeLabel, err := idnaProfile.ToUnicode(label); err == nil {
+			label = unicodeLabel
+		}
+
+		child, ok := n.children[label]
+		if !ok {
+			child = &node{label: label}
+
+			if n.children == nil {
+				n.children = map[string]*node{}
+			}
+
+			n.children[label] = child
+		}
+
+		n = child
+	}
+
+	n.rules |= rule
+}
+
+func (n *node) addRules(rules Rules, shift int) {
+	for _, suffix := range rules.Normal {
+		n.add(suffix, ruleNormal<<shift)
+	}
+
+	for _, suffix := range rules.Wildcard {
+		n.add(suffix, ruleWildcard<<shift)
+	}
+
+	for _, suffix := range rules.Exception {
+		n.add(suffix, ruleException<<shift)
+	}
+}
+
+// Build returns the text holding the labels of the suffix table of the ICANN and private rules,
+// and its nodes, the first one being the root.
+func Build(ICANN, private Rules) (text string, nodes []uint64, err error) {
+	root := &node{}
+
+	root.addRules(ICANN, 0)
+	root.addRules(private, privateShift)
+
+	// Lay out the nodes breadth-first, for the children of each node to be contiguous.
+	queue := []*node{root}
+
+	for i := 0; i < len(queue); i++ {
+		queue = append(queue, sortedChildren(queue[i])...)
+	}
+
+	text = buildText(queue)
+
+	nodes = make([]uint64, len(queue))
+
+	next := 1
+
+	for i, n := range queue {
+		offset := strings.Index(text, n.label)
+
+		switch {
+		case len(n.label) >= 1<<labelLengthBits:
+			err = fmt.Errorf("label %q too long for the suffix table", n.label)
+		case offset >= 1<<labelOffsetBits:
+			err = fmt.Errorf("text too long for the suffix table")
+		case len(n.children) >= 1<<childrenBits:
+			err = fmt.Errorf("label %q has too many children for the suffix table", n.label)
+		case next >= 1<<firstChildBits:
+			err = fmt.Errorf("too many nodes for the suffix table")
+		}
+
+		if err != nil {
+			return
+		}
+
+		nodes[i] = n.rules |
+			uint64(len(n.label))<<labelLengthShift |
+			uint64(offset)<<labelOffsetShift |
+			uint64(len(n.children))<<childrenShift |
+			uint64(next)<<firstChildShift
+
+		next += len(n.children)
+	}
+
+	return
+}
+
+// sortedChildren returns the children of n, sorted by label.
+func sortedChildren(n *node) (children []*node) {
+	children = make([]*node, 0, len(n.children))
+
+	for _, child := range n.children {
+		children = append(children, child)
+	}
+
+	sort.Slice(children, func(i, j int) bool {
+		return children[i].label < children[j].label
+	})
+
+	return
+}
+
+// buildText concatenates the labels of nodes, longest first, skipping those that already are
+// substrings of the text, e.g. "co" of "com".
+func buildText(nodes []*node) (text string) {
+	labels := make([]string, 0, len(nodes))
+
+	for _, n := range nodes {
+		labels = append(labels, n.label)
+	}
+
+	sort.Slice(labels, func(i, j int) bool {
+		if len(labels[i]) != len(labels[j]) {
+			return len(labels[i]) > len(labels[j])
+		}
+
+		return labels[i] < labels[j]
+	})
+
+	var b strings.Builder
+
+	for _, label := range labels {
+		if !strings.Contains(b.String(), label) {
+			b.WriteString(label)
+		}
+	}
+
+	return b.String()
+}
+
+// FormatText splits text into quoted Go string literals of about 64 bytes each, cut between runes.
+func FormatText(text string) (lines []string) {
+	for len(text) > 0 {
+		end := 0
+
+		for end < len(text) && end < 64 {
+			_, size := utf8.DecodeRuneInString(text[end:])
+
+			end += size
+		}
+
+		lines = append(lines, strconv.Quote(text[:end]))
+
+		text = text[end:]
+	}
+
+	return
+}
+
+// FormatNodes formats nodes as Go hexadecimal literals, 4 to a line.
+func FormatNodes(nodes []uint64) (lines []string) {
+	for i := 0; i < len(nodes); i += 4 {
+		var line strings.Builder
+
+		for j := i; j < i+4 && j < len(nodes); j++ {
+			if j > i {
+				line.WriteByte(' ')
+			}
+
+			fmt.Fprintf(&line, "0x%016x,", nodes[j])
+		}
+
+		lines = append(lines, line.String())
+	}
+
+	return
+}
@@ -1,25 +1,36 @@
 package main
 
 import (
+	"bytes"
 	"encoding/csv"
 	"fmt"
 	"io"
-	"net/http"
 	"os"
 	"strings"
 	"text/template"
 
 	"github.com/hueristiq/hqgolog"
+	"github.com/hueristiq/hqgourl/generate/internal/source"
 	"github.com/spf13/pflag"
 )
 
 var (
-	output string
+	output  string
+	schemes string
+	diff    bool
 
 	schemesTmpl = template.Must(template.New("schemes").Parse(`// This file is autogenerated by https://github.com/hueristiq/hqgourl/blob/main/generate/schemes/main.go. Please do not edit manually.
 
 package schemes
 
+// Provenance of Schemes: the source it is generated from, the date the source was fetched, and
+// the hex-encoded SHA-256 hash of the source.
+const (
+	Source       = ` + "`" + `{{.Source.Location}}` + "`" + `
+	SourceDate   = ` + "`" + `{{.Source.Date}}` + "`" + `
+	SourceSHA256 = ` + "`" + `{{.Source.SHA256}}` + "`" + `
+)
+
 // Schemes is a sorted list of all IANA assigned schemes.
 // This list is fetched from:
 //   - https://www.iana.org/assignments/uri-schemes/uri-schemes-1.csv
@@ -31,6 +42,8 @@ var Schemes = []string{
 
 func init() {
 	pflag.StringVarP(&output, "output", "o", "", "")
+	pflag.StringVar(&schemes, "schemes", "https://www.iana.org/assignments/uri-schemes/uri-schemes-1.csv", "")
+	pflag.BoolVar(&diff, "diff", false, "")
 
 	pflag.CommandLine.SortFlags = false
 	pflag.Usage = func() {
@@ -39,6 +52,8 @@ func init() {
 
 		h += "\nOPTIONS:\n"
 		h += " -o, --output string                 output package file path\n"
+		h += "     --schemes string                IANA URI schemes CSV URL or local file path\n"
+		h += "     --diff bool                     print the entries added and removed instead of writing\n"
 
 		fmt.Fprintln(os.Stderr, h)
 	}
@@ -49,39 +64,42 @@ func init() {
 func main() {
 	hqgolog.Info().Msgf("Generating %s...", output)
 
-	schemes := getSchemesList()
-
-	if err := writeSchemes(schemes, output); err != nil {
+	src, err := source.Read(schemes)
+	if err != nil {
 		hqgolog.Fatal().Msg(err.Error())
 	}
-}
 
-func getSchemesList() (schemes []string) {
-	schemesSourcesURL := "https://www.iana.org/assignments/uri-schemes/uri-schemes-1.csv"
-
-	resp, err := http.Get(schemesSourcesURL)
+	list, err := getSchemesList(src.Content)
 	if err != nil {
 		hqgolog.Fatal().Msg(err.Error())
 	}
 
-	defer resp.Body.Close()
+	if err := writeSchemes(src, list, output); err != nil {
+		hqgolog.Fatal().Msg(err.Error())
+	}
+}
 
-	r := csv.NewReader(resp.Body)
+func getSchemesList(content []byte) (schemes []string, err error) {
+	r := csv.NewReader(bytes.NewReader(content))
 
-	if _, err := r.Read(); err != nil { // ignore headers
-		hqgolog.Fatal().Msg(err.Error())
+	if _, err = r.Read(); err != nil { // ignore headers
+		return
 	}
 
 	schemes = make([]string, 0)
 
 	for {
-		record, err := r.Read()
+		var record []string
+
+		record, err = r.Read()
 		if err == io.EOF {
+			err = nil
+
 			break
 		}
 
 		if err != nil {
-			hqgolog.Fatal().Msg(err.Error())
+			return
 		}
 
 		if strings.Contains(record[0], "OBSOLETE") {
@@ -94,17 +112,18 @@ func getSchemesList() (schemes []string) {
 	return
 }
 
-func writeSchemes(schemes []string, output string) (err error) {
-	f, err := os.Create(output)
-	if err != nil {
-		return
-	}
-
-	defer f.Close()
+func writeSchemes(src *source.Source, schemes []string, output string) (err error) {
+	var buf bytes.Buffer
 
-	return schemesTmpl.Execute(f, struct {
+	if err = schemesTmpl.Execute(&buf, struct {
+		Source  *source.Source
 		Schemes []string
 	}{
+		Source:  src,
 		Schemes: schemes,
-	})
+	}); err != nil {
+		return
+	}
+
+	return source.Write(output, buf.Bytes(), diff, os.Stdout)
 }
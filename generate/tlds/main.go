@@ -2,8 +2,8 @@ package main
 
 import (
 	"bufio"
+	"bytes"
 	"fmt"
-	"net/http"
 	"os"
 	"regexp"
 	"sort"
@@ -11,28 +11,142 @@ import (
 	"text/template"
 
 	"github.com/hueristiq/hqgolog"
+	"github.com/hueristiq/hqgourl/generate/internal/source"
+	"github.com/hueristiq/hqgourl/generate/internal/suffixtable"
 	"github.com/spf13/pflag"
+	"golang.org/x/net/idna"
 )
 
 var (
-	output string
+	output                 string
+	metadataOutput         string
+	publicSuffixListOutput string
+	tableOutput            string
+	IANA                   string
+	publicSuffixList       string
+	publicSuffixListVer    string
+	rootZoneDatabase       string
+	brands                 string
+	diff                   bool
 
 	tmpl = template.Must(template.New("schemes").Parse(`// This file is autogenerated by https://github.com/hueristiq/hqgourl/blob/main/generate/tlds/main.go. Please do not edit manually.
 
 package tlds
 
+// Provenance of the TLDs: the sources they are generated from, the dates the sources were fetched,
+// the hex-encoded SHA-256 hashes of the sources, and the version of the public suffix list, as
+// declared in its "// VERSION:" header or given to the generator. The IANA constants are empty if
+// the TLDs are generated from the public suffix list alone.
+const (
+	IANASource       = ` + "`" + `{{with .IANA}}{{.Location}}{{end}}` + "`" + `
+	IANASourceDate   = ` + "`" + `{{with .IANA}}{{.Date}}{{end}}` + "`" + `
+	IANASourceSHA256 = ` + "`" + `{{with .IANA}}{{.SHA256}}{{end}}` + "`" + `
+
+	PublicSuffixListSource       = ` + "`" + `{{.PublicSuffixList.Location}}` + "`" + `
+	PublicSuffixListSourceDate   = ` + "`" + `{{.PublicSuffixList.Date}}` + "`" + `
+	PublicSuffixListSourceSHA256 = ` + "`" + `{{.PublicSuffixList.SHA256}}` + "`" + `
+	PublicSuffixListVersion      = ` + "`" + `{{.PublicSuffixListVersion}}` + "`" + `
+)
+
 // TLDs is a sorted list of public TLDs and eTLDs.
 // The list is fetched from:
+{{- if .IANA}}
 //   - https://data.iana.org/TLD/tlds-alpha-by-domain.txt
+{{- end}}
 //   - https://publicsuffix.org/list/public_suffix_list.dat
 var TLDs = []string{
 {{range $_, $TLD := .TLDs}}` + "\t`" + `{{$TLD}}` + "`" + `,
 {{end}}}
+
+// WildcardTLDs is a sorted list of eTLDs declared by wildcard rules ("*.<eTLD>") in the
+// public suffix list. Every label directly under one of these eTLDs is itself an eTLD.
+// The "*." prefix is stripped.
+var WildcardTLDs = []string{
+{{range $_, $TLD := .WildcardTLDs}}` + "\t`" + `{{$TLD}}` + "`" + `,
+{{end}}}
+
+// ExceptionTLDs is a sorted list of exception rules ("!<domain>") in the public suffix
+// list. Each entry overrides a wildcard rule: its parent is the eTLD, not the entry itself.
+// The "!" prefix is stripped.
+var ExceptionTLDs = []string{
+{{range $_, $TLD := .ExceptionTLDs}}` + "\t`" + `{{$TLD}}` + "`" + `,
+{{end}}}
+
+// PrivateTLDs is a sorted list of the eTLDs in the private domains section of the public
+// suffix list, i.e. suffixes under which a company lets its customers register names,
+// e.g. "github.io" or "herokuapp.com".
+var PrivateTLDs = []string{
+{{range $_, $TLD := .PrivateTLDs}}` + "\t`" + `{{$TLD}}` + "`" + `,
+{{end}}}
+
+// PrivateWildcardTLDs is the private domains section counterpart of WildcardTLDs.
+var PrivateWildcardTLDs = []string{
+{{range $_, $TLD := .PrivateWildcardTLDs}}` + "\t`" + `{{$TLD}}` + "`" + `,
+{{end}}}
+
+// PrivateExceptionTLDs is the private domains section counterpart of ExceptionTLDs.
+var PrivateExceptionTLDs = []string{
+{{range $_, $TLD := .PrivateExceptionTLDs}}` + "\t`" + `{{$TLD}}` + "`" + `,
+{{end}}}
+`))
+
+	tableTmpl = template.Must(template.New("table").Parse(`// This file is autogenerated by https://github.com/hueristiq/hqgourl/blob/main/generate/tlds/main.go. Please do not edit manually.
+
+package table
+
+// text holds the labels of the suffix table, in their Unicode form. Nodes refer to their
+// label by offset and length, labels being shared as substrings of longer ones where possible.
+const text = "" +
+{{range $_, $line := .Text}}` + "\t" + `{{$line}} +
+{{end}}` + "\t" + `""
+
+// nodes is the suffix table of tlds.TLDs, tlds.WildcardTLDs, tlds.ExceptionTLDs and the private
+// TLDs: a trie of their rules keyed by their labels in reverse order, laid out breadth-first, the
+// root first. See Node for the layout of a node.
+var nodes = [...]uint64{
+{{range $_, $line := .Nodes}}` + "\t" + `{{$line}}
+{{end}}}
+`))
+
+	metadataTmpl = template.Must(template.New("metadata").Parse(`// This file is autogenerated by https://github.com/hueristiq/hqgourl/blob/main/generate/tlds/main.go. Please do not edit manually.
+
+package tlds
+
+// Provenance of the TLDs metadata: the source it is generated from, the date the source was fetched,
+// and the hex-encoded SHA-256 hash of the source. They are empty if the metadata is derived from the
+// public suffix list alone, see PublicSuffixListSource.
+const (
+	RootZoneDatabaseSource       = ` + "`" + `{{with .RootZoneDatabase}}{{.Location}}{{end}}` + "`" + `
+	RootZoneDatabaseSourceDate   = ` + "`" + `{{with .RootZoneDatabase}}{{.Date}}{{end}}` + "`" + `
+	RootZoneDatabaseSourceSHA256 = ` + "`" + `{{with .RootZoneDatabase}}{{.SHA256}}{{end}}` + "`" + `
+)
+
+// metadata maps TLDs, in their Unicode form, to their metadata.
+// The metadata is fetched from:
+{{- if .RootZoneDatabase}}
+//   - https://www.iana.org/domains/root/db
+//   - https://publicsuffix.org/list/public_suffix_list.dat, for the country codes of IDN ccTLDs
+{{- else}}
+//   - https://publicsuffix.org/list/public_suffix_list.dat, for its ccTLDs and the legacy TLDs only,
+//     whose types are known without the root zone database
+{{- end}}
+var metadata = map[string]TLDMetadata{
+{{range $_, $TLD := .Metadata}}` + "\t`" + `{{$TLD.TLD}}` + "`" + `: {Type: {{$TLD.Type}}, CountryCode: ` + "`" + `{{$TLD.CountryCode}}` + "`" + `},
+{{end}}}
 `))
 )
 
 func init() {
 	pflag.StringVarP(&output, "output", "o", "", "")
+	pflag.StringVarP(&metadataOutput, "metadata-output", "m", "", "")
+	pflag.StringVarP(&publicSuffixListOutput, "public-suffix-list-output", "p", "", "")
+	pflag.StringVarP(&tableOutput, "table-output", "t", "", "")
+	pflag.StringVar(&IANA, "iana", "https://data.iana.org/TLD/tlds-alpha-by-domain.txt", "")
+	pflag.StringVar(&publicSuffixList, "public-suffix-list", "https://publicsuffix.org/list/public_suffix_list.dat", "")
+	pflag.StringVar(&publicSuffixListVer, "public-suffix-list-version", "", "")
+	pflag.StringVar(&rootZoneDatabase, "root-zone-database", "https://www.iana.org/domains/root/db", "")
+	pflag.StringVar(&brands, "brands", "", "")
+	pflag.BoolVar(&diff, "diff", false, "")
 
 	pflag.CommandLine.SortFlags = false
 	pflag.Usage = func() {
@@ -41,6 +155,15 @@ func init() {
 
 		h += "\nOPTIONS:\n"
 		h += " -o, --output string                 output package file path\n"
+		h += " -m, --metadata-output string        output metadata package file path\n"
+		h += " -p, --public-suffix-list-output string output raw public suffix list file path, to embed\n"
+		h += " -t, --table-output string           output precomputed suffix table package file path\n"
+		h += "     --iana string                   IANA TLDs list URL or local file path, empty to skip\n"
+		h += "     --public-suffix-list string     public suffix list URL or local file path\n"
+		h += "     --public-suffix-list-version string version of a public suffix list without a VERSION header\n"
+		h += "     --root-zone-database string     IANA root zone database URL or local file path, empty to derive the metadata from the public suffix list\n"
+		h += "     --brands string                 file listing brand TLDs, one per line\n"
+		h += "     --diff bool                     print the entries added and removed instead of writing\n"
 
 		fmt.Fprintln(os.Stderr, h)
 	}
@@ -51,51 +174,165 @@ func init() {
 func main() {
 	hqgolog.Info().Msgf("Generating %s...", output)
 
-	TLDs, err := getTLDsFromIANA()
+	publicSuffixListSource, err := source.Read(publicSuffixList)
 	if err != nil {
 		hqgolog.Fatal().Msgf(err.Error())
 	}
 
-	eTLDs, err := getEffectiveTLDsFromPublicSuffix()
+	TLDs := []string{}
+
+	// The IANA list is optional, the public suffix list holds the delegated TLDs as well.
+	var IANASource *source.Source
+
+	if IANA != "" {
+		if IANASource, err = source.Read(IANA); err != nil {
+			hqgolog.Fatal().Msgf(err.Error())
+		}
+
+		if TLDs, err = getTLDsFromIANA(IANASource.Content); err != nil {
+			hqgolog.Fatal().Msgf(err.Error())
+		}
+	}
+
+	ICANN, private, countryCodes, err := getEffectiveTLDsFromPublicSuffix(publicSuffixListSource.Content)
 	if err != nil {
 		hqgolog.Fatal().Msgf(err.Error())
 	}
 
-	TLDs = append(TLDs, eTLDs...)
+	TLDs = append(TLDs, ICANN.normal...)
+	TLDs = append(TLDs, ICANN.TLDs()...)
+
+	version := getPublicSuffixListVersion(publicSuffixListSource.Content)
+	if version == "" {
+		version = publicSuffixListVer
+	}
 
 	sort.Strings(TLDs)
 
-	f, err := os.Create(output)
+	ICANN.sort()
+	private.sort()
+
+	var buf bytes.Buffer
+
+	if err = tmpl.Execute(&buf, struct {
+		IANA                    *source.Source
+		PublicSuffixList        *source.Source
+		PublicSuffixListVersion string
+		TLDs                    []string
+		WildcardTLDs            []string
+		ExceptionTLDs           []string
+		PrivateTLDs             []string
+		PrivateWildcardTLDs     []string
+		PrivateExceptionTLDs    []string
+	}{
+		IANA:                    IANASource,
+		PublicSuffixList:        publicSuffixListSource,
+		PublicSuffixListVersion: version,
+		TLDs:                    remDuplicates(TLDs),
+		WildcardTLDs:            remDuplicates(ICANN.wildcard),
+		ExceptionTLDs:           remDuplicates(ICANN.exception),
+		PrivateTLDs:             remDuplicates(private.normal),
+		PrivateWildcardTLDs:     remDuplicates(private.wildcard),
+		PrivateExceptionTLDs:    remDuplicates(private.exception),
+	}); err != nil {
+		hqgolog.Fatal().Msgf(err.Error())
+	}
+
+	if err = source.Write(output, buf.Bytes(), diff, os.Stdout); err != nil {
+		hqgolog.Fatal().Msgf(err.Error())
+	}
+
+	// The raw public suffix list is embedded next to the TLDs generated from it.
+	if publicSuffixListOutput != "" {
+		hqgolog.Info().Msgf("Generating %s...", publicSuffixListOutput)
+
+		if err = source.WriteFile(publicSuffixListOutput, publicSuffixListSource.Content, diff, os.Stdout); err != nil {
+			hqgolog.Fatal().Msgf(err.Error())
+		}
+	}
+
+	// The table is derived from the TLDs, its differences are those of the TLDs.
+	if tableOutput != "" && !diff {
+		hqgolog.Info().Msgf("Generating %s...", tableOutput)
+
+		var text string
+
+		var nodes []uint64
+
+		if text, nodes, err = suffixtable.Build(
+			suffixtable.Rules{Normal: remDuplicates(TLDs), Wildcard: remDuplicates(ICANN.wildcard), Exception: remDuplicates(ICANN.exception)},
+			suffixtable.Rules{Normal: remDuplicates(private.normal), Wildcard: remDuplicates(private.wildcard), Exception: remDuplicates(private.exception)},
+		); err != nil {
+			hqgolog.Fatal().Msgf(err.Error())
+		}
+
+		buf.Reset()
+
+		if err = tableTmpl.Execute(&buf, struct {
+			Text  []string
+			Nodes []string
+		}{
+			Text:  suffixtable.FormatText(text),
+			Nodes: suffixtable.FormatNodes(nodes),
+		}); err != nil {
+			hqgolog.Fatal().Msgf(err.Error())
+		}
+
+		if err = source.Write(tableOutput, buf.Bytes(), diff, os.Stdout); err != nil {
+			hqgolog.Fatal().Msgf(err.Error())
+		}
+	}
+
+	if metadataOutput == "" {
+		return
+	}
+
+	hqgolog.Info().Msgf("Generating %s...", metadataOutput)
+
+	var rootZoneDatabaseSource *source.Source
+
+	var metadata []TLDMetadata
+
+	// Without the root zone database, the metadata of the TLDs of the public suffix list is derived from it.
+	if rootZoneDatabase != "" {
+		if rootZoneDatabaseSource, err = source.Read(rootZoneDatabase); err != nil {
+			hqgolog.Fatal().Msgf(err.Error())
+		}
+
+		metadata, err = getTLDMetadataFromIANA(rootZoneDatabaseSource.Content, countryCodes)
+	} else {
+		metadata, err = getTLDMetadataFromPublicSuffix(publicSuffixListSource.Content, countryCodes)
+	}
+
 	if err != nil {
 		hqgolog.Fatal().Msgf(err.Error())
 	}
 
-	defer f.Close()
+	buf.Reset()
 
-	if err = tmpl.Execute(f, struct {
-		TLDs []string
+	if err = metadataTmpl.Execute(&buf, struct {
+		RootZoneDatabase *source.Source
+		Metadata         []TLDMetadata
 	}{
-		TLDs: remDuplicates(TLDs),
+		RootZoneDatabase: rootZoneDatabaseSource,
+		Metadata:         metadata,
 	}); err != nil {
 		hqgolog.Fatal().Msgf(err.Error())
 	}
-}
-
-func getTLDsFromIANA() (TLDs []string, err error) {
-	TLDs = []string{}
 
-	var res *http.Response
-
-	res, err = http.Get("https://data.iana.org/TLD/tlds-alpha-by-domain.txt")
-	if err != nil {
-		return
+	if err = source.Write(metadataOutput, buf.Bytes(), diff, os.Stdout); err != nil {
+		hqgolog.Fatal().Msgf(err.Error())
 	}
+}
 
-	defer res.Body.Close()
+// getTLDsFromIANA
+// returns the TLDs of the IANA TLDs list content.
+func getTLDsFromIANA(content []byte) (TLDs []string, err error) {
+	TLDs = []string{}
 
 	re := regexp.MustCompile(`^[^#]+$`)
 
-	scanner := bufio.NewScanner(res.Body)
+	scanner := bufio.NewScanner(bytes.NewReader(content))
 
 	for scanner.Scan() {
 		line := scanner.Text()
@@ -105,10 +342,17 @@ func getTLDsFromIANA() (TLDs []string, err error) {
 
 		TLD := re.FindString(line)
 
-		if TLD == "" || strings.HasPrefix(TLD, "xn--") {
+		if TLD == "" {
 			continue
 		}
 
+		// IDN TLDs are listed in their ASCII form, while the public suffix list (and so TLDs) holds them in their Unicode form.
+		if strings.HasPrefix(TLD, "xn--") {
+			if TLD, err = idna.ToUnicode(TLD); err != nil {
+				return
+			}
+		}
+
 		TLDs = append(TLDs, TLD)
 	}
 
@@ -119,6 +363,233 @@ func getTLDsFromIANA() (TLDs []string, err error) {
 	return
 }
 
+// TLDMetadata
+// holds the metadata of a TLD, with Type the name of its tlds.TLDType constant.
+type TLDMetadata struct {
+	TLD         string
+	Type        string
+	CountryCode string
+}
+
+// IANATypes
+// maps the TLD types of the IANA root zone database to their tlds.TLDType constants.
+var IANATypes = map[string]string{
+	"generic":            "TLDTypeGeneric",
+	"country-code":       "TLDTypeCountryCode",
+	"sponsored":          "TLDTypeSponsored",
+	"infrastructure":     "TLDTypeInfrastructure",
+	"generic-restricted": "TLDTypeGenericRestricted",
+	"test":               "TLDTypeTest",
+}
+
+// legacyTypes
+// maps the TLDs that predate the new gTLD program, other than ccTLDs, to the tlds.TLDType constants
+// of their type in the IANA root zone database.
+var legacyTypes = map[string]string{
+	"arpa":   "TLDTypeInfrastructure",
+	"com":    "TLDTypeGeneric",
+	"info":   "TLDTypeGeneric",
+	"net":    "TLDTypeGeneric",
+	"org":    "TLDTypeGeneric",
+	"biz":    "TLDTypeGenericRestricted",
+	"name":   "TLDTypeGenericRestricted",
+	"pro":    "TLDTypeGenericRestricted",
+	"aero":   "TLDTypeSponsored",
+	"asia":   "TLDTypeSponsored",
+	"cat":    "TLDTypeSponsored",
+	"coop":   "TLDTypeSponsored",
+	"edu":    "TLDTypeSponsored",
+	"gov":    "TLDTypeSponsored",
+	"int":    "TLDTypeSponsored",
+	"jobs":   "TLDTypeSponsored",
+	"mil":    "TLDTypeSponsored",
+	"mobi":   "TLDTypeSponsored",
+	"museum": "TLDTypeSponsored",
+	"post":   "TLDTypeSponsored",
+	"tel":    "TLDTypeSponsored",
+	"travel": "TLDTypeSponsored",
+	"xxx":    "TLDTypeSponsored",
+}
+
+// countryCodeExceptions
+// maps the ccTLDs whose country code is not the TLD itself to their ISO 3166-1 country code.
+var countryCodeExceptions = map[string]string{
+	"uk": "GB",
+}
+
+// getCountryCode
+// returns the country code of the ASCII ccTLD.
+func getCountryCode(TLD string) (countryCode string) {
+	if countryCode, ok := countryCodeExceptions[TLD]; ok {
+		return countryCode
+	}
+
+	return strings.ToUpper(TLD)
+}
+
+// getTLDMetadataFromPublicSuffix
+// returns the metadata of the TLDs of the ICANN domains section of the public suffix list content
+// whose type is known without the IANA root zone database, sorted by TLD: the legacy TLDs, and the
+// ccTLDs, two-letter TLDs or IDN ccTLDs with a country code in countryCodes. The list does not tell
+// the new gTLDs, generic or brand, apart, so they are left out.
+func getTLDMetadataFromPublicSuffix(content []byte, countryCodes map[string]string) (metadata []TLDMetadata, err error) {
+	seen := map[string]bool{}
+
+	var TLDs []string
+
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+
+		if strings.HasPrefix(line, "// ===END ICANN DOMAINS") {
+			break
+		}
+
+		if strings.HasPrefix(line, "//") {
+			continue
+		}
+
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+
+		rule := strings.TrimLeft(fields[0], "!*.")
+
+		TLD := rule[strings.LastIndex(rule, ".")+1:]
+
+		if !seen[TLD] {
+			seen[TLD] = true
+
+			TLDs = append(TLDs, TLD)
+		}
+	}
+
+	if err = scanner.Err(); err != nil {
+		return
+	}
+
+	for _, TLD := range TLDs {
+		TLDMetadata := TLDMetadata{
+			TLD: TLD,
+		}
+
+		switch {
+		case legacyTypes[TLD] != "":
+			TLDMetadata.Type = legacyTypes[TLD]
+		case len(TLD) == 2 && TLD[0] < 0x80 && TLD[1] < 0x80:
+			TLDMetadata.Type = "TLDTypeCountryCode"
+			TLDMetadata.CountryCode = getCountryCode(TLD)
+		case countryCodes[TLD] != "":
+			TLDMetadata.Type = "TLDTypeCountryCode"
+			TLDMetadata.CountryCode = countryCodes[TLD]
+		default:
+			continue
+		}
+
+		metadata = append(metadata, TLDMetadata)
+	}
+
+	if len(metadata) == 0 {
+		err = fmt.Errorf("no TLDs found in the public suffix list")
+
+		return
+	}
+
+	sort.Slice(metadata, func(i, j int) bool {
+		return metadata[i].TLD < metadata[j].TLD
+	})
+
+	return
+}
+
+// getBrandTLDs
+// returns the TLDs listed in the brands file, if any.
+func getBrandTLDs() (brandTLDs map[string]bool, err error) {
+	brandTLDs = map[string]bool{}
+
+	if brands == "" {
+		return
+	}
+
+	content, err := os.ReadFile(brands)
+	if err != nil {
+		return
+	}
+
+	for _, TLD := range strings.Fields(strings.ToLower(string(content))) {
+		brandTLDs[TLD] = true
+	}
+
+	return
+}
+
+// getTLDMetadataFromIANA
+// returns the metadata of the TLDs listed in the IANA root zone database content, sorted by TLD. IDN
+// ccTLDs get their country code from countryCodes, generic TLDs listed in the brands file are brand TLDs.
+func getTLDMetadataFromIANA(content []byte, countryCodes map[string]string) (metadata []TLDMetadata, err error) {
+	brandTLDs, err := getBrandTLDs()
+	if err != nil {
+		return
+	}
+
+	// Each TLD is a table row of its link and its type.
+	re := regexp.MustCompile(`(?s)<a href="/domains/root/db/([^"]+)\.html">.*?</a>.*?<td>([^<]*)</td>`)
+
+	for _, match := range re.FindAllStringSubmatch(string(content), -1) {
+		TLD := strings.ToLower(match[1])
+
+		IANAType, ok := IANATypes[strings.TrimSpace(match[2])]
+		if !ok {
+			err = fmt.Errorf("unknown type %q of TLD %q", match[2], TLD)
+
+			return
+		}
+
+		if IANAType == "TLDTypeGeneric" && brandTLDs[TLD] {
+			IANAType = "TLDTypeBrand"
+		}
+
+		var countryCode string
+
+		if IANAType == "TLDTypeCountryCode" {
+			countryCode = getCountryCode(TLD)
+		}
+
+		// IDN TLDs are listed in their ASCII form, while TLDs are keyed by their Unicode form.
+		if strings.HasPrefix(TLD, "xn--") {
+			if TLD, err = idna.ToUnicode(TLD); err != nil {
+				return
+			}
+
+			countryCode = ""
+
+			if IANAType == "TLDTypeCountryCode" {
+				countryCode = countryCodes[TLD]
+			}
+		}
+
+		metadata = append(metadata, TLDMetadata{
+			TLD:         TLD,
+			Type:        IANAType,
+			CountryCode: countryCode,
+		})
+	}
+
+	if len(metadata) == 0 {
+		err = fmt.Errorf("no TLDs found in the IANA root zone database")
+
+		return
+	}
+
+	sort.Slice(metadata, func(i, j int) bool {
+		return metadata[i].TLD < metadata[j].TLD
+	})
+
+	return
+}
+
 // remDuplicates
 // removes duplicate elements from a slice of any type that satisfies the comparable constraint.
 func remDuplicates[T comparable](slice []T) []T {
@@ -138,19 +609,55 @@ func remDuplicates[T comparable](slice []T) []T {
 	return list
 }
 
-func getEffectiveTLDsFromPublicSuffix() (eTLDs []string, err error) {
-	eTLDs = []string{}
+// rules
+// holds the rules of one section of the public suffix list, split by rule type:
+// normal rules, wildcard rules ("*." stripped) and exception rules ("!" stripped).
+type rules struct {
+	normal    []string
+	wildcard  []string
+	exception []string
+}
 
-	var res *http.Response
+func (r *rules) add(rule string) {
+	switch {
+	case strings.HasPrefix(rule, "*."):
+		r.wildcard = append(r.wildcard, strings.TrimPrefix(rule, "*."))
+	case strings.HasPrefix(rule, "!"):
+		r.exception = append(r.exception, strings.TrimPrefix(rule, "!"))
+	default:
+		r.normal = append(r.normal, rule)
+	}
+}
 
-	res, err = http.Get("https://publicsuffix.org/list/effective_tld_names.dat")
-	if err != nil {
-		return
+// TLDs returns the TLDs of the rules, i.e. their last labels, e.g. "ck" of "*.ck", which the
+// public suffix list does not always list as rules of their own.
+func (r *rules) TLDs() (TLDs []string) {
+	for _, list := range [][]string{r.normal, r.wildcard, r.exception} {
+		for _, rule := range list {
+			TLDs = append(TLDs, rule[strings.LastIndex(rule, ".")+1:])
+		}
 	}
 
-	defer res.Body.Close()
+	return
+}
+
+func (r *rules) sort() {
+	sort.Strings(r.normal)
+	sort.Strings(r.wildcard)
+	sort.Strings(r.exception)
+}
+
+// getEffectiveTLDsFromPublicSuffix
+// returns the rules of the ICANN and private domains sections of the public suffix list content, and
+// the country codes of IDN ccTLDs, from their comments, e.g. "// xn--fiqs8s (...) : CN".
+func getEffectiveTLDsFromPublicSuffix(content []byte) (ICANN, private rules, countryCodes map[string]string, err error) {
+	section := &ICANN
+
+	countryCodes = map[string]string{}
 
-	scanner := bufio.NewScanner(res.Body)
+	countryCodeRe := regexp.MustCompile(`^// (xn--[a-z0-9-]+) .*\s:\s+([A-Z]{2})$`)
+
+	scanner := bufio.NewScanner(bytes.NewReader(content))
 
 	for scanner.Scan() {
 		line := scanner.Text()
@@ -158,23 +665,30 @@ func getEffectiveTLDsFromPublicSuffix() (eTLDs []string, err error) {
 		line = strings.TrimSpace(line)
 
 		if strings.HasPrefix(line, "// ===BEGIN PRIVATE DOMAINS") {
-			break
+			section = &private
+		}
+
+		if match := countryCodeRe.FindStringSubmatch(line); match != nil {
+			var TLD string
+
+			if TLD, err = idna.ToUnicode(match[1]); err != nil {
+				return
+			}
+
+			countryCodes[TLD] = match[2]
 		}
 
 		if strings.HasPrefix(line, "//") {
 			continue
 		}
 
-		TLD := line
-
-		TLD = strings.ReplaceAll(TLD, "*.", "")
-		TLD = strings.ReplaceAll(TLD, "!", "")
-
-		if TLD == "" {
+		// Rules are terminated by the first whitespace.
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
 			continue
 		}
 
-		eTLDs = append(eTLDs, TLD)
+		section.add(fields[0])
 	}
 
 	if err = scanner.Err(); err != nil {
@@ -183,3 +697,30 @@ func getEffectiveTLDsFromPublicSuffix() (eTLDs []string, err error) {
 
 	return
 }
+
+// getPublicSuffixListVersion returns the version the public suffix list content declares in its
+// "// VERSION:" header, e.g. "2024-06-01_09-14-25_UTC", or "" if it has none.
+func getPublicSuffixListVersion(content []byte) (version string) {
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+
+		if line == "" {
+			continue
+		}
+
+		// The header ends with the first rule or section marker.
+		if !strings.HasPrefix(line, "//") || strings.HasPrefix(line, "// ===") {
+			break
+		}
+
+		if after, ok := strings.CutPrefix(line, "// VERSION:"); ok {
+			version = strings.TrimSpace(after)
+
+			break
+		}
+	}
+
+	return
+}
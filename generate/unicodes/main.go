@@ -1,6 +1,7 @@
 package main
 
 import (
+	"bytes"
 	"fmt"
 	"os"
 	"strconv"
@@ -9,16 +10,23 @@ import (
 	"unicode"
 
 	"github.com/hueristiq/hqgolog"
+	"github.com/hueristiq/hqgourl/generate/internal/source"
 	"github.com/spf13/pflag"
 )
 
 var (
-	output string
+	output         string
+	unicodeVersion string
+	diff           bool
 
 	tmpl = template.Must(template.New("schemes").Parse(`// This file is autogenerated by https://github.com/hueristiq/hqgourl/blob/main/generate/unicodes/main.go. Please do not edit manually.
 
 package unicodes
 
+// UnicodeVersion is the version of the Unicode tables, of Go's unicode package, the character
+// classes are generated from.
+const UnicodeVersion = ` + "`" + `{{.version}}` + "`" + `
+
 const AllowedUcsChar = {{.withPunc}}
 
 const AllowedUcsCharMinusPunc = {{.withoutPunc}}
@@ -27,6 +35,8 @@ const AllowedUcsCharMinusPunc = {{.withoutPunc}}
 
 func init() {
 	pflag.StringVarP(&output, "output", "o", "", "")
+	pflag.StringVar(&unicodeVersion, "unicode-version", "15.0.0", "")
+	pflag.BoolVar(&diff, "diff", false, "")
 
 	pflag.CommandLine.SortFlags = false
 	pflag.Usage = func() {
@@ -35,6 +45,8 @@ func init() {
 
 		h += "\nOPTIONS:\n"
 		h += " -o, --output string                 output package file path\n"
+		h += "     --unicode-version string        Unicode version the toolchain's tables must have (default: 15.0.0)\n"
+		h += "     --diff bool                     print the entries added and removed instead of writing\n"
 
 		fmt.Fprintln(os.Stderr, h)
 	}
@@ -65,6 +77,12 @@ func visit(rt *unicode.RangeTable, fn func(rune)) {
 }
 
 func writeUnicode() error {
+	// The character classes follow the Unicode tables of the toolchain, which is pinned so that
+	// regenerating them does not change what the extractor matches.
+	if unicode.Version != unicodeVersion {
+		return fmt.Errorf("the toolchain's Unicode tables are version %s, want %s: generate with a Go release of Unicode %s, e.g. Go 1.21, or set --unicode-version", unicode.Version, unicodeVersion, unicodeVersion)
+	}
+
 	// rfc3987Ranges contains the ranges of valid code points specified by RFC 3987.
 	rfc3987Ranges := [][2]rune{
 		{0xA0, 0xD7FF},
@@ -171,16 +189,15 @@ func writeUnicode() error {
 	allowedUcsChar := characterClassContents(sepFreeRanges)
 	allowedUcsCharMinusPunc := characterClassContents(puncFreeRanges)
 
-	// Write to file.
-	f, err := os.Create(output)
-	if err != nil {
-		return err
-	}
-
-	defer f.Close()
+	var buf bytes.Buffer
 
-	return tmpl.Execute(f, map[string]string{
+	if err := tmpl.Execute(&buf, map[string]string{
+		"version":     unicode.Version,
 		"withPunc":    strconv.Quote(allowedUcsChar.String()),
 		"withoutPunc": strconv.Quote(allowedUcsCharMinusPunc.String()),
-	})
+	}); err != nil {
+		return err
+	}
+
+	return source.Write(output, buf.Bytes(), diff, os.Stdout)
 }
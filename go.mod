@@ -5,10 +5,13 @@ go 1.20
 require (
 	github.com/hueristiq/hqgolog v0.0.0-20230623113334-a6018965a34f
 	github.com/spf13/pflag v1.0.5
+	golang.org/x/crypto v0.20.0
+	golang.org/x/net v0.21.0
 )
 
 require (
 	github.com/logrusorgru/aurora/v3 v3.0.0 // indirect
 	golang.org/x/sys v0.17.0 // indirect
 	golang.org/x/term v0.17.0 // indirect
+	golang.org/x/text v0.14.0 // indirect
 )
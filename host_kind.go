@@ -0,0 +1,95 @@
+package hqgourl
+
+import (
+	"net/netip"
+	"strings"
+)
+
+// HostKind tells what the host of a URL is: a domain, an IP address, a single-label name, or
+// none of these.
+type HostKind int
+
+const (
+	// HostKindNone is for URLs without a host, e.g. "mailto:user@example.com" or "/path".
+	HostKindNone HostKind = iota
+	// HostKindDomain is for domains of two labels or more, e.g. "www.example.com" or "例子.公司.cn".
+	HostKindDomain
+	// HostKindIPv4 is for IPv4 addresses, e.g. "192.0.2.1".
+	HostKindIPv4
+	// HostKindIPv6 is for bracketed IPv6 addresses, with or without a zone, e.g. "[2001:db8::1]"
+	// or "[fe80::1%25en0]".
+	HostKindIPv6
+	// HostKindSingleLabel is for single-label names, e.g. "localhost" or "intranet", resolved
+	// locally or through a search domain.
+	HostKindSingleLabel
+	// HostKindInvalid is for hosts that are neither IP addresses nor syntactically valid domains,
+	// e.g. "example..com", "-example-.com" or "256.1.1.1".
+	HostKindInvalid
+)
+
+// String returns the name of the kind.
+func (k HostKind) String() (kind string) {
+	switch k {
+	case HostKindNone:
+		kind = "none"
+	case HostKindDomain:
+		kind = "domain"
+	case HostKindIPv4:
+		kind = "ipv4"
+	case HostKindIPv6:
+		kind = "ipv6"
+	case HostKindSingleLabel:
+		kind = "single-label"
+	case HostKindInvalid:
+		kind = "invalid"
+	}
+
+	return
+}
+
+// hostValidator validates the hosts that are not IP addresses. It accepts underscores anywhere in
+// a label, e.g. "a_b.example.com", common in the hostnames of real-world URLs.
+var hostValidator = &DomainValidator{withUnderscores: true}
+
+// parseHost returns the kind of host, a URL host without its port, and its address if it is an
+// IP address. IPv6 addresses are bracketed, their zone, if any, unescaped.
+func parseHost(host string) (kind HostKind, addr netip.Addr) {
+	if host == "" {
+		return HostKindNone, addr
+	}
+
+	if strings.HasPrefix(host, "[") {
+		var err error
+
+		if !strings.HasSuffix(host, "]") {
+			return HostKindInvalid, addr
+		}
+
+		if addr, err = netip.ParseAddr(host[1 : len(host)-1]); err != nil || !addr.Is6() {
+			return HostKindInvalid, netip.Addr{}
+		}
+
+		return HostKindIPv6, addr
+	}
+
+	if parsedAddr, err := netip.ParseAddr(host); err == nil && parsedAddr.Is4() {
+		return HostKindIPv4, parsedAddr
+	}
+
+	if err := hostValidator.Validate(host); err != nil {
+		return HostKindInvalid, addr
+	}
+
+	name := strings.TrimSuffix(host, ".")
+
+	// A last label of digits only is no TLD, e.g. in "256.1.1.1", an IPv4 address out of range.
+	if isNumericSegment(name[strings.LastIndex(name, ".")+1:]) {
+		return HostKindInvalid, addr
+	}
+
+	if !strings.Contains(name, ".") {
+		return HostKindSingleLabel, addr
+	}
+
+	return HostKindDomain, addr
+}
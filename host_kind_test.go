@@ -0,0 +1,66 @@
+package hqgourl_test
+
+import (
+	"fmt"
+	"net/netip"
+	"testing"
+
+	"github.com/hueristiq/hqgourl"
+)
+
+func TestURLParser_ParseHostKind(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		rawURL           string
+		expectedHostKind hqgourl.HostKind
+		expectedAddr     netip.Addr
+		expectDomain     bool
+	}{
+		{"https://www.example.com/", hqgourl.HostKindDomain, netip.Addr{}, true},
+		{"https://例子.公司.cn/", hqgourl.HostKindDomain, netip.Addr{}, true},
+		{"https://www.example.com./", hqgourl.HostKindDomain, netip.Addr{}, true},
+		{"https://_dmarc.example.com/", hqgourl.HostKindDomain, netip.Addr{}, true},
+		{"http://a_b.example.com/", hqgourl.HostKindDomain, netip.Addr{}, true},
+		{"http://a_.example.com/", hqgourl.HostKindDomain, netip.Addr{}, true},
+		{"http://192.0.2.1:8080/", hqgourl.HostKindIPv4, netip.MustParseAddr("192.0.2.1"), false},
+		{"http://[2001:db8::1]:8080/", hqgourl.HostKindIPv6, netip.MustParseAddr("2001:db8::1"), false},
+		{"http://[fe80::1%25en0]/", hqgourl.HostKindIPv6, netip.MustParseAddr("fe80::1%en0"), false},
+		{"http://[::ffff:192.0.2.1]/", hqgourl.HostKindIPv6, netip.MustParseAddr("::ffff:192.0.2.1"), false},
+		{"http://localhost:3000/", hqgourl.HostKindSingleLabel, netip.Addr{}, false},
+		{"http://intranet/", hqgourl.HostKindSingleLabel, netip.Addr{}, false},
+		{"http://-example-.com/", hqgourl.HostKindInvalid, netip.Addr{}, false},
+		{"http://example..com/", hqgourl.HostKindInvalid, netip.Addr{}, false},
+		{"http://256.1.1.1/", hqgourl.HostKindInvalid, netip.Addr{}, false},
+		{"http://1.2.3/", hqgourl.HostKindInvalid, netip.Addr{}, false},
+		{"http://12345/", hqgourl.HostKindInvalid, netip.Addr{}, false},
+		{"mailto:user@example.com", hqgourl.HostKindNone, netip.Addr{}, false},
+	}
+
+	parser := hqgourl.NewURLParser()
+
+	for _, c := range cases {
+		c := c
+
+		t.Run(fmt.Sprintf("Parse(%q)", c.rawURL), func(t *testing.T) {
+			t.Parallel()
+
+			parsedURL, err := parser.Parse(c.rawURL)
+			if err != nil {
+				t.Fatalf("Parse(%q) error = %v", c.rawURL, err)
+			}
+
+			if parsedURL.HostKind != c.expectedHostKind {
+				t.Errorf("Parse(%q).HostKind = %v, want %v", c.rawURL, parsedURL.HostKind, c.expectedHostKind)
+			}
+
+			if parsedURL.Addr != c.expectedAddr {
+				t.Errorf("Parse(%q).Addr = %v, want %v", c.rawURL, parsedURL.Addr, c.expectedAddr)
+			}
+
+			if (parsedURL.Domain != nil) != c.expectDomain {
+				t.Errorf("Parse(%q).Domain = %+v, expectDomain %v", c.rawURL, parsedURL.Domain, c.expectDomain)
+			}
+		})
+	}
+}
@@ -1,5 +1,5 @@
 package hqgourl
 
 //go:generate go run generate/schemes/main.go -o ./schemes/schemes.go
-//go:generate go run generate/tlds/main.go -o ./tlds/tlds.go
+//go:generate go run generate/tlds/main.go -o ./tlds/tlds.go -m ./tlds/tlds_metadata.go -p ./tlds/public_suffix_list.dat -t ./internal/table/nodes.go
 //go:generate go run generate/unicodes/main.go -o ./unicodes/unicodes.go
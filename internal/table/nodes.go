@@ -0,0 +1,3072 @@
+// This file is autogenerated by https://github.com/hueristiq/hqgourl/blob/main/generate/tlds/main.go. Please do not edit manually.
+
+package table
+
+// text holds the labels of the suffix table, in their Unicode form. Nodes refer to their
+// label by offset and length, labels being shared as substrings of longer ones where possible.
+const text = "" +
+	"சிங்கப்பூர்chirurgiens-dentistes-en-france" +
+	"correios-e-telecomunicaçõesposts-and-telecommunicationsenviron" +
+	"mentalconservations3-website-ap-northeast-1s3-website-ap-southea" +
+	"st-1s3-website-ap-southeast-2privatizehealthinsuranceaccident-in" +
+	"vestigationindependent-commissionandria-barletta-traniandria-tra" +
+	"ni-barlettabarletta-trani-andriafriuli-venezia-giuliais-a-financ" +
+	"ialadvisormonza-e-della-brianzaobservableusercontentpassenger-as" +
+	"sociations3-fips-us-gov-west-1trani-andria-barlettatrani-barlett" +
+	"a-andriaஇந்தியாakamaiorigin-stagingawsglobalaccele" +
+	"ratorbarrell-of-knowledgecolonialwilliamsburgfriuli-veneziagiuli" +
+	"afriulivenezia-giuliais-a-personaltrainernaturalhistorymuseums3-" +
+	"website-eu-west-1s3-website-sa-east-1s3-website-us-east-1s3-webs" +
+	"ite-us-west-1s3-website-us-west-2synology-diskstationaccident-pr" +
+	"eventionair-traffic-controlandriabarlettatraniandriatranibarlett" +
+	"aappspaceusercontentbarrel-of-knowledgefriuliveneziagiuliaindepe" +
+	"ndent-inquestindependent-inquirymedizinhistorischesnatuurwetensc" +
+	"happentraniandriabarlettatranibarlettaandriatrentino-alto-adiget" +
+	"rentino-sued-tiroltrentino-süd-tirolakamaiedge-stagingboldlygoi" +
+	"ngnowheredigitaloceanspacesdiskussionsbereichexperts-comptablesh" +
+	"arvestcelebrationhosted-by-previderindependent-reviewinterhostso" +
+	"lutionsis-a-cubicle-slavemonzaedellabrianzanorthwesternmutualpag" +
+	"espeedmobilizerscienceandindustrysciencesnaturellesservecounters" +
+	"triketravelersinsurancetrentin-sued-tiroltrentin-süd-tiroltrent" +
+	"ino-altoadigetrentino-sud-tiroltrentino-suedtiroltrentino-südti" +
+	"roltrentinoalto-adigetrentinosued-tiroltrentinosüd-tirolvermög" +
+	"ensberatungموريتانياभारतम्இலங்கை" +
+	"ธุรกิจรัฐบาลองค์กรファッシ" +
+	"ョンakamaized-stagingalpha-myqnapcloudbolzano-altoadigebrowser" +
+	"safetymarkcarbonia-iglesiasedgesuite-staginggithubusercontentgü" +
+	"nstigbestellenhealth-carereformhigashimatsushimahistoricalsociet" +
+	"yiglesias-carboniaindependent-panelis-a-conservativeis-a-photogr" +
+	"apheris-an-entertainerlinodeusercontentmyactivedirectorynaturhis" +
+	"torischesplaystation-clouds3-ap-northeast-1s3-ap-northeast-2s3-a" +
+	"p-southeast-1s3-ap-southeast-2scienceandhistorystackhero-network" +
+	"telekommunikationtrentin-sud-tiroltrentin-suedtiroltrentin-südt" +
+	"iroltrentino-sudtiroltrentinoaltoadigetrentinosud-tiroltrentinos" +
+	"uedtiroltrentinosüdtiroltrentinsued-tiroltrentinsüd-tirolvermö" +
+	"gensberaterair-surveillanceakamaihd-stagingamericanantiquesauthg" +
+	"ear-stagingbalsan-suedtirolbalsan-südtirolbrandywinevalleybulsa" +
+	"n-suedtirolbulsan-südtirolcarboniaiglesiascouchpotatofrieselast" +
+	"icbeanstalkendoftheinternetest-mon-blogueurfastly-terrariumfedor" +
+	"ainfracloudfirewall-gatewayfirewalledreplitfriuli-ve-giuliahigas" +
+	"hihiroshimahigashimatsuyamahigashishirakawahigashisumiyoshihisto" +
+	"ryofsciencehuissier-justiceiglesiascarboniais-a-hard-workeris-a-" +
+	"libertarianis-an-accountantis-into-cartoonsis-not-certifiedissma" +
+	"rterthanyoumuseumverenigingmysecuritycameramøre-og-romsdalnatio" +
+	"nalfirearmsnationalheritagenorth-kazakhstannååmesjevuemiephila" +
+	"delphiaarearoyal-commissions3-us-gov-west-1saves-the-whalesstjø" +
+	"rdalshalsentownnews-stagingtrentin-sudtiroltrentino-a-adigetrent" +
+	"ino-s-tiroltrentinosudtiroltrentinsud-tiroltrentinsuedtiroltrent" +
+	"insüdtirolusdecorativeartsالسعوديةالسعوديهالس" +
+	"عودیةالسعودیۃaffinitylotteryamericanexpressaurskog-" +
+	"hølandazurestaticappsbalsan-sudtirolbotanicalgardenbozen-suedti" +
+	"rolbozen-südtirolbritishcolumbiabulsan-sudtirolcampidano-medioc" +
+	"hildrensgardencloudcontrolappcloudcontrolledcloudflare-ipfscolor" +
+	"adoplateaucomputerhistorycontemporaryartdev-myqnapclouddynamisch" +
+	"es-dnseast-kazakhstanedgekey-stagingenterprisecloudest-a-la-mais" +
+	"onest-a-la-masionevje-og-hornnesfriuli-v-giuliafriuli-vegiuliafr" +
+	"iulive-giuliafuettertdasnetzfujikawaguchikogeometre-expertgünst" +
+	"igliefernhembygdsforbundhigashiagatsumahigashichichibuhigashimur" +
+	"ayamahigashiyodogawahomesecuritymachosting-clusterhotelwithfligh" +
+	"tis-a-bookkeeperis-a-celticsfanis-a-landscaperis-a-linux-useris-" +
+	"a-republicanis-an-anarchistis-with-thebandivano-frankivskkazimie" +
+	"rz-dolnykerrypropertieskommunalforbundmedio-campidanominamiyamas" +
+	"hiromore-og-romsdalmusashimurayamamátta-várjjatnaturalsciences" +
+	"oceanographiqueoutsystemscloudreggio-calabrias3-ca-central-1s3-e" +
+	"u-central-1sandvikcoromantscience-fictionsecuritytacticsstateofd" +
+	"elawarestjordalshalsentrentino-aadigetrentino-stiroltrentinoa-ad" +
+	"igetrentinos-tiroltrentinsudtiroltuleap-partnersuscountryestateu" +
+	"slivinghistoryvallée-d-aostewatch-and-clockwpenginepoweredyokos" +
+	"hibahikariभारोतसंगठनবাংলাభార" +
+	"త్ഭാരതംศึกษา嘉里大酒店adobeio-stati" +
+	"cadobeioruntimeakamai-stagingamericanfamilyap-northeast-3appspac" +
+	"ehostedarchaeologicalaurskog-holandazurecontainerbalena-devicesb" +
+	"ananarepublicbmoattachmentsbozen-sudtirolcampidanomediocasadelam" +
+	"onedachihayaakasakachristiansburgcloudfunctionscn-northwest-1coa" +
+	"staldefencecookingchannelculturalcenterdell-ogliastradnepropetro" +
+	"vskdnipropetrovskdyndns-at-homedyndns-at-workeating-organicecomm" +
+	"erce-shopemilia-romagnafastvps-serverfriuli-vgiuliafriuliv-giuli" +
+	"afriulivegiuliagroundhandlingheimatunduhrenhigashiyoshinohistori" +
+	"chouseshome-webserverhomesecuritypchámmárfeastaichikawamisatoi" +
+	"s-a-anarchistis-a-bruinsfanis-a-bulls-fanis-a-candidateis-a-nasc" +
+	"arfanis-a-socialistis-a-therapistis-an-engineerjcloud-ver-jpcker" +
+	"rylogisticskitanakagusukukunstunddesignlinkyard-cloudmediocampid" +
+	"anomidtre-gauldalminamiashigaranaamesjevuemienativeamericannatur" +
+	"bruksgymnondigitaloceanpublic-inquirypythonanywherereggiocalabri" +
+	"areserve-onlineschulplattformsciencecenterssciencehistorysells-f" +
+	"or-lessserveminecraftsoundandvisiontrentinoaadigetrentinostirolv" +
+	"allee-d-aosteweatherchannelwebview-assetswritesthisblogyamatokor" +
+	"iyamaøystre-slidreкатоликירושליםاتصالاتال" +
+	"بحرينالجزائرالعليانكاثوليكپاكستا" +
+	"نپاکستان123hjemmesideadobeaemcloudairkitapps-auaizuwakam" +
+	"atsuartsandcraftsascoli-picenoassassinationatlassian-devazureweb" +
+	"sitesbeeldengeluidbotanicgardenbrønnøysundbuiltwithdarkbáhcca" +
+	"vuotnacaltanissettacampinagrandecarrara-massacertificationcesena" +
+	"-forlìchesapeakebaycivilaviationcloudplatformcloudyclustercommu" +
+	"nity-prodellogliastradevcdnaccessodielddanuorridivttasvuotnadynd" +
+	"ns-officedyndns-remotedyndns-serveremiliaromagnaendofinternetent" +
+	"ertainmentest-le-patronfantasyleaguefarmequipmentfedoraprojectfo" +
+	"rlì-cesenafriulivgiuliafuturehostingfuturemailinggiehtavuoatnag" +
+	"ithubpreviewguovdageaidnuhere-for-morehigashikagawahigashikagura" +
+	"higashikurumehigashinarusehigashiyamatoimageandsoundinternationa" +
+	"lis-a-democratis-a-designeris-a-musicianis-a-rockstaris-an-actre" +
+	"ssis-into-animeis-into-gamesis-very-sweetisa-hockeynutkawachinag" +
+	"anokisofukushimakitahiroshimakunstsammlungkárášjohkalifeinsur" +
+	"ancelinodeobjectslpusercontentmassa-carraramatta-varjjatminamiec" +
+	"hizenminamisanrikumonza-brianzamonzaebrianzamyhome-servermythic-" +
+	"beastsnachikatsuuranieruchomoscinore-og-uvdaloamishirasatooceano" +
+	"graphicoffice-on-theosakikamijimaoystre-slidrepesaro-urbinopluri" +
+	"nacionalreggio-emiliarikuzentakataráhkkerávjus3-ap-south-1s3-e" +
+	"xternal-1sandnessjøensatsumasendaiscrapper-sitesellsyourhomeser" +
+	"veexchangeservehalflifeshichikashukushimokitayamashinyoshitomisk" +
+	"edsmokorsetsmartlabelingsouthcarolinaspace-to-rentstatic-accesss" +
+	"ør-varangerthingdustdatatraeumtgeradetravelchanneltrycloudflare" +
+	"unusualpersonurbino-pesarovalle-d-aostavallée-aostevalléedaost" +
+	"evestre-slidrevibo-valentiavirtualserverwatchandclockweeklylotte" +
+	"rywellbeingzonewolterskluwer123paginawebanthropologyaosta-valley" +
+	"architectureartanddesignarteducationascolipicenoat-band-campauth" +
+	"gearappsazure-mobilebackplaneappbahccavuotnabearalváhkiblackbau" +
+	"dcdnbáhcavuotnacarraramassacdn77-securecesena-forlicesenaforlì" +
+	"championshipchiropracticcivilisationcivilizationclicketcloudcons" +
+	"tructioncustomer-ocidavvenjárgadeno-stagingdivtasvuodnadreamhos" +
+	"tersencyclopedicfashionstorefedorapeopleflynnhostingfolionetwork" +
+	"forli-cesenaforlìcesenafortmissoulaframercanvasfranziskanergent" +
+	"lentapisgáŋgaviikaham-radio-ophamatonbetsuhammarfeastahattfjel" +
+	"ldalhigashiizumohigashiosakahigashitsunohitachiomiyahostyhosting" +
+	"hs-heilbronnillustrationilovecollegeimpertrixcdnindianapolisindi" +
+	"anmarketinstantcloudintelligenceinternet-dnsis-a-bloggeris-a-cat" +
+	"ereris-a-liberalis-a-painteris-a-patsfanis-a-studentis-a-teacher" +
+	"is-an-artistis-certifiedis-into-carsis-very-evilis-very-goodis-v" +
+	"ery-niceitcouldbeworjelenia-gorajinsekikogenkakamigaharakamikita" +
+	"yamakamisunagawakhmelnitskiykhmelnytskyikitashiobarakomatsushima" +
+	"kristiansandkristiansundkrokstadelvakuromatsunailaakesvuemielcub" +
+	"e-serverleaŋgaviikalocalhistorylplfinancialmassacarraraminamifu" +
+	"ranominamiminowaminamiuonumamonzabrianzamuseumcentermyshopblocks" +
+	"myspreadshopmálatvuopminakasatsunainakatombetsunasushiobaraneso" +
+	"ddtangennewhampshirenishiawakuranishikatsuranishinoomotenishinos" +
+	"himanodebalancernordeste-idcolbia-tempioonflashdrivepagefrontapp" +
+	"pantheonsitepesarourbinopostman-echoprequalifymepreservationpubl" +
+	"ishproxyquickconnectquipelementsrag-cloud-chreggioemiliaregruhos" +
+	"tingresinstagingriodejaneiros3-eu-west-1s3-eu-west-2s3-eu-west-3" +
+	"s3-sa-east-1s3-us-east-2s3-us-west-1s3-us-west-2salvadordalisand" +
+	"nessjoensanfranciscosantabarbarasaskatchewanschlesischesscholars" +
+	"hipsservesarcasmshinanomachishinkamigotoshinshinotsusor-varanger" +
+	"speedpartnerstalowa-wolastarachowicestpetersburgstreamlitappstuf" +
+	"f-4-salesurgeonshallsøndre-landteaches-yogatempio-olbiatondabay" +
+	"ashitrendhostingtry-snowplowtsurugashimaurbinopesarovalle-daosta" +
+	"valled-aostavallee-aostevalleedaostevalléeaosteversicherungvest" +
+	"re-totenvibovalentiavirtual-uservirtualcloudvultrobjectswashingt" +
+	"ondcwoltlab-demoworkinggroupworkisboringwphostedmailyamatotakada" +
+	"zaporizhzhiaákŋoluoktaøstre-totenčáhcesuoloмоскваон" +
+	"лайнсамараאקדמיהابوظبيارامكوالار" +
+	"دنالمغرباماراتفلسطينمليسياভারত" +
+	"ভাৰতਭਾਰਤભારતଭାରତಭಾರತලං" +
+	"කාทหารเน็ตアマゾンクラウドグーグル" +
+	"ポイント组织机构電訊盈科香格里拉123homepage123ko" +
+	"tisivu123webseiteaccountantsactivetrailagriculturealessandriaame" +
+	"ricanartaostavalleyarchaeologyarchitectesarkhangelskbahcavuotnab" +
+	"anzaicloudbarclaycardbarsycenterbarsyonlinebasicserverbeagleboar" +
+	"dbearalvahkibetter-thanblackfridayblockbusterboleslawiecbounty-f" +
+	"ullbridgestonebronnoysundbungotakadabådåddjåcable-modemcalvin" +
+	"kleincesenaforlichannelsdvrchattanoogachippubetsuclickrisingclou" +
+	"daccesscontractorscooperativacorporationcreditunioncryptonomicda" +
+	"vvenjargadelmenhorstdev-builderdevelopmentdiscordsaysditchyourip" +
+	"doesntexistdynamic-dnsdyndns-blogdyndns-freedyndns-homedyndns-ma" +
+	"ildyndns-picsdyndns-wikidyndns-workedgecomputeeducationalenginee" +
+	"ringenterprisesevenáššifastly-edgefh-muensterfilegear-aufileg" +
+	"ear-defilegear-gbfilegear-iefilegear-jpfilegear-sgfirebaseappfle" +
+	"kkefjordfoodnetworkforgeblocksfredrikstadfreedesktopfreemasonryf" +
+	"ujiyoshidafukuchiyamagame-servergemologicalgotpantheongrandrapid" +
+	"shachirogatahandcraftedhangglidinghatsukaichihigashikawahitachin" +
+	"akaholmestrandhægebostadin-the-bandinteractiveinvestmentsis-a-d" +
+	"octoris-a-hunteris-a-knightis-a-lawyeris-a-playeris-a-soxfanis-a" +
+	"-techieis-an-actoris-uberleetis-very-badiservschulejudygarlandka" +
+	"miamakusakamishihorokashiwazakikasumigaurakazteleportkerryhotels" +
+	"knightpointkongsvingerkrødsheradlamborghiniland-4-saleleangavii" +
+	"kalewismillerlillehammermagentositemaintenancemalatvuopmimassive" +
+	"gridmesswithdnsmetacentrummidatlanticminami-alpsminamiawajiminam" +
+	"idaitominamiogunimisconfusedmotorcyclesmozilla-iotmy-firewallnak" +
+	"aniikawanakatsugawanedre-eikernishinomiyanishiokoppenoboribetsun" +
+	"ord-aurdalnordre-landnospamproxynozawaonsenolayangroupolbiatempi" +
+	"oomihachimanorangecloudoregontrailosakasayamaostre-totenownprovi" +
+	"derpalmspringsparachutingparaglidingpharmaciensphotographyplanet" +
+	"ariumplatter-appprivatelinkproductionsprofesionalprogressivequal" +
+	"ifioappraffleentryrahkkeravjureadthedocsredumbrellaresindeviceri" +
+	"shirifujisakaiminatosaobernardosaveincloudschoenbrunnschokoladen" +
+	"schulserversells-for-uservehumourshiftcryptoshijonawateshikokuch" +
+	"uoshimonosekishizukuishisondre-landstg-builderstor-elvdalstuffto" +
+	"readsweetpepperswinoujsciesynology-dssør-aurdaltamatsukuritateb" +
+	"ayashitempioolbiatimekeepingtosashimizutrafficplextselinogradtve" +
+	"destrandval-d-aostavalle-aostavalledaostavalleeaostevestvågøyv" +
+	"eterinairevirtualuservladikavkazvolkenkundevossevangenwebredirec" +
+	"twilliamhillwithyoutubeyandexcloudyoshinogarizaporizhzheøvre-ei" +
+	"ker123minsida123siteweb123website3utilitiesaf-south-1airtraffica" +
+	"ivencloudaizumisatoaknoluoktaaktyubinskaltervistaalwaysdataamsco" +
+	"mputeapartmentsartgalleryassociatesautomotiveazerbaijanbabia-gor" +
+	"abalestrandballooningbasilicatabasketballbeebyteappbetainaboxbia" +
+	"lowiezabieszczadybirthplacebnpparibasboehringerbookonlinebrumund" +
+	"dalbuyshousesbåtsfjordcahcesuolocaliforniacampobassocanva-appsc" +
+	"apebretoncapitalonecartoonartcheltenhamchernivtsichernovtsychiku" +
+	"shinocincinnaticiscofreakclerkstagecleverappscloudfrontcloudjiff" +
+	"ycn-north-1collectioncollegefanconferenceconsultantconsultingcop" +
+	"enhagencreditcardcuisinelladamnserverdatacenterdatadetectdattolo" +
+	"caldattorelaydavvesiidademocraciadiscordsezdnsiskinkydnsupdaterd" +
+	"yn-berlindyn-o-saurdyndns-webeastafricaeero-stageelvendrellembro" +
+	"ideryentomologyeu-north-1eu-south-1eurovisionexhibitionextraspac" +
+	"efaststacksfederationfor-betterfoundationfreebox-osfrenchkissfuj" +
+	"inomiyafylkesbiblgangaviikageekgalaxygildeskålgirlfriendgooglea" +
+	"pisgooglecodegovernmentgroks-thisgáivuotnahagebostadhalloffameh" +
+	"ammerfesthanamigawahasura-apphealthcarehigashiomihigashiurahitac" +
+	"hiotahjelmelandhobby-sitehomeofficehumanitieshøylandetichinomiy" +
+	"aichinosekiiliadboxosimmobilieninawashiroindustriesis-a-greenis-" +
+	"a-llamais-a-nurseishinomakiisteingeekjenv-arubajournalismjournal" +
+	"istjuedischesjørpelandkagamiishikamifuranokaminokawakaminoyamak" +
+	"anegasakikarasjohkakarasuyamakatsushikakautokeinokawaiishopkawam" +
+	"inamikeymachinekirovogradkitakyushuknowsitallknx-serverkobierzyc" +
+	"ekoebenhavnkonskowolakouzushimakrodsheradkråanghkekuchinotsukvi" +
+	"nnheradkvænangenlancashirelayershiftlebtimnetzleitungsenlikesca" +
+	"ndylittlestarlolitapunklosangelesloseyouripluxembourglørenskogm" +
+	"akinoharamakurazakimalopolskamamurogawamanagementmanchestermangy" +
+	"shlakmarylhurstmasfjordenmatsubushimatsushigeme-south-1mein-iser" +
+	"vmein-vigormicrolightminamiaikiminamibosominamimakiminamitanemin" +
+	"iservermitsubishimiyakonojomjøndalenmo-siemensmonticellomovimie" +
+	"ntomy-gatewaymyfirewallnaganoharanagaokakyonagareyamanakadomarin" +
+	"amdalseidnamerikawanamsskogannavigationnet-freaksnextdirectnishi" +
+	"aritaniyodogawanorthflanknoticeablenøtterøyokinoshimaomasvuotn" +
+	"aon-aptibleon-rancheron-the-webopensocialoperauniteostrowwlkpovr" +
+	"e-eikerowariasahiparliamentpittsburghplantationplatformshpoint2t" +
+	"hisporsáŋguportlligatprochowiceprotectionprudentialpyatigorskq" +
+	"uicksytesread-booksreadmyblogrealestaterecreationredirectmeresis" +
+	"tancerestaurantrotorcraftsagamiharasaintlouissakuragawasandefjor" +
+	"dsantamariasantoandresavannahgascaleforceschaefflerschokokeksseb" +
+	"astopolsekigaharasenseeringsensiositeservequakesettlementsevasto" +
+	"polsherbrookeshichinoheshimotsukeshimotsumashopitsiteshopselects" +
+	"imple-urlsimplesiteskygearappsnillfjordsor-aurdalspjelkaviksteie" +
+	"rmarkswiebodzintabitordertaifun-dnstakanezawatakarazukatarnobrze" +
+	"gtatamotorstawaramototb-hostingtechnologytecnologiatelevisiontes" +
+	"t-iservtokorozawatomigusukutorprojecttranslatedtransporteullensa" +
+	"kerullensvanguniversityusantiquesutsunomiyaval-daostavald-aostav" +
+	"alleaostavaporcloudvegårsheiversaillesvindafjordvipsinaappvlaan" +
+	"derenvolkswagenwafaicloudwafflecellwebhostingwesteuropewithgoogl" +
+	"eworse-thanwpdevcloudyamanakakoyamanouchiyatsushiroyawatahamayok" +
+	"aichibayotsukaidozoologicalálaheadjuישראלاليمنايرا" +
+	"نایرانبازاربھارتسودانسورياسوريةه" +
+	"مراهaccentureaccesscamaerobaticaerodromeagrigentoaizubangeal" +
+	"aheadjualfaromeoallfinanzalstahaugamagasakiamazonawsambulanceams" +
+	"terdamamusementanalyticsandasuoloann-arborannefrankaogashimaap-e" +
+	"ast-1aparecidaappengineaquarellearboretumaridagawaartcenterasahi" +
+	"kawaasaminamiashibetsuastronomyaudnedalnaustevollaustraliaaustrh" +
+	"eimaéroportbackyardsballangenbalsfjordbaltimorebarcelonabaremet" +
+	"albashkiriabatsfjordbeauxartsbeneventoberlevågbialystokbitbridg" +
+	"ebitbucketbjerkreimbloombergboyfriendbremangerbroadcastbruxelles" +
+	"bydgoszczcambridgecatanzarocdn-edgescdn77-sslcentraluschambagric" +
+	"herkassychernigovchernihivchigasakichikuhokuchocolatechristmascl" +
+	"oud-fr1cloudappscloudletscloudsitecompute-1consuladocranbrookdev" +
+	"eloperdiscoursediscoverydlugolekadontexistdrangedaldualstackdyna" +
+	"thomedyndns-ipeastcoasteasypaneledgestackeigersundeisenbahneleme" +
+	"ntorembaixadaemergencyencoreapiethnologyfairwindsfarmsteadfilate" +
+	"liafirestoneflatangerfolkebiblforgerockfortworthfrancaisefrankfu" +
+	"rtfreeboxosfreseniusfrontdoorfrosinoneftpaccessfujiiderafujishir" +
+	"ofukumitsufunabashifunahashifunkfeuerfurniturefuturecmsgaivuotna" +
+	"game-hostgeelvinckgildeskalgit-pagesgit-reposgoldpointgratangeng" +
+	"roks-thegushikamigyeongbukgyeongnamhachinohehamamatsuhashikamiha" +
+	"shimotohaugesundhayashimaherokuappherokusslhigashinehimeshimahir" +
+	"aizumihiratsukahisamitsuholtålenhomebuilthomedepothomegoodshome" +
+	"linuxhomesensehomesklephordalandhornindalhorokanaihoylandethylle" +
+	"stadhäkkinenhønefosshøyangeriamallamaik-serverin-berlinin-but" +
+	"terindustriainstancesinstituteis-a-chefis-a-geekis-a-guruisleofm" +
+	"anitayanagiiwamizawaizumiotsuizumisanoizumizakiizumozakiizunokun" +
+	"ijan-mayenjdevcloudjeffersonjellybeanjerusalemjewishartjoinville" +
+	"jorpelandkagoshimakamiizumikamikoanikamitondakaragandakarikaturk" +
+	"aruizawakasamatsukashiharakashiwarakasserverkatashinakatsuragika" +
+	"tsuyamakawanehonkawanishikhakassiakicks-asskilatironkimobetsukis" +
+	"hiwadakitaakitakitadaitokobayashikokubunjikolobrzegkongsbergkosh" +
+	"igayakoshimizukraanghkekrasnodarkudamatsukumakogenkunitachikuokg" +
+	"roupkurashikikushimotokvanangenkvinesdalkviteseidkvæfjordkyotan" +
+	"abel-o-g-i-nla-spezialancasterlandroverlangevågleadpagesleikang" +
+	"erleirfjordlifestylelikes-pielillesandlima-citylindesneslocalcer" +
+	"tlocalhostlocalzoneloginlinelolipopmclombardialorenskoglødingen" +
+	"marketingmarnardalmarshallsmatsubaramatsukawamatsumotomatsusakam" +
+	"atsuzakimediatechmeinforummelbournemesaverdemeteorappmicrosoftmi" +
+	"grationminamiiseminamiizuminnesotamiyashiromjondalenmo-i-ranamoc" +
+	"hizukimodellingmongolianmoonscalemoriguchimorimachimoriyoshimoro" +
+	"tsukamusashinomutsuzawamy-routermyasustormydissentmymediapcmysho" +
+	"pifynakamichinannestadnarashinonewjerseynewmexiconewspapernishia" +
+	"izunishiazainishiharanishikatanishikawanishimeranishitosanishiwa" +
+	"kinord-fronnord-odalnordreisanávuotnaobanazawaogasawaraonavstac" +
+	"konfabricaonthewifiopencraftoppegårdostrolekaostrowiecotoineppu" +
+	"overhallaoyamazakipaderbornpanasonicpassagensperspectaphilatelyp" +
+	"latform0polkowicepomorskieponiatowapordenoneporsangerporsgrunnpr" +
+	"americaprzeworskrakkestadrandabergreadymaderennesøyretrosnubric" +
+	"hardlirikubetsuringerikeringsakerriobrancorochesterryugasakiræl" +
+	"ingensamnangersanagochisantacruzsarpsborgsarufutsuscalebookschoo" +
+	"lbusscientistscrappingseranishiservebeerserveblogservegameserveh" +
+	"ttpservepicsshangrilashibukawashifteditshimabarashimamakishimamo" +
+	"toshimodateshimofusashimoichishimokawashimonitashimosuwashinagaw" +
+	"ashinonsenshinshiroshirahamashiranukashiratakashiriuchishiroishi" +
+	"shirosatoshishikuishoparenaskjervøyskydivingskånlandsmall-webs" +
+	"nowflakesodegaurasongdalensosnowiecsoundcastsouthwestspydebergst" +
+	"arnbergstarostwostatebankstatefarmstathellestavangersteinkjersto" +
+	"ckholmstorebasestorfjordstuttgartsupersalesvn-repossykkylvensør" +
+	"-fronsør-odalsørreisatachiaraitachikawatailscaletakahashitakam" +
+	"atsutakashimatakatsukitateshinateshikagatheworkpctjeldsundtobish" +
+	"imatogakushitogliattitokamachitokashikitokushimatomakomaitoolfor" +
+	"getoyohashitrondheimtrøgstadtsuchiuratsukigatatsukiyonoturystyk" +
+	"atuxfamilytypedreamtønsberguberspaceujitawarauscultureushistory" +
+	"utashinaivacationsvaldaostavegarsheivestvagoyvevelstadvinnytsiav" +
+	"oorlopervárggátwalbrzychwashtenawwebthingswestfalenwhitesnowwl" +
+	"oclawekwodzislawyachimatayamaguchiyamanashiyamashinayamatsuriyod" +
+	"obashiyokkaichiyorkshireyoshikawayukuhashiyurihonjozachpomorzgor" +
+	"zelecåkrehamnøygardenकॉमनेटคอมไทยລາ" +
+	"ວみんなストアセール中文网亚马逊北海道和歌山" +
+	"天主教我爱你新加坡淡马锡神奈川飞利浦鹿児島1" +
+	"23miwebaarborteabashiriabkhaziaabudhabiacademiaaeroclubaeroporta" +
+	"gematsuaircraftairforceairguardakershusakrehamnallstateappchizia" +
+	"pplinziaquariumasakuchiashgabadasmatartattorneyaugustowautocodea" +
+	"vellinobabybluebabymilkbackdropbadaddjabalashovbarclaysbarefootb" +
+	"argainsbaseballbellevueberkeleyberlevagbiratoribirkenesbjarkøyb" +
+	"logsiteblogspotblogsytebluebiteboavistabouncemeboutiquebradescob" +
+	"rasiliabrindisibroadwaybroke-itbrusselsbuildingbungoonobusinessb" +
+	"uskerudbytemarkbáidárbájddarcadaquescagliaricampaigncampaniac" +
+	"ampinascandypopcapetowncateringcatholiccherkasychicappachikuseic" +
+	"hikuzenchilloutchimkentchungbukchungnamciprianicityeatscivilwarc" +
+	"leaningcliniqueclothingclouderacodebergcodespotcolumbuscommbankc" +
+	"ontagemcoolblogcorvettecraftingcuritibacutegirldagestandappnoded" +
+	"atabasedattowebddnsfreeddnsgeekddnskingddnslivedefinimadeliveryd" +
+	"eloittediamondsdinosaurdiscountdnsaliasdnstracedonostiadownloadd" +
+	"ray-dnsdrayddnsdyn-ip24dynaliaseastasiaecologiaeconomiaeducatore" +
+	"gersundegyptianeidfjordeidsbergeidsvollengerdalenscaledepilepsye" +
+	"ricssonetisalatevenassievennodefamilydsfastlylbfeedbackfentigerf" +
+	"este-ipfidelityfigueresfineartsfirmdaleflakstadflandersflesbergf" +
+	"lorencefootballfor-somefr-par-1fr-par-2freemyipfreesitefreiburgf" +
+	"ribourgfrontierfujiminofujisatofujisawafukagawafukudomifukusakif" +
+	"ukuyamafunagatafundaciofurubirafurudonofurukawafyresdalgamagorig" +
+	"entappsgjerdrumgjerstadgoodyeargraingergraphicsgrimstadgrossetog" +
+	"uardianguernseygyeonggihabikinohachiojihakodatehamatamahanamakih" +
+	"ashbanghatogayahatoyamahayakawahdfcbankhelsinkihemsedalhepforgeh" +
+	"inoharahirakatahirogawahirokawahirosakihisayamahistoirehjartdalh" +
+	"okkaidohokksundholdingsholtalenhomelinkhomeunixhonbetsuhonefossh" +
+	"orologyhoronobehospitalhostedpihoyangerichiharainashikiinatsukii" +
+	"nderøyindigenainfinitiingatlanipirangais-a-cpais-foundis-savedi" +
+	"s-slickisa-geekishigakiishikariistanbulitabashiitoigawaiwaizumii" +
+	"watsukijaworznojelasticjessheimjevnakerjls-sto1jls-sto2jls-sto3j" +
+	"otelulujpmorganjølsterkadogawakagaminokakegawakakinokikakogawak" +
+	"almykiakamagayakamaishikamakurakameyamakamigorikamiichikamikawak" +
+	"amiminekamisatokamitsuekamoenaikamogawakanagawakanazawakaneyamak" +
+	"arasjokkasaharakasukabekatagamikatowicekawaharakawajimakawakitak" +
+	"awamatakawanabekawasakikawatanakembuchikibichuokikirarakikugawak" +
+	"inghostkinokawakirkeneskisarazukisosakikitaaikikitagatakitagawak" +
+	"itahatakitakamikitakatakitamotokiyokawakiyosatokomaganekonsulatk" +
+	"onyvelokopervikkotohirakozagawakragerøkrellianktistorykudoyamak" +
+	"ujukurikuleuvenkumagayakumamotokumatorikumejimakumiyamakunigamik" +
+	"unisakikunitomikunneppukuriyamakuroishikurotakikustanaikuzumakik" +
+	"vafjordkvalsundkvitsøykyotambakyotangokåfjordlangevaglaspezial" +
+	"avangenlclstagelevangerlightinglimanowalodingenlombardylondrinal" +
+	"ovesickloyalistlubartowlundbeckmaceratamaebashimagazinemallorcam" +
+	"ansionsmaritimemaritimomarriottmarugamemarumorimarylandmaseratim" +
+	"atsumaematsuuramayfirstmazeplaymazowszemckinseymedicinamemorialm" +
+	"erckmsdmerseinemeråkermesserlimichiganmilitaryminakamiminamatam" +
+	"inisiteminokamomircloudmiyawakamiyazakimizumakimizunamimizusawam" +
+	"ombetsumonmouthmontrealmordoviamoriyamamoroyamamortgagemoseushim" +
+	"osjøenmoskenesmotoyamamoårekemuenchenmulhousemunakatamurakamim" +
+	"urmanskmy-vigormydobissmyeffectmyiphostmykolaivmymailermyphotosm" +
+	"ytuleapmålselvnagahamanagasakinagatoronakagawanakamuranakanojon" +
+	"akanotonakatanenakayamanamegatanamegawanamikatanaoshimanarusawan" +
+	"arviikanaumburgnaustdalnavuotnaneat-urlnebraskanesoddenneyagawan" +
+	"ichinanniikappunikolaevninomiyanirasakinishiizunissedalnittedaln" +
+	"l-ams-1nonoichinordkappnordlandnosegawanotairesnoticiasnotoddenn" +
+	"otogawanotteroynovecorenowarudaobserverofficialohtawaraokuizumoo" +
+	"maezakion-acornonomichionporteronrenderoppegardoristanoorkangero" +
+	"rtsinfoosterøyoygardenparallelparasitepasadenapaywhirlpharmacyp" +
+	"iacenzapicturespiedmontpiemontepimientapixolinoplatterpplumbingp" +
+	"odlasiepokrovskpoliticaporsanguportlandpresidioprimetelprincipep" +
+	"ropertyprotonetpruszkowpussycatrackmazerailroadraindropralingenr" +
+	"ankoshirawa-mazredstonerelianceremotewdrendalenrennesoyresearchr" +
+	"ibeiraoriopretorokunoherømskogrøyrviksaarlandsakahogisakegawas" +
+	"alangensalzburgsamegawasamsclubsamukawasandcatssandiegosaogoncas" +
+	"ardegnasardiniasasagurisasayamasauheradscotlandsdscloudsekikawas" +
+	"ells-itservebbsserveftpserveircservemp3servep2pservicessetagayas" +
+	"etouchisettlersshacknetshakotanshibechashinichishinjukushintokus" +
+	"hintomishiogamashiojirishiraokashizuokashoppingshopwareshowtimes" +
+	"iracusasiteleafskanlandskierváskiptvetskjervoysmushcdnsoftbanks" +
+	"oftwaresor-fronsor-odalsorocabasorreisasortlandspacekitspectrums" +
+	"talbansstargardstcgroupstgstagestrippersuginamisukagawasunnydays" +
+	"upabasesuppliessurnadalsvalbardsvizzeraswidnicasyncloudszczecins" +
+	"zczytnosørfoldt3l3p0rttabayamatakahagitakahamatakaharutakahatat" +
+	"akaishitakamoritakanabetakasagotakasakitakatoritakayamatakazakit" +
+	"akeharataketomitakikawatakinouetamakawatamamuratanaguratanangert" +
+	"anohatatarumizutashkenttateyamatelemarktemp-dnsternopilthruheret" +
+	"ingvolltohnoshotokigawatokonametokuyamatomisatotonkotsutonsbergt" +
+	"opologytorahimetoyokawatoyonakatoyosatotoyotomitrainingtransurlt" +
+	"roandintrogstadtsubetsutsukaerutsumagoitsuruokatypeformtysfjordu" +
+	"2-localuchiharauchinadauchinomiuenoharaunderseaunicloudunispaceu" +
+	"njárgaureshinousgardenuzhgorodvanguardvanylvenvbrplsbxvenneslav" +
+	"enturesverbaniavercelliverisignvestfoldvirginiavladimirvps-hostv" +
+	"ågsøywakayamawakkanaiwalloniewanouchiwarszawawebspacewedeployw" +
+	"est1-uswiardwebwildlifewindmillwoodsideworkshopyamagatayamakitay" +
+	"amamotoyamanobeyanagawayokohamayokosukayolasiteyonabaruyonaguniy" +
+	"onezawayosemiteyoshiokayugawarayusuharazakopanezentsujizhitomirz" +
+	"hytomyrålesundålgårdøstfoldдетиикомкрымсайтс" +
+	"очиישובממשלبارتبيتكتونسشبكةعراقع" +
+	"مانموقعڀارت123saitabogadoabruzzoacademyadygeyaagakhan" +
+	"agdenesaibetsuairlineairportakabiraakirunoakkeshialabamaalesunda" +
+	"libabaanamizuandroidanpachiappspotaremarkarendalarmeniaartdecoas" +
+	"akawaaseinetaskvollathletaatlantaauctionaudibleaurlandauspostave" +
+	"røyaviancaavocatsawsmpplaxarnetayagawaazimuthazuminobadajozbagh" +
+	"dadbajddarbambinabanamexbarueribauhausbellunobentleybergamobergb" +
+	"aubeskidybestbuybielawabievátbirdartbjarkoyblogdnsbloxcmsbolivi" +
+	"abolognabookingboxfusebplacedbrendlybresciabristolbrotherbryansk" +
+	"budejjubukharaburghofbuyshopbyglandbálátcaracalcaravancareersc" +
+	"arriercasacamcasertacastrescataniacatfoodcechirecertmgrcf-ipfsch" +
+	"aritycharterchicagochijiwachikugochikujochikumachintaichitosechi" +
+	"yodachowdercienciacieszyncistroncitadelcldmailclintoncloud66clou" +
+	"dnsclubmedcocottecoldwarcolognecomcastcommunecompanycompareconta" +
+	"ctconventcorsicacosenzacosidnscostumecouncilcouponscoursescremon" +
+	"acricketcrotonecruisescupcakeczeladzdaejeondazaifudediboxdenmark" +
+	"deportedetroitdnsdojodnshomedoes-itdomainsdonetskdoomdnsdrammend" +
+	"raydnsdrøbakdsmynasduckdnsdyn-vpndyndns1dynserveastus2edgeapped" +
+	"itorxedogawaeidskogeiheijieklogeselverumembetsuempresaen-rootenc" +
+	"owayenebakkenglanderoticaerotikaetajimaetnedaleurodirexposedezpr" +
+	"oxyfakefurfarmersfarsundferraraferrariferrerofetsundfinancefinla" +
+	"ndfinnøyfirenetfirenzefirewebfishingfitnessflightsfloridaflorip" +
+	"afloristflowersfolldalfor-ourfor-theforsaleforsandfra1-defreetls" +
+	"frogansfrolandfrom-akfrom-alfrom-arfrom-azfrom-cafrom-cofrom-ctf" +
+	"rom-dcfrom-defrom-flfrom-gafrom-hifrom-iafrom-idfrom-ilfrom-infr" +
+	"om-ksfrom-kyfrom-lafrom-mafrom-mdfrom-mefrom-mifrom-mnfrom-mofro" +
+	"m-msfrom-mtfrom-ncfrom-ndfrom-nefrom-nhfrom-njfrom-nmfrom-nvfrom" +
+	"-nyfrom-ohfrom-okfrom-orfrom-pafrom-prfrom-rifrom-scfrom-sdfrom-" +
+	"tnfrom-txfrom-utfrom-vafrom-vtfrom-wafrom-wifrom-wvfrom-wyfuefuk" +
+	"ifujiedafujiokafujitsufukuokafukuroifuoiskufuosskogangwongausdal" +
+	"gentinggeologygeorgiagetmyipgets-itgiessenginowangitpagegjemnesg" +
+	"jesdalgjøvikgliwicegloppengnieznogodaddygoianiagolffangoriziago" +
+	"rlicegoshikigotembagoupilegrajewogranvingraphoxgreatergrocerygro" +
+	"ndarguitarsgujaratgwangjugyokutogálsáhachijohaebaruhaibarahaku" +
+	"sanhamaroyhamburghandsonhangoutharstadhashimahedmarkhekinanhichi" +
+	"sohiranaihokuryuholidayhomednshomeftphosteurhoteleshotmailhttpbi" +
+	"nhyundaihábmerhápmiribarakiibestadibigawaichikaiikarugaikusaka" +
+	"imabariimakaneimperiain-addrinazawaincheoninderoyinuyamaipifonyi" +
+	"s-goneis-leetis-lostisahayaiseharaiserniaisesakiismailiisshikiis" +
+	"tmeinitakuraivanovoivelandiwafuneiwakuniiwakuraiwanumajamisonjeo" +
+	"nbukjeonnamjewelryjolsterjudaicajuniperkafjordkaizukakameokakami" +
+	"okakanmakikannamikanonjikanzakikaracolkaratsukareliakarlsoykarmø" +
+	"ykarpaczkartuzykarumaikasaokakashibakasugaikaszubykawagoekeliweb" +
+	"ketrzynkharkivkharkovkhersonkikonaikikuchikimitsukitaurakitchenk" +
+	"lodzkokodairakoganeikokonoekomforbkommunekotourakouhokukounosuko" +
+	"uyamakragerokrasnikkumenankuroisokusatsukushirokutchankvitsoykyu" +
+	"ragilacaixalajollalandinglanxesslaquilalarssonlasallelatrobelava" +
+	"gisleasinglebesbyleclerclegnicaleirvikleksviklezajskligurialimit" +
+	"edlincolnlindåslivornoloabátlogintoloisirslovepoplucanialucern" +
+	"elugansklyngdalláhppilærdalmachidamaibaramaizurumalborkmalselv" +
+	"mantovamarburgmaringamarketsmashikemashikimashikomatsudamatsudom" +
+	"atsunomedecinmedicalmembersmerakermessinamidsundminteremishimami" +
+	"ssilemitsukemoarekemodalenmonstermoriokamosjoenmragowomuosátmur" +
+	"oranmyamazemycloudmydattomydrobomyforummyfritzmytabitmåsøynaka" +
+	"gyonakijinnalchiknamastenankokunanmokunanporonerdpolnessebynetba" +
+	"nknetflixnetlifyneustarnewportnewyorknfshostnh-servniigataniiham" +
+	"anishigonisshinniteroinobeokanobushinodeartnorddalnorfolknoshiro" +
+	"now-dnsnærøyobihiroobninskodawaraofunatoogawaraoishidaokagakio" +
+	"kayamaokazakiokegawaokinawaokutamaoldnavyolsztynomigawaomitamaom" +
+	"otegoonagawaoncillaontarioooshikaopenairopocznooriginsorsitesost" +
+	"eroyostfoldostrodaosøyrootofukeotsuchipacificpalermoperugiapesc" +
+	"araphilipsphoenixpigboatpioneerpistoiaplesknspodhalepodzonepoint" +
+	"topoivronpolitiepoltavapomorzepotagerpotenzarailwayravendbravenn" +
+	"aravpagerealtorrecipesreclaimrenneburentalsrepbodyreservdreviews" +
+	"revistarexrothrhcloudringeburockartromskogroyrvikryokamirzeszowr" +
+	"åholtrødøyrøykensaigawasaitamasakuraisalernosaltdalsamsungsa" +
+	"ndøysannohesantafesaotomesapporosassarisatoshoschmidtschoolssch" +
+	"warzschweizscrysecseaportseljordsembokushibatashibuyashikabeshik" +
+	"aoishikshashimadashimaneshimogoshimojishirakoshiraoishitarashoba" +
+	"rasibeniksiciliasiellaksiiitessinglesskiervaskoczowskánitslattu" +
+	"msnåasesobetsusogndalsokndalsolognesondriosorfoldsquare7staples" +
+	"staticsstavernsteigenstoragestordalstrandasunndalsupportsurgerys" +
+	"uwalkisvelvikswidniksyno-dssáláttadaokatadotsutaishintakaokata" +
+	"rantotatsunoteckidstelebittemasektempurltenkawatestingtextilethe" +
+	"atertheatretheshopticketstiffanytobetsutochigitogitsutomiokatono" +
+	"shotorskentoscanatoshibatoshimatottoritourismtoyoaketoyookatoyot" +
+	"sutoyouratradingtransiptranøytrapanitrevisotriestetroitsktrolle" +
+	"ytromsøtrusteetsubametsubatatsugarutsukubatsukumitsurugitsuruta" +
+	"tsuwanotuscanytysværunazukiunjargaurakawaurayasuushuaiautwenteu" +
+	"wajimavaapstevaksdalvarggatvestnesvicenzavillagevinnicavirtuelvi" +
+	"terbovologdavpnplusværøywalmartwanggouwassamuwataraiwatchesweb" +
+	"hareweblikewebpaasweddingwestus2whalingwhoswhowindowswinnerswixs" +
+	"itewmcloudwmflabswolominworkerswpmucdnwpmudevwroclawxfinityyachi" +
+	"yoyamaxunyamazoeyanaizuyasuokayatsukayomitanyoshimizoologyzueric" +
+	"håseraløksnesørlandørskog001www9guacuabarthabbottabbvieadach" +
+	"iaejrieagencyagentsaikawaairbusairtelakadnsakaiwaalaskaalipayals" +
+	"acealstomalvdalanconaandebuannakaanquanaogakiaomoriapigeeappudoa" +
+	"ramcoarezzoariakeaseralashoroaskøyassabuassisiatsugiaustinautho" +
+	"raveroyavouesb-databaidarbamblebandaibauernbayernbeardubeautybed" +
+	"zinbeiarnbergenbhartibiellabievatbifukabihorobilbaobindalbitterb" +
+	"oomlabostikbostonbotanyboutirbrokerbrunelbunkyobusheybærumbøml" +
+	"ocamdvrcanadacasinocastlecaxiaschanelchietichiryuchonanchoseicho" +
+	"shichromechurchcinemacirclecircusclaimscliniccloud9coffeecomsecc" +
+	"ondoscountycrankycrimeacuiabadaemondaisendallasdapliedatingdatsu" +
+	"ndd-dnsdealerdeatnudebiandegreedentaldiademdigickdisrecdnsfordog" +
+	"adodopaasdrobakdunlopdupontdurbandurhamdvrcamdvrdnsdynvpndyrøyd" +
+	"ønnaebetsuedugitegoismelastxelblagelburgemerckenergyesashievene" +
+	"seventsexeterfauskefbx-osfinnoyfitjarfjalerflickrfloppyflorøfog" +
+	"giaforgotfortalforumzfosnesfrostafruskyfrænafrøyafukayafutabaf" +
+	"utbolfuttsuførdegallupgamvikgaulargdanskgdyniagehirngeiseigenka" +
+	"igenovageorgeginozagitappgitlabgivinggjovikgleezeglitchglogowglo" +
+	"omygojomegokasegonohegotdnsgratisgroznyhabmerhaborohadanohadselh" +
+	"akatahakonehakubahaldenhamadahamurahanawahannanhapmirhappouharei" +
+	"dharimahasamahasamihasudahasvikhawaiihegurihellashemneshermesher" +
+	"øyhetemlhidakahidorahikonehimejihiphophiradohirarahirayahobølh" +
+	"okutohomeiphonjyohoplixhortenhugheshungryhurdalhvalericurusidret" +
+	"tiijimaiitateiiyamaiizukaiizunaimamatin-brbin-dslin-vpninsureint" +
+	"uitiopsysitomaniwanaijaguarjambyljobojijoburgjoetsujogaszjohanaj" +
+	"oyentjuegoskadenakahokukainankaiseikakudakaliszkalugakanoyakanum" +
+	"akaratekariwakariyakarmoykasuyakatanokaufenkawabakawarakawauekaw" +
+	"azukayabekazunokeisenkhplaykihokukiminokinderkindlekitamikiyamak" +
+	"iyosekiyosuklæbukomakikomonokomorokomvuxkoobinkosakakosherkosug" +
+	"ekozakikrakowkumanokundenkunimikuratekurgankurobekurogikuwanakyo" +
+	"nanlabourlahppilanbiblancialandeslardallarviklatinalatinoleborkl" +
+	"ecznalefraklenviklerdalliernelindasloabatlockerlogoiplohmuslondo" +
+	"nlouvrelowiczlublinlunnerlurøyluxuryluzernlyngenløtenmacapamac" +
+	"eiomadridmagnetmakeupmalvikmanausmandalmarchemarinemarkermasakim" +
+	"asudamateramatsuemattelmazurymeeresmeguromeldalmelhusmeløymemse" +
+	"tmiastamidorimielecmielnomifunemihamamiharumikasamilanomimatamin" +
+	"anomincomminersminingminobumisakimisasamisawamisugimitakamitakem" +
+	"itoyomiyadamiyagimiyakemiyazumiyotamizuhomlbfanmmafanmobaramoden" +
+	"amodernmolisemonashmorenamormonmoscowmosvikmotegimotobumotosumun" +
+	"ciemuosatmurotomuseetmusicamy-wanmyddnsmyfastmyjinomypetsmywirem" +
+	"āorinabarinagaranagasunagisonagoyanaharinakamanamsosnantannaple" +
+	"snaritanarutonarviknatorinayoronemuronerimanessetnflfannhlfannie" +
+	"pcenikahonikitaninohenissannissaynogatanohejinohostnombrenortonn" +
+	"ovaranowruznumatanumazunyuzenocelotodessaoguchiohkuraoiraseoksne" +
+	"soleckoolkuszomniweon-k3son-rioon-webonjukuookuwaoppdaloracleork" +
+	"dalorlandorskogosascoosoyrootsukioxfordpadovapagexlpalacepalmasp" +
+	"anamaparochpatriapecoripeeweepfizerpgafanphysiopictetpilotspinok" +
+	"opl-wawplantspoliceponpesportalpowiatpoznanpressepubtlspueblopug" +
+	"liapulawyqbuserquebecracingradøyragusaraholtranzanrealtyrecifer" +
+	"eisenreklamrepairreportriminirindalrisørrocherrogersrollagrovig" +
+	"oroykenruovatrussiarybnikryukyuráisarørossadistsaikaisakadosak" +
+	"akisakatasakuhosandoysannansanofisanukisaromasasebosavonasecaass" +
+	"ecretseidatseirouselfipsellfyseminesenasasennansettsushiibashing" +
+	"oshingushinjoshioyashisuishonaishoujishunansicilysigdalsiljansir" +
+	"dalskanitskjåkskodjeslupsksmølasnaasesnoasasnåsasoccersolunds" +
+	"phinxstangestdlibstjohnstokkestolosstudiosugitosuissesukumosulda" +
+	"lsumidasumitasupplysurreysusakisusonosuzakasuzukasuzukiswatchswe" +
+	"densydneyszkolasálatsøgnesømnasørumtabusetagajotaharatainait" +
+	"aipeitajimitajiritakagitakasutaketatamakitamanotamayutaobaotaram" +
+	"atargettattootennisteramotermeztiendatjmaxxtjømetkmaxxtochiotog" +
+	"anetoguratomaritomikatomiyatomobetonakitonamitoridetorinotowadat" +
+	"oyakotoyonetoyonotoyotatozawatozsdetradertranbytranoytrentotrito" +
+	"ntromsatromsotrysiltrænatsuikitsukuitsunantwmailtynsettysnestys" +
+	"varufcfanumbriaunicomurasoeurausuusartsushikuutazasutsirav-infov" +
+	"adsøvagsoyvantaavardøvaresevelvetvenetoveniceverdalveronaverra" +
+	"nversusvestbyviajesvikingvillasvivianvoagatvotingvoyagevpndnsvue" +
+	"losvåganvågåvålerwajikiwakuyawalterwarabiwarmiawatariwatsonw" +
+	"ebcamwebhopwegrowwielunwzmiuwxihuanxs4allyabukiyachtsyahabayahik" +
+	"oyakageyakumoyamadayaotsuyasakayashioyasudayasugiyatomiyawarayoi" +
+	"chiyokoteyokozeyonagoyuzawazamamizapposzombieáltáårdalåsnesø" +
+	"rstaбелбизмирмкдмонмскоброргрусспб" +
+	"србукрупрқазհայצהלקוםعربقطركوممص" +
+	"رგეコム三重世界个人中信中国中國京都企业佐" +
+	"賀佛山信息個人健康八卦公司公益兵庫千葉台湾台" +
+	"灣商城商店商标商業在线埼玉大分大拿大阪奈良娱" +
+	"乐宮城宮崎家電富山山口山形山梨岐阜岡山岩手島" +
+	"根广东広島微博徳島愛媛愛知慈善手机招聘政务政" +
+	"府敎育教育新潟新闻时尚書籍東京栃木沖縄游戏滋" +
+	"賀澳門澳门点看熊本石川福井福岡福島秋田移动箇" +
+	"人組織組织網絡網络網路组織网址网店网站网絡网" +
+	"络群馬联通臺灣茨城谷歌购物通販長崎長野集团青" +
+	"森静岡食品餐厅香川香港高知鳥取닷넷닷컴삼성한" +
+	"국1kapp4limaabenoabikoadimoadultaetnaagraraisaiakunealcesambera" +
+	"mfamamicaamuneananiangryappleaskeraskimaskoyasnesassurasukeaudio" +
+	"aukraautosawdevbaidubalatbandobardubarumbaselbathsbeatsbegetbela" +
+	"ubelembeppubibaibiblebiharbingobizenbjugnblushboatsbodøbomlobos" +
+	"chbrynebusanbuzenbyklebytomcafjscanoncapoocardscargocarrdchasech" +
+	"eapchipschitachofuchoyociticcoachcoprocrowncuneocybercymruczestd" +
+	"aburdaegudaigodaiwadanceddns5ddnssdealsdedyndelhideltademondiher" +
+	"dollsdonnadoshidovredubaidyn53dynnsdynv6dyroyeartheatonebinaebin" +
+	"oedekaeniwaepsonerimoessexexnetfaithfbsbxfbxosfedexfedjefeirafer" +
+	"mofieldfinalfldrvflierfloraflorofordeforexfortefranafrognfroyafu" +
+	"chufudaifukuifussagallogalsagenoagiftsgiizeginangirlygiskegivesg" +
+	"lassglobogmailgminagonnagorgegosengotsugranegretagripegronggucci" +
+	"guidegulengunmahaccahakuihalsahandahannohanyuheavyheroyhicamhiok" +
+	"ihippyhitrahobolhondahongohoptohorsehurumhyatthyogohyugaibxosihe" +
+	"yaikedailawaimariinabeinagiindieinzaiirumais-byitakoitanoitigoiw" +
+	"adeiwamaiwataiwateizenajampajetztjgorakaitakanankaniekanrakapsik" +
+	"asaikepnokinkokiryuklabukleppkmpspkochikoelnkomaekonankoninkoori" +
+	"koryokosaikoseikoshukozowkppspkuronkutnokwpspkyotokyowalaborlaka" +
+	"slamerlazioleaselecceleccolegalleluxlenuglesjalexuslillylipsyloa" +
+	"nslocuslomzalon-1lon-2loppalotenlottolubinluccalukowluroylutsklä" +
+	"nsmacysmangomannomaorimasoymcdirmcpremeiwameloymiamimiasaminohmi" +
+	"toumiuramodummoldemoneymoviemuikamyftpmyokomypepmypsxmytismyvncn" +
+	"againakainaklonanaenanaonanbunangonanjonantonanyonaroynatalnaval" +
+	"navoinesnaneuesnexusniiminiizanikkonikonninjano-ipnodesnokianowt" +
+	"vntdllnuorooaraiobamaobiraobuseodesaoganoogimiogoriogoseoharuoji" +
+	"yaoketoolawaomahaomegaomutaonionooguyopoleosetootagootakeotariot" +
+	"aruotobeowaniownipoyabeoyodoozorapaduapaleoparisparmapartipartsp" +
+	"artypaviapennepenzapermapgfogphonepippupizzaplazapokerpratopraxi" +
+	"prgmrpromoprvcypstmnpubolpunyupymntradomradoyraumarebunrechtreha" +
+	"bricohrietirisorrissarittorivnerockyrodeorodoyrorosrovnorugbyryg" +
+	"geryuohråderøstsabaesagaesaijosaikisaitosakaesakyosalonsaludsa" +
+	"mpasangosanjosanoksattesaudaseihiseiyosejnysekd1selbuseljeseners" +
+	"eoulsharisharpshellshoesshowasienaskaunskienskjakskoleskypeslask" +
+	"slingsmilesmolasnasasochisoedasognesolarsomnasopotsorumspawnspdn" +
+	"sstabastadasteamstorjstrynstudysuckssuifusuitasveioswisstaijitai" +
+	"kitairataitotakkotargitaruitendoteneitenriternitexastgorythickti" +
+	"restjometksattmalltodaytohmatokaitolgatoolstoraytotaltourstranat" +
+	"unesturekturintushutychytydalubankudineujiieukihaulvikumajiunnan" +
+	"unzenupaasupperurawaurownusersustkausukiutazuvadsovaganvardovaro" +
+	"yvefsnvegasvideovodkavoldavolvovolynwalesweberweibownextworldxer" +
+	"oxxnbayyaeseyahooyaitayaizuyaltayoitayomboyoriiyouthyuasayusuiza" +
+	"ganzaptozarowzgoraåmliåmotøyer0emm12hp133716-b2000203832-b64-" +
+	"baarpacctaioiakdnalp1armyarvoassnaxisbar0bar1bar2bbvabeepbieibig" +
+	"vbikebillbodobofaboknboltbondbonnbuzzbyencafecallcbreciaoclancnp" +
+	"ycoalcodycomocrewcurvcyoncyoudclkdetadgcadietdishdruddvagdynue16" +
+	"4ebizes-1eu-1eu-2eu-3eu-4fagefailfansfhskfiatfidofilmflapflirflo" +
+	"gflåfnwkfoolfr-1fuelfusogbizggeegifugluggmbhgobogodogouvgovtgra" +
+	"zgriwgrueguamgugegujohagahairhelphgtvhlx3holeholyhsbci234icbciee" +
+	"eimdbiobbiraqivgujavajeepjejujobsjosojoyojozijprsjuifkaaskazokdd" +
+	"ikievkijokillkilokiwikizukobekofukokakpmgkredkrymkujikurekusukva" +
+	"mkyivlairlanslapylegolgbtlidllimolmpmlugslvivlynxmaifmanxmcpemee" +
+	"tmememenumibumihomockmodsmossmugimukomycdmydsmypina4unabunaienee" +
+	"nnikenoginoipnoopny-1ny-2nyaanyannynynysaoddaohdaoirmollooopsosl" +
+	"ootapoyerparspccwpinbpinkpisapiszpohlpornpupuqotoqponreitriikroo" +
+	"mrsvpruhrrzgwsadosarlsatxsaxosayoseatseeksexysg-1shawshoosilksnc" +
+	"fsohusojasokasomasonysowasrcfsrhtsulisumysurfszextanktaxitcp4tia" +
+	"atinntipstlontobatoeitogotoystreetulatunktuvauedauni5uozuupliupo" +
+	"wus-1us-2us-3us-4utahuvicvisavivavivovlogvotevotowakeweirwienwii" +
+	"hwinbwioswitdwskrwuozx443xboxyufuyukizeroελευакбгеюод" +
+	"рф2ix611aaaabcarqbbcbbtbcgbcnbhzbidbipbmdbmsbmwbnrbzhbzzc66cbg" +
+	"cbncbsceocfdcimcngcnscntcogcozcrdcrscsxddsdhldiydnpdrremrenfesqf" +
+	"emfhvfltfncfndfotfoxfozfrlfstftrfvgfyig12gapggfgmxgopgrpgsjhkthr" +
+	"ahzcibmidvip6it1itvjcbjdfjedjfkjlljmpjnjjpnk12k8skfhkpnkrdlk3llp" +
+	"mlsmtnmupn4tnhknhsnidnjsnrwnswocpomgovhoxaphdphxpiwplcpmnpncpoap" +
+	"rdpvhpvtpwcq-aqa2qcxqldqsls5ysbsscbscwsewshwsjcsldslgslzsoospbsr" +
+	"lsrvsvctcitcmtdktjxtmptrdtrvtvsuk0ulmusruwuvaovfsvgsvixvp4vxlwhm" +
+	"wiwwowwtcwtfxenxinxxxxyzynhyuuzipzlg5g6ge4gqgxkzmqqhxjxzzwя" +
+	""
+
+// nodes is the suffix table of tlds.TLDs, tlds.WildcardTLDs, tlds.ExceptionTLDs and the private
+// TLDs: a trie of their rules keyed by their labels in reverse order, laid out breadth-first, the
+// root first. See Node for the layout of a node.
+var nodes = [...]uint64{
+	0x0001174800000000, 0x05d30000270c00c1, 0x05d3000025f98101, 0x05d300001e4e4181,
+	0x05d300001e4fc0c1, 0x05d300001e4fc181, 0x05d300001e514181, 0x05d30000270cc0c1,
+	0x05d3000000694101, 0x05d30000191b01c1, 0x05d30000139b4201, 0x05d3001c003d4081,
+	0x05da0004191e81c1, 0x05db00000f44c241, 0x05db000002c54281, 0x05db000009c6c2c1,
+	0x05db00000a2980c1, 0x05db00000a258141, 0x05db00040060c081, 0x05dc0000087300c1,
+	0x05dc000023600141, 0x05dc002001474081, 0x05e4000023ba40c1, 0x05e401580f494101,
+	0x063a000023614141, 0x063a00140057c081, 0x063f00001a6200c1, 0x063f00000ccac181,
+	0x063f0014008b0081, 0x06440000192201c1, 0x064400001e55c181, 0x06440014005d0081,
+	0x06490000027980c1, 0x064900001e5a4181, 0x0649000013a74201, 0x064900001e5bc181,
+	0x0649000025fc8101, 0x0649001c00210081, 0x065000000f548241, 0x06500000193381c1,
+	0x065000001e61c181, 0x065000000f56c241, 0x0650000013af4201, 0x06500000226d8101,
+	0x065000001e634181, 0x065000001e64c181, 0x065000240087c081, 0x065900000f5d8181,
+	0x06590000033b83c1, 0x0659000004888381, 0x065900001968c101, 0x065900002368c141,
+	0x06590000236a0141, 0x065900000f620241, 0x065900000f668241, 0x06590000193701c1,
+	0x065900001e6c4181, 0x065900000066c0c1, 0x0659001800678081, 0x065f00001cac40c1,
+	0x065f00000c33c281, 0x065f007000e040c1, 0x067b0000236f0141, 0x067b00000f788081,
+	0x067b00000f788241, 0x067b0038004a4081, 0x0689000019968101, 0x068900001e73c181,
+	0x0689000003e64141, 0x0689000025fe8101, 0x068900181ba48101, 0x068f000002ca00c1,
+	0x068f000002d0c101, 0x068f000400298081, 0x069000001edf4101, 0x06900004147c4101,
+	0x069100000c38c281, 0x06910048001cc081, 0x06a30000194881c1, 0x06a3000013bd4201,
+	0x06a30048025fc081, 0x06b50000194c01c1, 0x06b50000194dc101, 0x06b50000194dc1c1,
+	0x06b500002377c141, 0x06b50000195141c1, 0x06b500001e82c181, 0x06b500000c3b4101,
+	0x06b50000237a4141, 0x06b500001954c1c1, 0x06b50004008c4081, 0x06b60000008c40c1,
+	0x06b600200cfb0081, 0x06be0000195a00c1, 0x06be003002e98081, 0x06ca000003430141,
+	0x06ca0020004a0081, 0x06d2000013c54101, 0x06d20000237cc141, 0x06d20000196801c1,
+	0x06d2000004a10381, 0x06d2000003eac101, 0x06d2000012b80101, 0x06d20000004a00c1,
+	0x06d200000f9ec241, 0x06d2000009e502c1, 0x06d2000013cf4201, 0x06d2000013d14201,
+	0x06d2000013d34201, 0x06d2000013d54201, 0x06d200080c4a4281, 0x06d40000196b81c1,
+	0x06d400001e8ec181, 0x06d400280d26c081, 0x06de0000270e40c1, 0x06de0000270f00c1,
+	0x06de000026058101, 0x06de0000270fc0c1, 0x06de0000271080c1, 0x06de000014570083,
+	0x06de002001298081, 0x06e6000023858141, 0x06e600001e91c181, 0x06e60000125bc101,
+	0x06e60000196f01c1, 0x06e600000cc34181, 0x06e6000001d20101, 0x06e60000197601c1,
+	0x06e6000007cb40c1, 0x06e600040a768081, 0x06e700981f4b8081, 0x070d00140f0bc081,
+	0x071200001e97c181, 0x0712001401438081, 0x07170000238d0141, 0x07170000271200c1,
+	0x0717000026098101, 0x0717000017424101, 0x07170000238f8141, 0x07170000265a40c1,
+	0x0717003c2390c0c1, 0x0726005406944081, 0x073b0000076f8141, 0x073b000009f842c1,
+	0x073b000009fb02c1, 0x073b00000289c101, 0x073b00000fb78241, 0x073b000013c64101,
+	0x073b001404a48081, 0x07400000271440c1, 0x07400000271500c1, 0x074000180c594081,
+	0x074600000c594281, 0x074600a4011dc081, 0x076f000023948141, 0x076f00000c5bc281,
+	0x076f0000260c8101, 0x076f00001c5b80c1, 0x076f0000260f8101, 0x076f000003d2c0c1,
+	0x076f000003d2c101, 0x076f00001985c1c1, 0x076f000023984141, 0x076f00001ea54181,
+	0x076f00001ea6c181, 0x076f0000034a80c1, 0x076f000013ef4201, 0x076f00000c5100c1,
+	0x076f023400660081, 0x07fc000013f14201, 0x07fc00000a0342c1, 0x07fc000013f74201,
+	0x07fc00001eab4181, 0x07fc0000199041c1, 0x07fc000013fb4201, 0x07fc00180025c081,
+	0x080200140d9c4081, 0x080700000a388141, 0x080700040bbc4201, 0x0808000414014201,
+	0x080900000c6340c1, 0x0809000026118101, 0x0809000005888081, 0x0809000818c70081,
+	0x080b001801360081, 0x0811001c27168081, 0x08180000271680c1, 0x0818005400154081,
+	0x082d00000a0e40c1, 0x082d000026138101, 0x082d000001de80c1, 0x082d000026148101,
+	0x082d00000a1102c1, 0x082d000002da40c1, 0x082d000002da4181, 0x082d0000035d4101,
+	0x082d000023a10141, 0x082d000014174201, 0x082d00000c74c1c1, 0x082d00000c74c281,
+	0x082d000001c340c1, 0x082d000019a001c1, 0x082d000023a38141, 0x082d000001d60101,
+	0x082d000019a1c181, 0x082d000019a1c1c1, 0x082d000008198101, 0x082d000404af0101,
+	0x082e000019a70101, 0x082e00000d978101, 0x082e00001eb74181, 0x082e0000001c80c1,
+	0x082e000014194201, 0x082e0000141b4201, 0x082e0000150c00c1, 0x082e0000271980c1,
+	0x082e000026158101, 0x082e0000271a40c1, 0x082e0024003d8081, 0x0837000406078081,
+	0x0838000004c98181, 0x08380000271b00c1, 0x083800000894c101, 0x0838000407a54081,
+	0x0839000007a540c1, 0x08390000271bc0c1, 0x0839000005d78081, 0x0839003400084081,
+	0x084600001ebbc181, 0x0846000004c5c1c1, 0x0846000019b341c1, 0x0846000023a74141,
+	0x084600000a194101, 0x0846000023a88141, 0x0846000019bf81c1, 0x084600000fe00241,
+	0x084600001ec4c181, 0x084600001ec64181, 0x08460044003dc081, 0x08570000142d4201,
+	0x085700001ec94181, 0x0857000008e54141, 0x0857000019cbc1c1, 0x0857000023aec101,
+	0x0857000023aec141, 0x08570000115d8101, 0x08570000142f4201, 0x08570004021d4083,
+	0x0858001401440081, 0x085d00001ecc4181, 0x085d000014334201, 0x085d0000078d8141,
+	0x085d00001ecdc181, 0x085d000014354201, 0x085d000014374201, 0x085d006c01b98141,
+	0x0878000c13a04101, 0x087b000019d481c1, 0x087b00100b1b8081, 0x087f00bc04bd0081,
+	0x08ae005c00100081, 0x08c5000023b00141, 0x08c50004143d4141, 0x08c600001ed0c181,
+	0x08c6000007e8c1c1, 0x08c6000019d9c1c1, 0x08c6063c0013c0c1, 0x0a55000019db81c1,
+	0x0a55000014414201, 0x0a55000c06004241, 0x0a58000019df01c1, 0x0a58000019e0c1c1,
+	0x0a5800000373c201, 0x0a5800001ed24181, 0x0a5800001ed3c181, 0x0a58000007908301,
+	0x0a5800000c9f4281, 0x0a58000019e281c1, 0x0a5800000a2442c1, 0x0a58000004c401c1,
+	0x0a58000004c40381, 0x0a58000814454101, 0x0a5a00000a270101, 0x0a5a000019e601c1,
+	0x0a5a0000044dc1c1, 0x0a5a000019eec181, 0x0a5a000019eec1c1, 0x0a5a000019f081c1,
+	0x0a5a0000120b80c1, 0x0a5a001c05754081, 0x0a6100000a2c8181, 0x0a6100000ca44281,
+	0x0a6100000a2c82c1, 0x0a61000019f401c1, 0x0a61000023b28141, 0x0a6100002721c0c1,
+	0x0a61000019f78181, 0x0a61000019f781c1, 0x0a61001801430081, 0x0a6700000ca6c281,
+	0x0a67001826ef4081, 0x0a6d001009524081, 0x0a71000c275e0081, 0x0a74003007a30081,
+	0x0a80000023b64141, 0x0a800000261f8101, 0x0a8000180c558081, 0x0a86000023b8c141,
+	0x0a8600000cb7c0c1, 0x0a86000023bdc141, 0x0a8600000716c101, 0x0a860000051e0101,
+	0x0a8600001ee14181, 0x0a8600001ee2c181, 0x0a86000009fa40c1, 0x0a86000026208101,
+	0x0a860000272340c1, 0x0a86011c000b4081, 0x0acd00001ee5c101, 0x0acd00001ee5c181,
+	0x0acd000023c18141, 0x0acd00001eea4181, 0x0acd000014614201, 0x0acd000000648101,
+	0x0acd000014634201, 0x0acd000023c54141, 0x0acd000006630201, 0x0acd00001eebc181,
+	0x0acd0000000b41c1, 0x0acd000005398101, 0x0acd000405398181, 0x0ace005c037b40c1,
+	0x0ae50000272400c1, 0x0ae5000014654201, 0x0ae5000026238101, 0x0ae50004012241c1,
+	0x0ae6000001fc8181, 0x0ae6000001fc8241, 0x0ae6000014694201, 0x0ae600000ff68201,
+	0x0ae6000026248101, 0x0ae600002724c0c1, 0x0ae600000a0d4081, 0x0ae6002008444081,
+	0x0aee001401514081, 0x0af30000272580c1, 0x0af3002802844081, 0x0afd00000b8b8101,
+	0x0afd00000ac24181, 0x0afd000003c4c0c1, 0x0afd00001a0c81c1, 0x0afd00001d8ec0c1,
+	0x0afd000014714201, 0x0afd0000089d4141, 0x0afd00000be180c1, 0x0afd000023ce0141,
+	0x0afd00001ef7c181, 0x0afd00001ef94181, 0x0afd00001efac181, 0x0afd000026268101,
+	0x0afd00000a1880c1, 0x0afd00280eb54081, 0x0b07000423d44141, 0x0b08000004dc80c1,
+	0x0b080038000fc081, 0x0b160000000fc0c1, 0x0b16000023d94141, 0x0b160004075840c1,
+	0x0b17000407584241, 0x0b1800280150c081, 0x0b220024039f0081, 0x0b2b0000063c0141,
+	0x0b2b00001f0e4181, 0x0b2b00001f0fc181, 0x0b2b0000052b0201, 0x0b2b00000a60c2c1,
+	0x0b2b00000a6382c1, 0x0b2b000023dbc141, 0x0b2b0000062b8241, 0x0b2b000000228083,
+	0x0b2b000014934201, 0x0b2b00000b398101, 0x0b2b001c000d0081, 0x0b320000272880c1,
+	0x0b320004044f8181, 0x0b330020004b0081, 0x0b3b000014954201, 0x0b3b0024006a0081,
+	0x0b4400000cdb4281, 0x0b440004006a00c1, 0x0b4500081f144181, 0x0b47000006f20201,
+	0x0b470000012b4181, 0x0b4700001a4101c1, 0x0b470000033d81c1, 0x0b4700000ce04281,
+	0x0b470000262f8101, 0x0b47000026308101, 0x0b470000101cc241, 0x0b47000423e0c141,
+	0x0b480000048a8181, 0x0b48000003d840c1, 0x0b48000026318101, 0x0b480004062a8101,
+	0x0b4900001a4641c1, 0x0b49000007a581c1, 0x0b490000028bc101, 0x0b49000023e48141,
+	0x0b490000149f4201, 0x0b4900001a4b81c1, 0x0b4900001a4d41c1, 0x0b490028005e8081,
+	0x0b53000026338101, 0x0b53000014a54201, 0x0b53000026348101, 0x0b53000026358101,
+	0x0b53000023eac141, 0x0b5300001a50c1c1, 0x0b530004005e8241, 0x0b5400000293c101,
+	0x0b54000010238241, 0x0b54000014ab4201, 0x0b5400001a5b4101, 0x0b5400001a5b41c1,
+	0x0b5400001a5d00c1, 0x0b5400001a5d01c1, 0x0b54002807d08081, 0x0b5e000026664083,
+	0x0b5e00001f1ec181, 0x0b5e00001a5ec1c1, 0x0b5e000026378101, 0x0b5e00001a6401c1,
+	0x0b5e00001a65c1c1, 0x0b5e000007ab80c1, 0x0b5e001810c24081, 0x0b64000001d78081,
+	0x0b6400000a8480c1, 0x0b6400000a848101, 0x0b6400000a8482c1, 0x0b64000014b54201,
+	0x0b6400001ef28101, 0x0b64000023f24141, 0x0b6400001a6cc1c1, 0x0b64000011880141,
+	0x0b6400000cea4281, 0x0b640000272dc0c1, 0x0b640088000e8081, 0x0b8600000a4c8101,
+	0x0b86000010358241, 0x0b860000272f40c1, 0x0b8600001a73c1c1, 0x0b8600001037c241,
+	0x0b86000014c54201, 0x0b8600002730c0c1, 0x0b8600001ad781c1, 0x0b86000004bac0c1,
+	0x0b86000014d74101, 0x0b860000104c0241, 0x0b8600001f33c181, 0x0b860000273240c1,
+	0x0b86000000414081, 0x0b86000001b640c1, 0x0b8600000c3701c1, 0x0b86000023fd8141,
+	0x0b8600001f384181, 0x0b860000025b8101, 0x0b86000006774141, 0x0b8600002733c0c1,
+	0x0b860000034cc181, 0x0b860000112300c1, 0x0b860000011d8081, 0x0b860000263f8101,
+	0x0b86000803b4c081, 0x0b880004197f80c1, 0x0b89001c006e8081, 0x0b9000000260c0c1,
+	0x0b900000038a0101, 0x0b9000001ae3c1c1, 0x0b9000001f45c181, 0x0b900000009b0081,
+	0x0b90001801cb8081, 0x0b96000026408101, 0x0b96001403d0c081, 0x0b9b00180009c081,
+	0x0ba1000024014101, 0x0ba1000024014141, 0x0ba1000024078141, 0x0ba100001f4bc181,
+	0x0ba1001c008d0081, 0x0ba800002408c141, 0x0ba8000001c5c0c1, 0x0ba80000008d0181,
+	0x0ba80000240a0141, 0x0ba8000002d78081, 0x0ba80000240b4141, 0x0ba8000026438101,
+	0x0ba8000011a640c1, 0x0ba80000273540c1, 0x0ba8001801204081, 0x0bae00001afc41c1,
+	0x0bae0000105e0101, 0x0bae0000105e0241, 0x0bae00001affc101, 0x0bae0000081f80c1,
+	0x0bae000014e94201, 0x0bae000c0d00c101, 0x0bb100000d00c181, 0x0bb10000273600c1,
+	0x0bb1000008fa80c1, 0x0bb10000007500c1, 0x0bb1001c0b688081, 0x0bb8000027840081,
+	0x0bb8001c01f04081, 0x0bbf000014eb4201, 0x0bbf000014ed4201, 0x0bbf00001f5ac181,
+	0x0bbf00000d408141, 0x0bbf000024154141, 0x0bbf00001b1141c1, 0x0bbf000409574141,
+	0x0bc0000007074081, 0x0bc0002803078081, 0x0bca0020028a8081, 0x0bd2000014f34201,
+	0x0bd200002417c141, 0x0bd20000264c8101, 0x0bd2000024190141, 0x0bd200001b14c1c1,
+	0x0bd2000010c00101, 0x0bd20000095b4081, 0x0bd2001c00c9c081, 0x0bd90000264f8101,
+	0x0bd900001b2641c1, 0x0bd900001b29c1c1, 0x0bd90000196c4101, 0x0bd90000239440c1,
+	0x0bd90000150b4101, 0x0bd90000150b4201, 0x0bd9000400398181, 0x0bda00000d19c281,
+	0x0bda000026508101, 0x0bda0000150d4201, 0x0bda000001214101, 0x0bda00001f81c181,
+	0x0bda000026518101, 0x0bda00001f8c4181, 0x0bda000010868241, 0x0bda000007db81c1,
+	0x0bda00000fd880c1, 0x0bda0064068e8081, 0x0bf30000273840c1, 0x0bf3000004a68081,
+	0x0bf3001c0ecb0081, 0x0bfa0000067cc181, 0x0bfa000015274201, 0x0bfa00001b37c1c1,
+	0x0bfa0000108d4241, 0x0bfa0000108f8241, 0x0bfa000003c64141, 0x0bfa000010940241,
+	0x0bfa0000242d0141, 0x0bfa00002430c141, 0x0bfa000015374201, 0x0bfa002c01344101,
+	0x0c05000403ca01c1, 0x0c06000001ef40c1, 0x0c0600001b3ec1c1, 0x0c0600000af10181,
+	0x0c0600001b4081c1, 0x0c06000005018141, 0x0c060000090900c1, 0x0c06001804b64081,
+	0x0c0c000026558101, 0x0c0c004403d10081, 0x0c1d008001cc8081, 0x0c3d00001f9b4181,
+	0x0c3d000024334141, 0x0c3d00001b4401c1, 0x0c3d0000273a80c1, 0x0c3d000026578101,
+	0x0c3d000002b700c1, 0x0c3d000009cd00c1, 0x0c3d0038003e0081, 0x0c4b000c000a0081,
+	0x0c4e000026588101, 0x0c4e0000268600c1, 0x0c4e00001b97c141, 0x0c4e002000988081,
+	0x0c56002001da0081, 0x0c5e00001fabc181, 0x0c5e000026598101, 0x0c5e00000d37c101,
+	0x0c5e00000d37c281, 0x0c5e00b8003b0081, 0x0c8c00000a1340c1, 0x0c8c00000d3cc281,
+	0x0c8c000015474201, 0x0c8c005411878101, 0x0ca10000008b80c1, 0x0ca10000053b40c1,
+	0x0ca1000010b80241, 0x0ca10000003b0241, 0x0ca100001fb1c181, 0x0ca10004013d40c1,
+	0x0ca20000065e8341, 0x0ca200001fb34181, 0x0ca200000abe42c1, 0x0ca2012000114081,
+	0x0cea0000154b4201, 0x0cea0018025e8081, 0x0cf000240008c081, 0x0cf9000017c88141,
+	0x0cf90020000c4081, 0x0d0100001b6fc1c1, 0x0d010000000c40c1, 0x0d010000155b4201,
+	0x0d01068400264081, 0x0ea200001bc08101, 0x0ea20000273d80c1, 0x0ea200001fb94181,
+	0x0ea20000265d8101, 0x0ea20000273e40c1, 0x0ea2001002edc081, 0x0ea60000265e8101,
+	0x0ea6000024500141, 0x0ea600001b8681c1, 0x0ea600001ad640c1, 0x0ea60000274140c1,
+	0x0ea6000021ed8083, 0x0ea60000274200c1, 0x0ea600002742c0c1, 0x0ea6002004338081,
+	0x0eae000026608101, 0x0eae00001fbdc181, 0x0eae0000157340c1, 0x0eae00001fc3c0c1,
+	0x0eae037c052fc081, 0x0f8d000015754201, 0x0f8d000026648101, 0x0f8d00001fc54181,
+	0x0f8d00001b8bc1c1, 0x0f8d00001fdd4181, 0x0f8d000026688101, 0x0f8d00240160c081,
+	0x0f9600000aefc2c1, 0x0f96000005308381, 0x0f96000003f343c1, 0x0f9600002745c0c1,
+	0x0f9600300cfa0081, 0x0fa20000021d8083, 0x0fa2001c03ee4081, 0x0fa900000f5d00c1,
+	0x0fa900001d97c101, 0x0fa900000db6c0c1, 0x0fa900001fedc181, 0x0fa900001fef4181,
+	0x0fa900001bc201c1, 0x0fa90000266d8101, 0x0fa900440dc38081, 0x0fba001000940081,
+	0x0fbe00002462c141, 0x0fbe0000083e81c1, 0x0fbe000020014181, 0x0fbe001807674081,
+	0x0fc4000026728101, 0x0fc40000274680c1, 0x0fc4007808418081, 0x0fe20008274740c1,
+	0x0fe4000026738101, 0x0fe4000011318241, 0x0fe4001c045c0081, 0x0feb0010053bc081,
+	0x0fef000024744141, 0x0fef002027850081, 0x0ff7002800608081, 0x100100001be501c1,
+	0x100100000afac2c1, 0x1001000024794141, 0x1001000011480241, 0x1001000020194181,
+	0x1001000400d68101, 0x10020000114a4241, 0x100200001bea41c1, 0x100200001bef81c1,
+	0x10020000037240c1, 0x100200002020c181, 0x100200001bf141c1, 0x10020000043180c1,
+	0x100200000aca8181, 0x1002001402678081, 0x1007001c00214081, 0x100e000012efc0c1,
+	0x100e0000247bc141, 0x100e00001bf841c1, 0x100e000020254181, 0x100e0000247f8141,
+	0x100e0000267d8101, 0x100e000024848141, 0x100e0000267e8101, 0x100e000800590081,
+	0x10100000267f8101, 0x10100000068f4101, 0x10100000068f4341, 0x1010000011558241,
+	0x1010000016294201, 0x101000000da0c101, 0x101000002485c141, 0x101000001c02c1c1,
+	0x1010000026808101, 0x101000001c0481c1, 0x10100000115e8141, 0x1010000c053b0101,
+	0x1013000024870141, 0x1013000400a14101, 0x1014000004518181, 0x1014003c02834081,
+	0x10230000261500c1, 0x102300002748c0c1, 0x102300000f6a8101, 0x1023000024884141,
+	0x10230000202e4181, 0x1023000024898141, 0x102300040da5c0c1, 0x102400002032c181,
+	0x102400000339c141, 0x1024000024910141, 0x1024000007e7c101, 0x10240000056600c1,
+	0x1024000008598301, 0x1024001406d34081, 0x1029002801df0081, 0x10330008003a4081,
+	0x103500001d2080c1, 0x103500001d208101, 0x1035000801400081, 0x1037000016394201,
+	0x103700000dad4101, 0x10370000203bc181, 0x10370024018e0081, 0x10400024011ec081,
+	0x1049001800880081, 0x104f00002499c141, 0x104f00002044c181, 0x104f000026858101,
+	0x104f000003904181, 0x104f00002047c181, 0x104f00000426c0c1, 0x104f00040dbc4281,
+	0x10500000249b0141, 0x105000002114c0c1, 0x1050000007f20181, 0x105000001172c241,
+	0x105000001c2941c1, 0x1050000016494201, 0x1050000011774241, 0x1050000016514201,
+	0x1050000020584181, 0x105000000fd100c1, 0x1050000805ba8081, 0x10520000165d4201,
+	0x105200140e02c081, 0x105700ac00200081, 0x1082000000f800c1, 0x108200041184c141,
+	0x1083000026888101, 0x1083000011894241, 0x1083000026898101, 0x1083000016614201,
+	0x10830000002000c1, 0x10830004268a8101, 0x1084000016634201, 0x1084002414e70081,
+	0x108d000001d84081, 0x108d000024a50141, 0x108d000011900241, 0x108d0000048b00c1,
+	0x108d00000de1c101, 0x108d00001c400101, 0x108d00000de040c1, 0x108d00000de44281,
+	0x108d002014280081, 0x1095001c068ac081, 0x109c0000208e40c1, 0x109c0000274980c1,
+	0x109c0000001b4083, 0x109c000007cd00c1, 0x109c001005530081, 0x10a0001400628081,
+	0x10a5000801518101, 0x10a7000007650181, 0x10a7000012740101, 0x10a700001599c0c1,
+	0x10a70000127880c1, 0x10a700001648c0c1, 0x10a7000020974181, 0x10a7000024adc141,
+	0x10a700001c4a81c1, 0x10a70000209a4181, 0x10a70000168f4201, 0x10a70000209bc181,
+	0x10a700000b298101, 0x10a700000b2982c1, 0x10a700000df0c0c1, 0x10a7000024af0141,
+	0x10a70004012dc081, 0x10a8000027858081, 0x10a800081c4fc081, 0x10aa001c0099c081,
+	0x10b1000002de80c1, 0x10b1001007190081, 0x10b50000274a40c1, 0x10b50000130780c1,
+	0x10b5001c00144081, 0x10bc088800adc181, 0x12de000006698141, 0x12de0000014dc181,
+	0x12de003802d50081, 0x12ec002c22650081, 0x12f7001827358081, 0x12fd002401b80081,
+	0x130600201f28c081, 0x130e004400480081, 0x131f0000076c00c1, 0x131f000020bb4181,
+	0x131f00080e04c101, 0x1321000000aa4181, 0x132100001cad4101, 0x132100000c4000c1,
+	0x13210008000f4081, 0x13230000005a4081, 0x132300000574c0c1, 0x13230294021f00c1,
+	0x13c800001c72c1c1, 0x13c800001c7481c1, 0x13c80014021f01c1, 0x13cd00001c7801c1,
+	0x13cd00000304c0c1, 0x13cd00040304c101, 0x13ce00000ce00101, 0x13ce00000e0ec281,
+	0x13ce000024cd0141, 0x13ce002802918081, 0x13d80000089bc0c1, 0x13d8003c006e4081,
+	0x13e700000a0940c1, 0x13e70000274c80c1, 0x13e700380014c081, 0x13f5000022280101,
+	0x13f5000026998101, 0x13f5000024d20141, 0x13f5000024d34141, 0x13f5000020d94181,
+	0x13f5000020dac181, 0x13f50028053ac081, 0x13ff0b6000280081, 0x16d7000024d70141,
+	0x16d70000014ac481, 0x16d7000020e24181, 0x16d70000009440c1, 0x16d7000020e54181,
+	0x16d7000024d84141, 0x16d700000556c083, 0x16d7001c06a4c081, 0x16de0000176300c1,
+	0x16de0000274f80c1, 0x16de00001848c0c1, 0x16de001003de8081, 0x16e2000019e040c1,
+	0x16e2004000630081, 0x16f200000151c0c1, 0x16f20000170b4201, 0x16f2000006124181,
+	0x16f200001ca901c1, 0x16f200000b4d4181, 0x16f200000b4d42c1, 0x16f200001cac81c1,
+	0x16f2000026a68101, 0x16f2002400140081, 0x16fb000024ed8141, 0x16fb000c039140c1,
+	0x16fe00000a9f80c1, 0x16fe000024f00141, 0x16fe0000056380c1, 0x16fe000c05638181,
+	0x170100001cba40c1, 0x170100000ca20101, 0x170100002101c181, 0x170100040b558181,
+	0x170201c804de40c1, 0x1774000004de41c1, 0x177400001cbfc1c1, 0x1774000007d74141,
+	0x177400000f248181, 0x17740000033a00c1, 0x17740004275280c1, 0x1775002c006d0081,
+	0x17800024014f4101, 0x1789000012098241, 0x1789000025040141, 0x1789000026ab8101,
+	0x17890000044b4201, 0x178900002507c141, 0x1789000425090141, 0x178a0000120bc241,
+	0x178a0000172f40c1, 0x178a000026ac8101, 0x178a00200043c081, 0x1792000004d000c1,
+	0x1792000c036f4081, 0x17950000211b4181, 0x179500001aea8083, 0x1795002001b70081,
+	0x179d000017314201, 0x179d0000275400c1, 0x179d00001cd4c1c1, 0x179d000025108141,
+	0x179d000001ef0141, 0x179d00000b6e42c1, 0x179d000416b1c181, 0x179e0000211e4181,
+	0x179e00000a54c101, 0x179e0000211fc181, 0x179e000417354201, 0x179f0000035e00c1,
+	0x179f000005e700c1, 0x179f00000bd88101, 0x179f000026ae8101, 0x179f00001cda01c1,
+	0x179f000025130141, 0x179f00380d710081, 0x17ad02cc02030081, 0x186000040c580141,
+	0x1861000002030101, 0x18610000020302c1, 0x1861000017414201, 0x186100001e07c101,
+	0x18610008062c8081, 0x1863001414520081, 0x186800002757c0c1, 0x1868000026b18101,
+	0x1868000025158141, 0x186800001ce641c1, 0x1868000426b28101, 0x1869000000174101,
+	0x1869003400374081, 0x1876000012224241, 0x1876000025180141, 0x18760000033e0141,
+	0x18760000174f4141, 0x1876003803f480c1, 0x188400000602c101, 0x188400000b7942c1,
+	0x188400000b7c0101, 0x188400000b7ec2c1, 0x18840000251a8141, 0x1884000003f48281,
+	0x1884000017534201, 0x188400000e4ac281, 0x188400000e4d40c1, 0x188400000e4d4281,
+	0x1884001c00738081, 0x188b0028012e0081, 0x1895000403e240c1, 0x1896002009584081,
+	0x189e0000275b80c1, 0x189e001c055a8081, 0x18a50024275d0081, 0x18ae000026b58101,
+	0x18ae00002137c181, 0x18ae000000f20141, 0x18ae000021394181, 0x18ae000007c78141,
+	0x18ae00100010c081, 0x18b2000008728101, 0x18b200000e59c281, 0x18b200001cf601c1,
+	0x18b200002140c181, 0x18b200001cf7c1c1, 0x18b200000121c0c1, 0x18b2000017674201,
+	0x18b200000b8c82c1, 0x18b2000025284141, 0x18b200002143c141, 0x18b200002143c181,
+	0x18b2000026b68101, 0x18b2000017694201, 0x18b20000010b40c1, 0x18b20000010b4101,
+	0x18b200001cfd01c1, 0x18b200002146c181, 0x18b2000021484181, 0x18b2000003e1c281,
+	0x18b200000343c101, 0x18b200000e63c281, 0x18b20004013bc181, 0x18b300001d0241c1,
+	0x18b300001d05c1c1, 0x18b300000500c101, 0x18b3000012320241, 0x18b3000025298141,
+	0x18b30000126700c1, 0x18b3000008c580c1, 0x18b300040a41c0c1, 0x18b4003c001f4081,
+	0x18c30000214e4181, 0x18c3000c066cc141, 0x18c6000025324141, 0x18c60000214fc181,
+	0x18c6000026b88101, 0x18c6002800a70081, 0x18d0000026b98101, 0x18d000a000090081,
+	0x18f8000025374141, 0x18f8000026ba8101, 0x18f80028048340c1, 0x1902001c00fe0081,
+	0x1909000000fe00c1, 0x19090000215a4181, 0x1909002000b70081, 0x19110000177d4201,
+	0x1911000001c0c101, 0x1911000001c0c181, 0x191100000e6dc181, 0x1911000009128101,
+	0x1911000025464141, 0x1911000017894201, 0x191100001d21c1c1, 0x19110000042401c1,
+	0x19110000042403c1, 0x19110000216ac181, 0x1911000005f400c1, 0x1911000026bd8101,
+	0x19110000040680c1, 0x1911000002fbc101, 0x1911000026bf8101, 0x19110014009a0081,
+	0x191600000cf580c1, 0x191600002760c0c1, 0x1916001400fb8081, 0x191b000002b980c1,
+	0x191b0000276180c1, 0x191b00000e7cc281, 0x191b00001d2fc1c1, 0x191b000008ef8301,
+	0x191b000012518181, 0x191b00000addc181, 0x191b00001d3341c1, 0x191b00040153c1c1,
+	0x191c0008179d4101, 0x191e002001268081, 0x192600bc001e0081, 0x195500001771c181,
+	0x1955000026c18101, 0x19550000077a0181, 0x1955000002d84201, 0x1955000026c28101,
+	0x195500000ea0c181, 0x195500002557c141, 0x1955000417af4201, 0x195600000a660141,
+	0x19560000276300c1, 0x195600001f1580c1, 0x1956000026c38101, 0x19560000039900c1,
+	0x19560020008cc081, 0x195e002c012f8081, 0x196900001265c241, 0x19690000255b8141,
+	0x1969000026c58101, 0x19690000255cc141, 0x1969000003b84101, 0x196900001d44c1c1,
+	0x19690000255e0141, 0x1969000c04e28101, 0x196c000017cd4201, 0x196c0000218bc181,
+	0x196c000017d14101, 0x196c000017d14201, 0x196c000c00260081, 0x196f000026c78101,
+	0x196f000005ca4101, 0x196f00001d5b81c1, 0x196f004400260101, 0x1980000002ed8081,
+	0x1980000400cb0081, 0x198100000533c0c1, 0x198100000cbe4101, 0x19810000083a80c1,
+	0x198100002566c141, 0x1981001401450081, 0x1986000025694141, 0x1986000002d04081,
+	0x1986000002d04141, 0x19860000256a8141, 0x1986002000fc8081, 0x198e000026c88101,
+	0x198e001c002e4081, 0x1995000021a0c181, 0x199500000523c181, 0x1995000017e14201,
+	0x1995000017e34201, 0x1995000026c98101, 0x1995000025720141, 0x19950004013f8241,
+	0x1996000012a70101, 0x1996000026cd8101, 0x19960000177080c1, 0x1996000000e100c1,
+	0x1996000c00e10141, 0x199900000edf4141, 0x1999000013e64101, 0x1999000008b94081,
+	0x19990000276900c1, 0x1999002000470081, 0x19a10030000c8081, 0x19ad0000257ac141,
+	0x19ad00001d7241c1, 0x19ad0000066dc101, 0x19ad000012b6c241, 0x19ad000012b90241,
+	0x19ad0000013100c1, 0x19ad000017f74201, 0x19ad000012c20241, 0x19ad00001d7941c1,
+	0x19ad000c07a74141, 0x19b00000090d8181, 0x19b0000021acc181, 0x19b00000257fc141,
+	0x19b0000011568141, 0x19b000d0003b8081, 0x19e4000025810141, 0x19e4000018054201,
+	0x19e4000021b74181, 0x19e400041d8041c1, 0x19e5000026d38101, 0x19e500001d8201c1,
+	0x19e5000021c04181, 0x19e50014060ec081, 0x19ea000021c1c181, 0x19ea000025860141,
+	0x19ea000424b60081, 0x19eb001800c84081, 0x19f1000021c4c181, 0x19f100040415c1c1,
+	0x19f2000c0c550081, 0x19f50000005240c1, 0x19f5000021d3c181, 0x19f500000282c101,
+	0x19f5000021e14181, 0x19f5000021e44181, 0x19f500000ec2c281, 0x19f500001844c141,
+	0x19f5000021e5c181, 0x19f5000026d680c1, 0x19f5000026d68101, 0x19f5000c01314081,
+	0x19f80000276b40c1, 0x19f80004037b0081, 0x19f90000276cc0c1, 0x19f90008257c4101,
+	0x19fb00000ad2c101, 0x19fb00040eca4281, 0x19fc00000012c0c1, 0x19fc00001d9a81c1,
+	0x19fc000021e74181, 0x19fc0000072e0101, 0x19fc0004029b8081, 0x19fd000001cfc081,
+	0x19fd00240028c081, 0x1a06000005de40c1, 0x1a0600001da341c1, 0x1a0600001da501c1,
+	0x1a06000026d88101, 0x1a0600001da881c1, 0x1a06000021ebc181, 0x1a0600001daa41c1,
+	0x1a06000026da8101, 0x1a0600002598c141, 0x1a06000001134141, 0x1a06003c03000081,
+	0x1a15000021ed4181, 0x1a150000276d80c1, 0x1a150000067ec081, 0x1a15000021f04181,
+	0x1a150004028c8081, 0x1a16002001fa4081, 0x1a1e0000259c8141, 0x1a1e0038040d4081,
+	0x1a2c003000908081, 0x1a380004259dc141, 0x1a39000024750141, 0x1a39000025a2c141,
+	0x1a3900080a8ec0c1, 0x1a3b000025a40141, 0x1a3b00001db841c1, 0x1a3b000025a54141,
+	0x1a3b000025a68141, 0x1a3b00000303c101, 0x1a3b000022084181, 0x1a3b000026df8101,
+	0x1a3b0058004c4081, 0x1a51000406060141, 0x1a5200001dc641c1, 0x1a520000186f4201,
+	0x1a52000001614181, 0x1a520000071b0341, 0x1a52000001614241, 0x1a52000001614481,
+	0x1a5200001dd60141, 0x1a520000276fc0c1, 0x1a520044004b4081, 0x1a6300000c074101,
+	0x1a6300001fb3c0c1, 0x1a63000025a90141, 0x1a63000025acc141, 0x1a63001004e7c081,
+	0x1a670000277080c1, 0x1a67003c021f8081, 0x1a76003003a7c081, 0x1a82014c014e8081,
+	0x1ad5000025b08141, 0x1ad50000178ac0c1, 0x1ad5002409298081, 0x1ade005c0536c081,
+	0x1af500002227c181, 0x1af500000ee5c281, 0x1af5000007dac0c1, 0x1af50000096c80c1,
+	0x1af5000017f8c0c1, 0x1af50124006a4081, 0x1b3e001802a68081, 0x1b44001006d78081,
+	0x1b48000000230081, 0x1b48000013250241, 0x1b48000011388101, 0x1b480000189b4201,
+	0x1b48002006074081, 0x1b50005000400081, 0x1b64000025cac141, 0x1b64000018a34201,
+	0x1b64000018a94201, 0x1b640000024f8441, 0x1b640000018e4481, 0x1b640000093d8301,
+	0x1b64000009ca80c1, 0x1b64000404ec4081, 0x1b650014001ec081, 0x1b6a000022474181,
+	0x1b6a000025cc0141, 0x1b6a00000dd400c1, 0x1b6a00002248c181, 0x1b6a0000224a4181,
+	0x1b6a0000045200c1, 0x1b6a00000efec0c1, 0x1b6a000018ad4181, 0x1b6a000026ef8101,
+	0x1b6a000005788181, 0x1b6a000026f08101, 0x1b6a000026f18101, 0x1b6a00000f014281,
+	0x1b6a00340d84c081, 0x1b77000025cd4141, 0x1b7700000f03c281, 0x1b77000025cfc141,
+	0x1b77000026f38101, 0x1b770000224ec181, 0x1b77000026f48101, 0x1b77000022504181,
+	0x1b77002002ee4081, 0x1b7f000022534181, 0x1b7f000025d24141, 0x1b7f00001e0a81c1,
+	0x1b7f0000225c4181, 0x1b7f00001aef4101, 0x1b7f00001e0c41c1, 0x1b7f000004588141,
+	0x1b7f00001e1181c1, 0x1b7f0000058481c1, 0x1b7f000005848381, 0x1b7f00002263c181,
+	0x1b7f000025d38141, 0x1b7f0004002541c1, 0x1b8000001e1881c1, 0x1b80000025d4c141,
+	0x1b80000026f68101, 0x1b80000812a34081, 0x1b8200001e1dc1c1, 0x1b82000026f78101,
+	0x1b8200040a578101, 0x1b8300000c02c2c1, 0x1b830000013d00c1, 0x1b8300001e1f81c1,
+	0x1b830000026d4101, 0x1b8300001e2141c1, 0x1b83000011cd40c1, 0x1b83000007454341,
+	0x1b83000018cb4201, 0x1b830000021fc101, 0x1b83000018cd4141, 0x1b83000025d74141,
+	0x1b830000277a40c1, 0x1b830024008c8081, 0x1b8c0000277b00c1, 0x1b8c0000277bc0c1,
+	0x1b8c000026ff8101, 0x1b8c000025d88141, 0x1b8c00001e3101c1, 0x1b8c0000226b4181,
+	0x1b8c0000277d40c1, 0x1b8c0000277e00c1, 0x1b8c0014277ec0c1, 0x1b910000226fc181,
+	0x1b91000025dc4141, 0x1b9100001e3481c1, 0x1b9100000c084181, 0x1b910018044f4081,
+	0x1b97000013520241, 0x1b970000091b8101, 0x1b97000018d94201, 0x1b97000002d300c1,
+	0x1b9700000c0681c1, 0x1b970004042d4081, 0x1b9800001b4440c1, 0x1b98004800634081,
+	0x1baa0000228c4181, 0x1baa00001cee8101, 0x1baa000027028101, 0x1baa0000278100c1,
+	0x1baa002c1c5f4081, 0x1bb5001007444101, 0x1bb900001e4281c1, 0x1bb9001427878081,
+	0x1bbe000027038101, 0x1bbe000027048101, 0x1bbe000027068101, 0x1bbe000022954181,
+	0x1bbe000018fb4201, 0x1bbe000027078101, 0x1bbe000005960381, 0x1bbe000018fdc181,
+	0x1bbe00002299c181, 0x1bbe0000229b4181, 0x1bbe0000096d8301, 0x1bbe000009708301,
+	0x1bbe0000229fc181, 0x1bbe002822a14181, 0x1bc8000027098101, 0x1bc8000019014201,
+	0x1bc8001822a44181, 0x1bce000022a5c181, 0x1bce000022a8c181, 0x1bce000022aa4181,
+	0x1bce00100f2bc281, 0x1bd2000022ad4181, 0x1bd2000009798301, 0x1bd20000059d0381,
+	0x1bd20000097c8301, 0x1bd20000097f8301, 0x1bd2000005a08381, 0x1bd2000005a40381,
+	0x1bd200000327c401, 0x1bd20000032bc401, 0x1bd20000032fc401, 0x1bd200000333c401,
+	0x1bd2000005a78381, 0x1bd2000009828301, 0x1bd200000f2e4281, 0x1bd2000009858301,
+	0x1bd200000f30c281, 0x1bd200000f334281, 0x1bd2000019094201, 0x1bd200000f35c281,
+	0x1bd20000190b4201, 0x1bd200000f384281, 0x1bd20000190d4201, 0x1bd200000f3ac281,
+	0x1bd200000f3d4281, 0x1bd200000f3fc281, 0x1bd20000190f4201, 0x1bd2000019114201,
+	0x1bd2000022aec181, 0x1bd2000019134201, 0x1bd2000009888301, 0x1bd2000022b04181,
+	0x1bd2000005ab0381, 0x1bd2000022b1c181, 0x1bd2000022b34181, 0x1bd20000098b8301,
+	0x1bd200000192c481, 0x1bd2000019154201, 0x1bd200000f424281, 0x1bd2000005ae8381,
+	0x1bd2000005b20381, 0x1bd2000019174201, 0x1bd2000013688241, 0x1bd20000136ac241,
+	0x1bd2000001974301, 0x1bd2000001974481, 0x1bd200000463c3c1, 0x1bd20000046783c1,
+	0x1bd20000046b43c1, 0x1bd20000098e8301, 0x1bd2000009918301, 0x1bd2000009948301,
+	0x1bd2000009978301, 0x1bd20000099a8301, 0x1bd2000000820541, 0x1bd20000019bc481,
+	0x1bd2000000000841, 0x1bd20000046f03c1, 0x1bd20000099d8301, 0x1bd200000472c3c1,
+	0x1bd2000009a08301, 0x1bd20000136d0241, 0x1bd20018136f4241, 0x1bd8000013718241,
+	0x1bd8000022b4c181, 0x1bd800001373c241, 0x1bd8000009a98301, 0x1bd8000009ac8301,
+	0x1bd8000009af8301, 0x1bd8000022b64181, 0x1bd8000013760241, 0x1bd8000013784241,
+	0x1bd8000001adc481, 0x1bd8000009b28301, 0x1bd8000022b94181, 0x1bd8000022bc4181,
+	0x1bd8000022bdc181, 0x1bd8000022bf4181, 0x1bd80000137a8241, 0x1bd80000137cc241,
+	0x1bd8000022c24181, 0x1bd8000022c54181, 0x1bd8000022c6c181, 0x1bd8000022c9c181,
+	0x1bd8000022cb4181, 0x1bd8000022ccc181, 0x1bd8000022ce4181, 0x1bd8000022d2c181,
+	0x1bd8000022d44181, 0x1bd8000022d5c181, 0x1bd8000022d74181, 0x1bd8000022d8c181,
+	0x1bd80000047a4181, 0x1bd80000047a43c1, 0x1bd8000022dbc181, 0x1bd8000022e04181,
+	0x1bd8000013838241, 0x1bd8000022e4c181, 0x1bd8000022e94181, 0x1bd8000022f6c181,
+	0x1bd8000022f9c181, 0x1bd8000022ffc181, 0x1bd800001385c241, 0x1bd8000023014181,
+	0x1bd800002302c181, 0x1bd8000023044181, 0x1bd800002305c181, 0x1bd8000013880241,
+	0x1bd80000230bc181, 0x1bd80000230d4181, 0x1bd80000230ec181, 0x1bd8000009b70181,
+	0x1bd80000138a4241, 0x1bd800002314c181, 0x1bd800002317c181, 0x1bd8000023194181,
+	0x1bd80000231ac181, 0x1bd8000023254181, 0x1bd8000009b58301, 0x1bd8000023314181,
+	0x1bd800002332c181, 0x1bd8000023344181, 0x1bd8000023374181, 0x1bd80000233a4181,
+	0x1bd80000233bc181, 0x1bd80000233ec181, 0x1bd8000023404181, 0x1bd800002341c181,
+	0x1bd8000023464181, 0x1bd8000009b88301, 0x1bd80000138ec241, 0x1bd80000234ac181,
+	0x1bd80000234c4181, 0x1bd8000009bb8301, 0x1bd80018234f4181, 0x1bde00002353c181,
+	0x1bde000023554181, 0x1bde00002356c181, 0x1bde000023584181, 0x1bde00000013c0c1,
+	0x1bde0000272640c8, 0x1bde0000075840c1, 0x1bde0000007500c1, 0x1bde0000048b00c1,
+	0x1bde0000021f00c1, 0x1bde000004de40c1, 0x1bde0000170d4208, 0x1bde000003fe00c1,
+	0x1bde0000003d4081, 0x1bde000013e54208, 0x1bde000000100081, 0x1bde0000007500c1,
+	0x1bde0000048b00c1, 0x1bde0000021f00c1, 0x1bde000004de40c1, 0x1bde000000fb80c1,
+	0x1bde0000003d4581, 0x1bde000000cd44c1, 0x1bde00000f494241, 0x1bde0000139f4201,
+	0x1bde00000f4b8241, 0x1bde00001e574181, 0x1bde00000253c401, 0x1bde000000d204c1,
+	0x1bde000013a54201, 0x1bde0000192741c1, 0x1bde0000192901c1, 0x1bde00000c1fc281,
+	0x1bde00000f5fc241, 0x1bde00000f644241, 0x1bde0000006f82c1, 0x1bde00001e82c181,
+	0x1bde00000c454281, 0x1bde00001eab4181, 0x1bde0000195600c1, 0x1bde000023a4c141,
+	0x1bde000014194201, 0x1bde000005ecc341, 0x1bde000007818301, 0x1bde000019b501c1,
+	0x1bde000005f68341, 0x1bde000013a04101, 0x1bde00000c9a4281, 0x1bde00000c9cc281,
+	0x1bde00000c9f4281, 0x1bde000000d501c1, 0x1bde000019ed01c1, 0x1bde0000261c8101,
+	0x1bde000005398181, 0x1bde000026228101, 0x1bde000014814201, 0x1bde000010160241,
+	0x1bde0000045cc181, 0x1bde0000052b0201, 0x1bde00000620c341, 0x1bde0000062b8241,
+	0x1bde000006f20201, 0x1bde0000033d81c1, 0x1bde00000ce54281, 0x1bde000003d00181,
+	0x1bde0000263d8101, 0x1bde00000aa941c1, 0x1bde00000d05c281, 0x1bde000004f50381,
+	0x1bde000009574141, 0x1bde00000aa842c1, 0x1bde0000108b0241, 0x1bde0000003b0241,
+	0x1bde00000d4e41c1, 0x1bde00000d50c281, 0x1bde00001bf4c1c1, 0x1bde00000531c241,
+	0x1bde0000163f4201, 0x1bde00000b0b42c1, 0x1bde00001184c141, 0x1bde00000dd54281,
+	0x1bde000011a44241, 0x1bde00000e09c281, 0x1bde00000b6602c1, 0x1bde00000b68c2c1,
+	0x1bde0000006d0541, 0x1bde000021214141, 0x1bde0000033e0141, 0x1bde00000b794281,
+	0x1bde00000e5c4281, 0x1bde00001cfec1c1, 0x1bde0000033e40c1, 0x1bde000017714201,
+	0x1bde00000e664281, 0x1bde000001c0c181, 0x1bde00001253c241, 0x1bde000017af4201,
+	0x1bde000017d14101, 0x1bde0000129bc241, 0x1bde000017e34201, 0x1bde0000080cc1c1,
+	0x1bde0000220cc181, 0x1bde00001dc641c1, 0x1bde000000a881c1, 0x1bde00000a2e0141,
+	0x1bde000009558301, 0x1bde000018cd4141, 0x1bde00000013c0c1, 0x1bde0000075840c1,
+	0x1bde0000007500c1, 0x1bde0000021f00c1, 0x1bde000004de40c1, 0x1bde000000100081,
+	0x1bde00000013c0c1, 0x1bde0000021f00c1, 0x1bde000003fe00c1, 0x1bde000004de40c1,
+	0x1bde00000013c0c1, 0x1bde0000021f00c1, 0x1bde0000061240c1, 0x1bde000004de40c1,
+	0x1bde0000277380c8, 0x1bde000013e54208, 0x1bde00000013c0c1, 0x1bde0000075840c1,
+	0x1bde0000007500c1, 0x1bde0000048b00c1, 0x1bde0000021f00c1, 0x1bde000004de40c1,
+	0x1bde000013e54208, 0x1bde000000100081, 0x1bde00000013c0c1, 0x1bde000019dd41c1,
+	0x1bde000015e0c108, 0x1bde0000021f00c1, 0x1bde0000269f8108, 0x1bde000004de40c1,
+	0x1bde000007c78148, 0x1bde000000100081, 0x1bde000000954081, 0x1bde000009404081,
+	0x1bde000000264081, 0x1bde000000c98081, 0x1bde000007694081, 0x1bde00002386c150,
+	0x1bde00000c5e4288, 0x1bde00000c88c148, 0x1bde00000c88c288, 0x1bde000026218108,
+	0x1bde00000ff20250, 0x1bde000010088248, 0x1bde00000a5b42c8, 0x1bde00000ca3c108,
+	0x1bde00001a5981c8, 0x1bde000007ba8188, 0x1bde00000d174188, 0x1bde000011678248,
+	0x1bde000016694208, 0x1bde00001c7641c8, 0x1bde0000269c8108, 0x1bde00000e164290,
+	0x1bde000005538388, 0x1bde0000089b8308, 0x1bde000012128248, 0x1bde0004048340c8,
+	0x1bdf000412a28248, 0x1be00000090d8248, 0x1be000001d98c1c8, 0x1be0000013154248,
+	0x1be0000018a74188, 0x1be00000002540c8, 0x1be0000025d60148, 0x1be0000007cb40c1,
+	0x1be000040013c0c1, 0x1be100000a270101, 0x1be10000075840c1, 0x1be10000239040c1,
+	0x1be10000007500c1, 0x1be10000013d40c1, 0x1be10000048b00c1, 0x1be1000020a94181,
+	0x1be10000014dc181, 0x1be10000021f00c1, 0x1be1000004de40c1, 0x1be10000217cc181,
+	0x1be1000000aac0c1, 0x1be1000026288101, 0x1be100001b5901c1, 0x1be10000273c00c1,
+	0x1be1000017c88101, 0x1be1000002d900c1, 0x1be100000d4ec0c1, 0x1be10000007500c1,
+	0x1be1000019d2c1c8, 0x1be1000009c402c8, 0x1be1000025f28108, 0x1be10000270a80c8,
+	0x1be10000235b0148, 0x1be10004003d4081, 0x1be200002390c0c8, 0x1be2000400100081,
+	0x1be300041049c240, 0x1be40008104e4250, 0x1be6000006378348, 0x1be60000063ac348,
+	0x1be6000009404081, 0x1be6000011878108, 0x1be60000115c4248, 0x1be6000008718308,
+	0x1be6000000104081, 0x1be6000817234200, 0x1be8000000374108, 0x1be8000001fb00c1,
+	0x1be8000003a6c0c1, 0x1be8000c0013c0c1, 0x1beb00000b278101, 0x1beb0024075840c1,
+	0x1bf40014007500c1, 0x1bf90000003e0081, 0x1bf9000011878101, 0x1bf90000021f00c1,
+	0x1bf90000275040c1, 0x1bf90000000bc081, 0x1bf9000004de40c1, 0x1bf90000034e8081,
+	0x1bf90000275e80c1, 0x1bf9000000b70081, 0x1bf90000060e40c1, 0x1bf90000049fc0c1,
+	0x1bf900000294c081, 0x1bf900000013c0c1, 0x1bf9000001298088, 0x1bf90000001c80c8,
+	0x1bf90000000d0088, 0x1bf90000006a0088, 0x1bf9000001cb8088, 0x1bf9000005ba8088,
+	0x1bf90000006a4088, 0x1bf9000008b30088, 0x1bf900002390c0c1, 0x1bf900000013c0c1,
+	0x1bf90000075840c1, 0x1bf90000007500c1, 0x1bf9000011878101, 0x1bf90000013d40c1,
+	0x1bf90000048b00c1, 0x1bf900000e04c101, 0x1bf90000021f00c1, 0x1bf9000004de40c1,
+	0x1bf9000000e08081, 0x1bf9000003f480c1, 0x1bf9000013e54208, 0x1bf900000013c0c1,
+	0x1bf90000075840c1, 0x1bf90000007500c1, 0x1bf90000048b00c1, 0x1bf90000021f00c1,
+	0x1bf9000004de40c1, 0x1bf9000000a70088, 0x1bf900000f8cc0c8, 0x1bf9000000630088,
+	0x1bf900002390c0c1, 0x1bf9000000100081, 0x1bf900000013c0c1, 0x1bf90000075840c1,
+	0x1bf90000007500c1, 0x1bf9000011878101, 0x1bf90000021f00c1, 0x1bf9000004de40c1,
+	0x1bf9000007a74141, 0x1bf9000004e7c081, 0x1bf900000c184288, 0x1bf90000003d4081,
+	0x1bf9000013e54208, 0x1bf90004013d4480, 0x1bfa000415f54200, 0x1bfb000008718308,
+	0x1bfb000018714210, 0x1bfb00000f0b4288, 0x1bfb0000007500c1, 0x1bfb000012148041,
+	0x1bfb0000002a8041, 0x1bfb000000370041, 0x1bfb00000024c041, 0x1bfb000009120041,
+	0x1bfb000023c00041, 0x1bfb000019d24041, 0x1bfb000007794041, 0x1bfb000025f74041,
+	0x1bfb00001e4cc041, 0x1bfb0000000f0041, 0x1bfb00000025c041, 0x1bfb000009e7c148,
+	0x1bfb000013e54208, 0x1bfb000000084041, 0x1bfb0000000b4041, 0x1bfb0000000a4041,
+	0x1bfb0000000e8041, 0x1bfb00000009c041, 0x1bfb000000088041, 0x1bfb00000008c041,
+	0x1bfb000002b60041, 0x1bfb000000878041, 0x1bfb000000134041, 0x1bfb000000144041,
+	0x1bfb0000000a8041, 0x1bfb000000104041, 0x1bfb000000174041, 0x1bfb000000f20041,
+	0x1bfb000000090041, 0x1bfb0000000ac041, 0x1bfb0000000c0041, 0x1bfb000000094041,
+	0x1bfb0000001ec041, 0x1bfb000000254041, 0x1bfb0000012b8041, 0x1bfb000000ad8041,
+	0x1bfb000000390041, 0x1bfb00000013c0c1, 0x1bfb0000075840c1, 0x1bfb0000007500c1,
+	0x1bfb0000021f00c1, 0x1bfb000004de40c1, 0x1bfb000000100081, 0x1bfb00000013c0c1,
+	0x1bfb0000075840c1, 0x1bfb000000104081, 0x1bfb000004de40c1, 0x1bfb000009c982c8,
+	0x1bfb000019d2c1c8, 0x1bfb0000179f81c8, 0x1bfb000004d58188, 0x1bfb00000ce7c288,
+	0x1bfb000006490208, 0x1bfb000014b74208, 0x1bfb00001a6b01c8, 0x1bfb000026638108,
+	0x1bfb0000208fc188, 0x1bfb000024b18148, 0x1bfb000024d48148, 0x1bfb00001a2440c8,
+	0x1bfb000021784188, 0x1bfb000022654188, 0x1bfb00000ccac181, 0x1bfb000004f4c101,
+	0x1bfb000009da02c1, 0x1bfb000023754141, 0x1bfb0000195681c1, 0x1bfb000013e54208,
+	0x1bfb000000100081, 0x1bfb00000013c0c1, 0x1bfb0000000fc0c1, 0x1bfb0000147f4141,
+	0x1bfb0000075840c1, 0x1bfb000011878101, 0x1bfb00001c0d41c1, 0x1bfb000024adc141,
+	0x1bfb0000021f00c1, 0x1bfb000004de40c1, 0x1bfb000003ce00c1, 0x1bfb00000e63c281,
+	0x1bfb000010240141, 0x1bfb00001dbd81c1, 0x1bfb00000ee5c101, 0x1bfb00000013c0c1,
+	0x1bfb0000075840c1, 0x1bfb0000007500c1, 0x1bfb0000021f00c1, 0x1bfb000004de40c1,
+	0x1bfb000000100088, 0x1bfb00000013c0c1, 0x1bfb0000075840c1, 0x1bfb0000007500c1,
+	0x1bfb0000021f00c1, 0x1bfb000004de40c1, 0x1bfb0000139d4201, 0x1bfb000004f4c101,
+	0x1bfb000002d0c101, 0x1bfb00000289c101, 0x1bfb0000198241c1, 0x1bfb000019c681c1,
+	0x1bfb00000013c0c1, 0x1bfb00000a2702c1, 0x1bfb00000cb84281, 0x1bfb00001a03c1c1,
+	0x1bfb0000147d4201, 0x1bfb0000147f4201, 0x1bfb0000075840c1, 0x1bfb00001a2f81c1,
+	0x1bfb0000239040c1, 0x1bfb000015454201, 0x1bfb000010b38241, 0x1bfb000011878101,
+	0x1bfb0000013d40c1, 0x1bfb0000165f4201, 0x1bfb0000048b00c1, 0x1bfb00000df0c281,
+	0x1bfb000020a94181, 0x1bfb000000aa41c1, 0x1bfb0000021f00c1, 0x1bfb000020e0c181,
+	0x1bfb000016ff4201, 0x1bfb000004de40c1, 0x1bfb00002116c181, 0x1bfb000006d04341,
+	0x1bfb000017474201, 0x1bfb00000b7c02c1, 0x1bfb00002131c181, 0x1bfb00001d0401c1,
+	0x1bfb000025478141, 0x1bfb00000eccc281, 0x1bfb0000259b4141, 0x1bfb00000ede4281,
+	0x1bfb000004e7c081, 0x1bfb0000002540c1, 0x1bfb00000a578101, 0x1bfb00001e4cc181,
+	0x1bfb0000270cc0c1, 0x1bfb00000e57c0c1, 0x1bfb00000060c0c1, 0x1bfb000004f4c0c1,
+	0x1bfb00001b8b80c1, 0x1bfb00000087c081, 0x1bfb0000236c8141, 0x1bfb00000f740241,
+	0x1bfb000000e040c1, 0x1bfb0000270d80c1, 0x1bfb000002ca00c1, 0x1bfb0000009040c1,
+	0x1bfb00000025c041, 0x1bfb00001969c1c1, 0x1bfb000023894141, 0x1bfb0000271140c1,
+	0x1bfb00000cf5c0c1, 0x1bfb0000265a40c1, 0x1bfb00000289c101, 0x1bfb0000271380c1,
+	0x1bfb000013eb4201, 0x1bfb000023e240c1, 0x1bfb000005e64341, 0x1bfb000014134201,
+	0x1bfb00001eba4181, 0x1bfb0000271c80c1, 0x1bfb0000271d40c1, 0x1bfb0000271ec0c1,
+	0x1bfb000c0013c0c1, 0x1bfe000014434201, 0x1bfe00000a270101, 0x1bfe0000272040c1,
+	0x1bfe000019f400c1, 0x1bfe00001ed9c181, 0x1bfe0000144b4201, 0x1bfe000004c240c1,
+	0x1bfe0000038dc0c1, 0x1bfe0000071a80c1, 0x1bfe0000037b40c1, 0x1bfe00000ecd00c1,
+	0x1bfe0000000fc0c1, 0x1bfe0000075840c1, 0x1bfe0000037880c1, 0x1bfe00002727c0c1,
+	0x1bfe0000006e00c1, 0x1bfe00000016c0c1, 0x1bfe0000078ec0c1, 0x1bfe0000067b40c1,
+	0x1bfe0000062a80c1, 0x1bfe000023e70141, 0x1bfe000026388101, 0x1bfe00001a6241c1,
+	0x1bfe000010c24081, 0x1bfe0000272c40c1, 0x1bfe00001f264181, 0x1bfe0000272d00c1,
+	0x1bfe0000272e80c1, 0x1bfe0000273000c1, 0x1bfe0000273300c1, 0x1bfe000003ac00c1,
+	0x1bfe0000273480c1, 0x1bfe00001afe01c1, 0x1bfe006c007500c1, 0x1c19000008c000c1,
+	0x1c1900000fb700c1, 0x1c1900000042c0c1, 0x1c190000029140c1, 0x1c19000013ccc0c1,
+	0x1c190000244ec141, 0x1c190000273f00c1, 0x1c19000010e08241, 0x1c190000043380c1,
+	0x1c19000002b600c1, 0x1c19006c07e980c1, 0x1c3400000affc0c1, 0x1c34000000c940c1,
+	0x1c34000016314201, 0x1c3400002041c181, 0x1c34000020434181, 0x1c340000204ac181,
+	0x1c3400001c2781c1, 0x1c34000001da40c1, 0x1c34000000f800c1, 0x1c340000048b00c1,
+	0x1c3400002098c181, 0x1c340000012dc081, 0x1c34000000adc0c1, 0x1c34000024c6c141,
+	0x1c340000021f00c1, 0x1c3400001c87c1c1, 0x1c34000003fe00c2, 0x1c34000002cc80c1,
+	0x1c34000000d580c1, 0x1c34000003c480c1, 0x1c3400000a9f80c1, 0x1c34000004de40c1,
+	0x1c3400002107c181, 0x1c34000021124181, 0x1c340000275880c1, 0x1c3400001f49c0c1,
+	0x1c34000003f480c1, 0x1c3400000c71c0c1, 0x1c3400000eff40c1, 0x1c340000275a00c1,
+	0x1c340000275f40c1, 0x1c34000007c78141, 0x1c34000001fd00c1, 0x1c34000021424181,
+	0x1c340000029a40c1, 0x1c34000017734201, 0x1c34000008c580c1, 0x1c340000123b0241,
+	0x1c34000017754201, 0x1c34000008dd8201, 0x1c3400002548c141, 0x1c3400000e72c281,
+	0x1c3400000e754281, 0x1c3400000b9782c1, 0x1c34000017914201, 0x1c34000016fbc0c1,
+	0x1c340000276480c1, 0x1c340000276600c1, 0x1c3400002766c0c1, 0x1c34000017e94201,
+	0x1c3400002769c0c1, 0x1c34000026d68101, 0x1c34000001314081, 0x1c3400000752c0c1,
+	0x1c340000043000c1, 0x1c3400000028c0c1, 0x1c340000276e40c1, 0x1c340000276f00c1,
+	0x1c34000000aac0c1, 0x1c34000004e7c081, 0x1c34000007f600c1, 0x1c34000009ca80c1,
+	0x1c340000277680c1, 0x1c34000026f28101, 0x1c3400000a578101, 0x1c3400002781c0c1,
+	0x1c3400000013c0c1, 0x1c340000075840c1, 0x1c340000007500c1, 0x1c340000021f00c1,
+	0x1c34000004de40c1, 0x1c34000000254088, 0x1c3400000013c0c1, 0x1c340000075840c1,
+	0x1c340000007500c1, 0x1c340000021f00c1, 0x1c34000004de40c1, 0x1c3400000fe90248,
+	0x1c34000000100088, 0x1c34000000100081, 0x1c34000004de40c1, 0x1c3400040013c0c1,
+	0x1c350000007500c1, 0x1c3500001184c248, 0x1c350000048b00c1, 0x1c3500001c56c1c8,
+	0x1c35000000934081, 0x1c3500000013c0c1, 0x1c350000075840c1, 0x1c350000007500c1,
+	0x1c350000273780c8, 0x1c350000021f00c1, 0x1c35000004de40c1, 0x1c35000000634088,
+	0x1c35000000528081, 0x1c350000237b8150, 0x1c35000009e7c148, 0x1c35000022644081,
+	0x1c35000013e54208, 0x1c35000000100088, 0x1c35000004b94081, 0x1c35000003588081,
+	0x1c35000008718308, 0x1c350000034e0081, 0x1c35000002918081, 0x1c350000053ac081,
+	0x1c35000024d48148, 0x1c350000000a8081, 0x1c350000000bc081, 0x1c35000003de8081,
+	0x1c350000001d8081, 0x1c3500000043c081, 0x1c350000275dc081, 0x1c35000000cb0081,
+	0x1c35000003f30081, 0x1c35000426968100, 0x1c36000019d2c1c8, 0x1c360000272280c8,
+	0x1c36000006274348, 0x1c360000103c4248, 0x1c3600000a97c2c8, 0x1c36000016b14208,
+	0x1c36000012560248, 0x1c36000425770140, 0x1c370000221ec188, 0x1c370000007500c1,
+	0x1c37000013e54208, 0x1c3700000c184288, 0x1c37000025f28108, 0x1c370000270a80c8,
+	0x1c370000235b0148, 0x1c37000013e54208, 0x1c370000145b81c8, 0x1c3700041a5601d0,
+	0x1c3800081a65c100, 0x1c3a00001f594188, 0x1c3a0000115c4248, 0x1c3a0000053b0388,
+	0x1c3a000008718308, 0x1c3a00001d7081c8, 0x1c3a0000003d4081, 0x1c3a0000006f8101,
+	0x1c3a00000f938241, 0x1c3a000000100081, 0x1c3a00000013c0c1, 0x1c3a000000954081,
+	0x1c3a0000075840c1, 0x1c3a0000005e80c8, 0x1c3a000000750081, 0x1c3a000026468101,
+	0x1c3a0000013d40c1, 0x1c3a00000e02c081, 0x1c3a0000021f00c1, 0x1c3a0000053ac088,
+	0x1c3a000000104081, 0x1c3a000004de40c1, 0x1c3a0000212ec181, 0x1c3a00001e4c00c4,
+	0x1c3a000013e54208, 0x1c3a000000100081, 0x1c3a0000239040c1, 0x1c3a0000007500c1,
+	0x1c3a0000048b00c1, 0x1c3a0004195a01c0, 0x1c3b000009e24190, 0x1c3b00001eed4188,
+	0x1c3b000010118248, 0x1c3b00041a3301c0, 0x1c3c000415674200, 0x1c3d000008268108,
+	0x1c3d00080d4bc280, 0x1c3f000015734208, 0x1c3f00041bb241c8, 0x1c40000015f54208,
+	0x1c400000053b0208, 0x1c4000000b0882d0, 0x1c4000000e254290, 0x1c400008275340c8,
+	0x1c420000120e0248, 0x1c420004174f4208, 0x1c4300001cf0c1c8, 0x1c43000c1cf981c0,
+	0x1c460020276240c0, 0x1c4e00000e894290, 0x1c4e00001d7401d0, 0x1c4e00000bdc42c8,
+	0x1c4e000809228300, 0x1c50000025be4148, 0x1c5000000ef4c148, 0x1c50000013328248,
+	0x1c50000009e7c148, 0x1c50000019d2c1c8, 0x1c50000008268108, 0x1c50000000100081,
+	0x1c5000000013c0c1, 0x1c500000007500c1, 0x1c500000021f00c1, 0x1c500000003d4081,
+	0x1c50000001dc8081, 0x1c50000006944081, 0x1c5000000c6fc288, 0x1c5000040013c0c1,
+	0x1c510000275c0081, 0x1c510000075840c1, 0x1c51000007d08081, 0x1c51000003b4c081,
+	0x1c510000007500c1, 0x1c51000007074081, 0x1c51000027848081, 0x1c51000007440081,
+	0x1c51000001000081, 0x1c51000015040081, 0x1c51000000290081, 0x1c51000000088081,
+	0x1c510000068e8081, 0x1c51000008ed0081, 0x1c5100000ecb0081, 0x1c51000007f38308,
+	0x1c510000156d4081, 0x1c5100000bc7c081, 0x1c510000276dc081, 0x1c51000002df8081,
+	0x1c510000048b00c1, 0x1c51000000628081, 0x1c510000021f00c1, 0x1c510000001fc081,
+	0x1c5100000d7e0081, 0x1c51000004de40c1, 0x1c51000027860081, 0x1c51000408b38300,
+	0x1c52000000fb8081, 0x1c52000001268081, 0x1c520000012f8081, 0x1c52000000fc8081,
+	0x1c52000024b60081, 0x1c52000003000081, 0x1c520000021f8081, 0x1c52000027868081,
+	0x1c52000027870081, 0x1c52000000c88081, 0x1c5200001fc20081, 0x1c52000022ccc181,
+	0x1c520000232b4181, 0x1c52000023374181, 0x1c5200000326c101, 0x1c52000023a60148,
+	0x1c5200040013c0c1, 0x1c530000272100c8, 0x1c530000075840c1, 0x1c5300040297c408,
+	0x1c54000014ab4101, 0x1c540000007500c1, 0x1c54000011878101, 0x1c540000013d40c1,
+	0x1c540000114ec248, 0x1c540000014f0188, 0x1c540000048b00c1, 0x1c54000026948108,
+	0x1c540000274bc0c8, 0x1c540000021f00c1, 0x1c54000003fe00c1, 0x1c54000004de40c1,
+	0x1c54000026a98110, 0x1c54000001fd00c1, 0x1c540004029a4108, 0x1c55000018034208,
+	0x1c550000002540c1, 0x1c550000095540d0, 0x1c5500001e4b4188, 0x1c55000025f18110,
+	0x1c5500002359c148, 0x1c5500000c1ac288, 0x1c55000026960088, 0x1c55000405b8c348,
+	0x1c5600000ccac188, 0x1c56000005bc0288, 0x1c56000005bc0348, 0x1c5600000c224288,
+	0x1c56000001b68448, 0x1c5600c80f5d8240, 0x1c8800000c314288, 0x1c88000013b14208,
+	0x1c88000013b34208, 0x1c880000048f8388, 0x1c88000000e044c8, 0x1c880004193a81c8,
+	0x1c890000004a4088, 0x1c890000025fc408, 0x1c89000007608308, 0x1c890000008c4508,
+	0x1c890000195841c8, 0x1c890000049d8388, 0x1c89000009e7c2c8, 0x1c89000009ea82c8,
+	0x1c8900000c4f4288, 0x1c890000197ec1c8, 0x1c89000013e54208, 0x1c89000013e74208,
+	0x1c890000198081c8, 0x1c8900080a0082c8, 0x1c8b00001ea9c188, 0x1c8b0000198941c8,
+	0x1c8b000000660088, 0x1c8b000005dc8348, 0x1c8b0000239fc148, 0x1c8b00000c6fc288,
+	0x1c8b000019ae01c8, 0x1c8b000019b181c8, 0x1c8b00000c864288, 0x1c8b0000078d8308,
+	0x1c8b00000364c3c8, 0x1c8b0000036883c8, 0x1c8b0000036c43c8, 0x1c8b000004bd0088,
+	0x1c8b000000100088, 0x1c8b000c0d04c100, 0x1c8e0000143d4208, 0x1c8e000c07938310,
+	0x1c9100000ca94288, 0x1c9100080cae4280, 0x1c9300000cb0c288, 0x1c9300000cb34288,
+	0x1c93000014554208, 0x1c93000023bf0148, 0x1c93000014574208, 0x1c93000014594208,
+	0x1c930000145b4208, 0x1c930000145d4208, 0x1c930000000b4088, 0x1c930000037b43c8,
+	0x1c9300000606c350, 0x1c93000001224490, 0x1c9300000a3d02c8, 0x1c9300000cbac288,
+	0x1c9300000a3fc2c8, 0x1c930000146b4208, 0x1c9300001a0741c8, 0x1c9300000cbd4288,
+	0x1c9300000a4282c8, 0x1c9300000ffb0248, 0x1c9300001a1001c8, 0x1c9300001ef4c188,
+	0x1c93000014754208, 0x1c930000079f8308, 0x1c9300001a1701c8, 0x1c9300000cc4c288,
+	0x1c93000014794208, 0x1c93000004d58388, 0x1c93000004d90388, 0x1c9300000a4802c8,
+	0x1c9300000a4ac2c8, 0x1c9300000a4d82c8, 0x1c93000010040248, 0x1c9300000a5042c8,
+	0x1c93000006108348, 0x1c9300000a5302c8, 0x1c9300000613c348, 0x1c93000006170348,
+	0x1c9300000cc74288, 0x1c9300000a55c2c8, 0x1c9300000a5882c8, 0x1c93000023d08148,
+	0x1c930044027fc408, 0x1ca4000010184248, 0x1ca40000038e03c8, 0x1ca400000391c3c8,
+	0x1ca4000006240348, 0x1ca400000287c408, 0x1ca40000006a0088, 0x1ca4002014994200,
+	0x1cac0000149b4208, 0x1cac00000a6902c8, 0x1cac0000028bc408, 0x1cac000004e70388,
+	0x1cac000423e20140, 0x1cad00000a7f02c8, 0x1cad00000293c408, 0x1cad000023ec0148,
+	0x1cad00000a8742c8, 0x1cad000007ba8308, 0x1cad00000cecc288, 0x1cad000010334248,
+	0x1cad000014bd4208, 0x1cad00001a7741c8, 0x1cad00001a7901c8, 0x1cad00001a7ac1c8,
+	0x1cad00001a7e41c8, 0x1cad00001a81c1c8, 0x1cad00001a8381c8, 0x1cad00001a8541c8,
+	0x1cad00001a8701c8, 0x1cad00001a88c1c8, 0x1cad00001a8a81c8, 0x1cad00001a8c41c8,
+	0x1cad00001a8e01c8, 0x1cad00001a8fc1c8, 0x1cad00001a9181c8, 0x1cad00001a9341c8,
+	0x1cad00001a9501c8, 0x1cad00001a9881c8, 0x1cad00001a9a41c8, 0x1cad00001a9dc1c8,
+	0x1cad00001a9f81c8, 0x1cad00001aa141c8, 0x1cad00001aa301c8, 0x1cad00001aa4c1c8,
+	0x1cad00001aa681c8, 0x1cad00001aa841c8, 0x1cad00001aaa01c8, 0x1cad00001aabc1c8,
+	0x1cad00001aad81c8, 0x1cad00001aaf41c8, 0x1cad00001ab101c8, 0x1cad00001ab481c8,
+	0x1cad00001ab641c8, 0x1cad00001ab801c8, 0x1cad00001ab9c1c8, 0x1cad00001abb81c8,
+	0x1cad00001abd41c8, 0x1cad00001abf01c8, 0x1cad00001ac0c1c8, 0x1cad00001ac281c8,
+	0x1cad00001ac441c8, 0x1cad00001ac601c8, 0x1cad00001ac7c1c8, 0x1cad00001ac981c8,
+	0x1cad00001acb41c8, 0x1cad00001acd01c8, 0x1cad00001acec1c8, 0x1cad00001ad081c8,
+	0x1cad00000cf94288, 0x1cad000014e34208, 0x1cad000007c08308, 0x1cad00001ae901c8,
+	0x1cad000024028148, 0x1cad000001cbc448, 0x1cad00001f4ec188, 0x1cad00000d00c288,
+	0x1cad00000d034288, 0x1cad00001f594188, 0x1cad00000a9d42c8, 0x1cad000001f04088,
+	0x1cad000001d44448, 0x1cad000010790248, 0x1cad0000107b4248, 0x1cad00001f87c188,
+	0x1cad0000068e8088, 0x1cad00000d264288, 0x1cad00001091c248, 0x1cad000003c643c8,
+	0x1cad000005068388, 0x1cad0000152d4208, 0x1cad000401344480, 0x1cae000015394208,
+	0x1cae00081b3d01c0, 0x1cb0000003cdc3c8, 0x1cb0000001cc8088, 0x1cb0000010aa8248,
+	0x1cb0000810acc240, 0x1cb2000007ea8248, 0x1cb2000007ea8308, 0x1cb2000005110388,
+	0x1cb2000007fc8308, 0x1cb2000003d183c8, 0x1cb2000005180388, 0x1cb2000007ff8308,
+	0x1cb2000010ba4248, 0x1cb2000001e98448, 0x1cb20000154d4208, 0x1cb200000141c488,
+	0x1cb200000661c348, 0x1cb2000006650348, 0x1cb200000ac102c8, 0x1cb20000005d4548,
+	0x1cb2000010bc8248, 0x1cb200000d3f4288, 0x1cb2000010bec248, 0x1cb2000002bbc408,
+	0x1cb200000ac3c2c8, 0x1cb2000003d903c8, 0x1cb200000ac942c8, 0x1cb2000008028308,
+	0x1cb2000002bfc408, 0x1cb200000d41c288, 0x1cb2000006684348, 0x1cb20000051f0388,
+	0x1cb200000d444288, 0x1cb2000008058308, 0x1cb2000000a54508, 0x1cb2000001edc448,
+	0x1cb200000acc02c8, 0x1cb2000003e083c8, 0x1cb20000066b8348, 0x1cb2000005228388,
+	0x1cb20000080b8308, 0x1cb20000080e8308, 0x1cb200000ad182c8, 0x1cb2000005260388,
+	0x1cb2000002c3c408, 0x1cb200000ad442c8, 0x1cb20000066ec348, 0x1cb2000003e443c8,
+	0x1cb2000008118308, 0x1cb2000005298388, 0x1cb2000001f20448, 0x1cb2000008148308,
+	0x1cb200001b6381c8, 0x1cb2000006720348, 0x1cb2000008178308, 0x1cb2000002c7c408,
+	0x1cb2000006754348, 0x1cb200001b6541c8, 0x1cb2000002cbc408, 0x1cb2000015534208,
+	0x1cb200000ad702c8, 0x1cb2000003e803c8, 0x1cb2000015554208, 0x1cb20000067bc348,
+	0x1cb2000002cfc408, 0x1cb2000000264088, 0x1cb2000010d54248, 0x1cb2000415674200,
+	0x1cb300041fc3c180, 0x1cb40000274380c8, 0x1cb4000010fb8248, 0x1cb40000110d8248,
+	0x1cb40000246e0148, 0x1cb4000008418088, 0x1cb4000015ef4208, 0x1cb400001157c248,
+	0x1cb400000da0c288, 0x1cb4000801f64180, 0x1cb6000006928350, 0x1cb6000401f64440,
+	0x1cb7000426818100, 0x1cb80000202fc188, 0x1cb800000daac288, 0x1cb800000695c348,
+	0x1cb800040b10c2c0, 0x1cb9000016594208, 0x1cb900000b1382c8, 0x1cb90004118dc248,
+	0x1cba000011cd80c8, 0x1cba00000de1c288, 0x1cba000001fa8448, 0x1cba000011bac248,
+	0x1cba00001c5881c8, 0x1cba000016a74208, 0x1cba00001c5a41c8, 0x1cba000016ab4208,
+	0x1cba000001b802c8, 0x1cba000002d7c408, 0x1cba0000086e8308, 0x1cba000011c18248,
+	0x1cba000008718308, 0x1cba00001c5f81c8, 0x1cba002c06afc340, 0x1cc5000016b34208,
+	0x1cc5000024b7c148, 0x1cc5000016db4208, 0x1cc500000e0c4288, 0x1cc500001c7d41c8,
+	0x1cc5000000280088, 0x1cc500040b47c2c0, 0x1cc600040067c540, 0x1cc700000e22c288,
+	0x1cc7000011f30248, 0x1cc70000171b4208, 0x1cc7000011f54248, 0x1cc7000024f14148,
+	0x1cc700000e2cc288, 0x1cc700001cc181c8, 0x1cc70000041503c8, 0x1cc700000b6082c8,
+	0x1cc70000089e8308, 0x1cc70000014f4488, 0x1cc70000210f4188, 0x1cc70000172f4210,
+	0x1cc70000250f4148, 0x1cc70000173d4208, 0x1cc700000b73c2c8, 0x1cc7000002030448,
+	0x1cc700001cdd81c8, 0x1cc700000e40c288, 0x1cc7000008a78308, 0x1cc7000425194140,
+	0x1cc8000008b08308, 0x1cc80004055a8388, 0x1cc90000275d00c8, 0x1cc9000021364188,
+	0x1cc90000275dc088, 0x1cc900000b8182c8, 0x1cc900000e524288, 0x1cc9000008b68310,
+	0x1cc90000175b4208, 0x1cc90000176b4208, 0x1cc90004171bc180, 0x1cca00001d0081c8,
+	0x1cca000005618388, 0x1cca00001d0781c8, 0x1cca000000090088, 0x1cca000000b70088,
+	0x1cca000002fbc408, 0x1cca00001d36c1c8, 0x1cca0000042b83c8, 0x1cca000021784188,
+	0x1cca0000056f8388, 0x1cca00000ba542c8, 0x1cca000017a54208, 0x1cca0000125a8248,
+	0x1cca0000015cc488, 0x1cca000006f0c348, 0x1cca000017a74208, 0x1cca0000125f0248,
+	0x1cca000006f40348, 0x1cca000012614248, 0x1cca00000ba802c8, 0x1cca000017a94208,
+	0x1cca000017ab4208, 0x1cca000017ad4208, 0x1cca000012638248, 0x1cca00000e8bc288,
+	0x1cca000008f28308, 0x1cca00000e9d4288, 0x1cca00001d59c1c8, 0x1cca00000ea24288,
+	0x1cca00000ea4c288, 0x1cca00000eff81c8, 0x1cca00000ea74288, 0x1cca000017df4208,
+	0x1cca0000070ac348, 0x1cca0000021c8448, 0x1cca000421a6c180, 0x1ccb0000090d8308,
+	0x1ccb00000bc0c2c8, 0x1ccb00040ec7c280, 0x1ccc000009198308, 0x1ccc0000184d4208,
+	0x1ccc000012ecc248, 0x1ccc000007148348, 0x1ccc00000303c408, 0x1ccc000009258308,
+	0x1ccc0000071e4348, 0x1ccc0000044983c8, 0x1ccc0004187f4200, 0x1ccd00000536c088,
+	0x1ccd000007218348, 0x1ccd0000006a4088, 0x1ccd000002a68088, 0x1ccd00000efec288,
+	0x1ccd0000094c8310, 0x1ccd000c0f064280, 0x1cd000000f08c288, 0x1cd0000418c54200,
+	0x1cd100000f104288, 0x1cd100000c0582c8, 0x1cd100001e2301c8, 0x1cd1000009528308,
+	0x1cd1000009588308, 0x1cd100000f154288, 0x1cd10004045c43c8, 0x1cd20000095b8308,
+	0x1cd200001e2bc1c8, 0x1cd20000058b8388, 0x1cd2000825d9c148, 0x1cd4000018dd4208,
+	0x1cd4000000634088, 0x1cd400001c5c01c8, 0x1cd40000041240c8, 0x1cd400001cf0c1c8,
+	0x1cd40000000b4088, 0x1cd4000010118248, 0x1cd40000003d4081, 0x1cd4000000100081,
+	0x1cd4000000954081, 0x1cd40000005e8081, 0x1cd4000000750081, 0x1cd4000000104081,
+	0x1cd4000000b70081, 0x1cd400000013c0c1, 0x1cd40000075840c1, 0x1cd40000007500c1,
+	0x1cd40000029140c1, 0x1cd40000021f00c1, 0x1cd4000004de40c1, 0x1cd4000013e54208,
+	0x1cd400000013c0c1, 0x1cd40000075840c1, 0x1cd40000013d40c1, 0x1cd4000004ad4101,
+	0x1cd4000004de40c1, 0x1cd400000013c0c1, 0x1cd40000075840c1, 0x1cd40000021f00c1,
+	0x1cd4000004de40c1, 0x1cd40000058500c8, 0x1cd40000007500c1, 0x1cd4000011878108,
+	0x1cd40000003d4081, 0x1cd400002390c0c1, 0x1cd400080013c0c1, 0x1cd600001a2a41c1,
+	0x1cd60000007500c1, 0x1cd600001d2080c1, 0x1cd60000048b00c1, 0x1cd60000021f00c1,
+	0x1cd6000004de40c1, 0x1cd60000033e0141, 0x1cd6000003f480c1, 0x1cd6000001fa4081,
+	0x1cd6000013e54208, 0x1cd6000000100088, 0x1cd6000027838088, 0x1cd600080b1642c0,
+	0x1cd8000400144100, 0x1cd9000016884148, 0x1cd9000009c402c8, 0x1cd9000025f28108,
+	0x1cd90000270a80c8, 0x1cd90000235b0148, 0x1cd9000009e7c148, 0x1cd9000013e54208,
+	0x1cd90000198941c8, 0x1cd900000013c0c8, 0x1cd9000006004348, 0x1cd9000419e981c0,
+	0x1cda00001ee44188, 0x1cda000823c04148, 0x1cdc00000126c488, 0x1cdc00001a0901c8,
+	0x1cdc00000cbfc288, 0x1cdc000014734208, 0x1cdc00001a1381c8, 0x1cdc00000cc24288,
+	0x1cdc000014774208, 0x1cdc00001a1a81c8, 0x1cdc0000037f03c8, 0x1cdc00001a1c41c8,
+	0x1cdc00001f00c188, 0x1cdc00000293c408, 0x1cdc00001f2c4190, 0x1cdc000003a483c8,
+	0x1cdc0000105bc248, 0x1cdc000020304108, 0x1cdc000001d00448, 0x1cdc000003afc3c8,
+	0x1cdc000405030388, 0x1cdd000407e18300, 0x1cde000010af0248, 0x1cde00001fad4188,
+	0x1cde000010b14248, 0x1cde00001faec188, 0x1cde00001fb04188, 0x1cde000007f98308,
+	0x1cde00000adc82c8, 0x1cde00000d494288, 0x1cde00001b7341c8, 0x1cde00000d73c288,
+	0x1cde000011438248, 0x1cde000008508308, 0x1cde00000d9bc288, 0x1cde00000d9e4288,
+	0x1cde0000115c4248, 0x1cde0000202fc188, 0x1cde00000dd04288, 0x1cde00000dd2c288,
+	0x1cde00000df34288, 0x1cde000011b88248, 0x1cde000016a54208, 0x1cde000020aac188,
+	0x1cde000006ac8348, 0x1cde000008718308, 0x1cde000005650388, 0x1cde00000ba282c8,
+	0x1cde000025784148, 0x1cde000409018300, 0x1cdf00001d7081c8, 0x1cdf000012cd4248,
+	0x1cdf00001d8901c8, 0x1cdf000000c84508, 0x1cdf00000bc902c8, 0x1cdf00000eb8c288,
+	0x1cdf00000ed1c288, 0x1cdf00000717c348, 0x1cdf00001319c250, 0x1cdf000009468308,
+	0x1cdf00000bf502c8, 0x1cdf000017a6c0c8, 0x1cdf000013c14208, 0x1cdf0000261d8108,
+	0x1cdf000007998108, 0x1cdf000007998308, 0x1cdf000026218108, 0x1cdf000007ab80c8,
+	0x1cdf0000029601d0, 0x1cdf000006414348, 0x1cdf00000adc8148, 0x1cdf0000094b00d0,
+	0x1cdf000016254210, 0x1cdf00041160c240, 0x1ce0000011678248, 0x1ce000001184c248,
+	0x1ce00000014f4148, 0x1ce000000b73c2c8, 0x1ce0000026050088, 0x1ce000000baac2c8,
+	0x1ce000000bbb40d0, 0x1ce0000017f94210, 0x1ce0000018a74188, 0x1ce000001e1341d0,
+	0x1ce000001e2a01c8, 0x1ce000040fe48248, 0x1ce1000005b58348, 0x1ce100002390c0c8,
+	0x1ce1000013e54208, 0x1ce1000000100088, 0x1ce1000014ab4108, 0x1ce1000008718308,
+	0x1ce100000418c0c8, 0x1ce1000007a74148, 0x1ce100000013c0c1, 0x1ce10000075840c1,
+	0x1ce10000007500c1, 0x1ce10000021f00c1, 0x1ce1000004de40c1, 0x1ce1000002ca00c1,
+	0x1ce100000013c0c1, 0x1ce10000075840c1, 0x1ce10000239040c1, 0x1ce10000007500c1,
+	0x1ce10000048b00c1, 0x1ce10000021f00c1, 0x1ce1000004de40c1, 0x1ce10000276540c1,
+	0x1ce10000002540c1, 0x1ce1000002ca00c1, 0x1ce10000006f8101, 0x1ce100000013c0c1,
+	0x1ce10000075840c1, 0x1ce10000007500c1, 0x1ce10000021f00c1, 0x1ce1000004de40c1,
+	0x1ce10000074d00c1, 0x1ce10000007000c1, 0x1ce1000001fa4081, 0x1ce100040fe58150,
+	0x1ce200000a800108, 0x1ce200000013c0c1, 0x1ce20000075840c1, 0x1ce20000005e80c1,
+	0x1ce20000239040c1, 0x1ce20000007500c1, 0x1ce2000011878101, 0x1ce20000274440c1,
+	0x1ce2000000f800c1, 0x1ce20000048b00c1, 0x1ce20000021f00c1, 0x1ce20000170d4208,
+	0x1ce2000004de40c1, 0x1ce2000003f480c1, 0x1ce2000402d940c0, 0x1ce3000000100088,
+	0x1ce300001b6180c1, 0x1ce300040013c0c1, 0x1ce40000075840c1, 0x1ce4000002cec0c1,
+	0x1ce40000007500c1, 0x1ce4000002c100c1, 0x1ce4000000f800c1, 0x1ce4000004de40c1,
+	0x1ce40000003740c1, 0x1ce4000026b78101, 0x1ce400040013c0c1, 0x1ce50000075840c1,
+	0x1ce50000072140c1, 0x1ce50000007500c1, 0x1ce50000048b00c1, 0x1ce500000e04c101,
+	0x1ce50000021f00c1, 0x1ce5000004de40c1, 0x1ce500000153c0c1, 0x1ce5000013934208,
+	0x1ce500040013c0c1, 0x1ce60000075840c1, 0x1ce60000239040c1, 0x1ce6000008718308,
+	0x1ce6000003fe00c1, 0x1ce6000004de40c1, 0x1ce600000373c1d0, 0x1ce600002390c0c1,
+	0x1ce600000013c0c1, 0x1ce60000075840c1, 0x1ce60000007500c1, 0x1ce6000011878101,
+	0x1ce600000e04c101, 0x1ce60000021f00c1, 0x1ce6000004de40c1, 0x1ce6000005bc0288,
+	0x1ce6000009e7c148, 0x1ce6000019d2c1c8, 0x1ce6000000ca82c8, 0x1ce600041ef34180,
+	0x1ce7000026928108, 0x1ce7000025784148, 0x1ce7000018714210, 0x1ce7000007420348,
+	0x1ce7000425090140, 0x1ce8000000100088, 0x1ce800001ffe4188, 0x1ce800001ea980c8,
+	0x1ce80000257d4148, 0x1ce8000009c142c8, 0x1ce8000010998141, 0x1ce8000013e54208,
+	0x1ce8000405f9c340, 0x1ce900080cabc280, 0x1ceb0000026cc088, 0x1ceb000010a3c248,
+	0x1ceb0000083040c8, 0x1ceb000024578148, 0x1ceb000008718308, 0x1ceb000000100088,
+	0x1ceb0000003d4081, 0x1ceb00002390c0c1, 0x1ceb00000013c0c1, 0x1ceb0000007500c1,
+	0x1ceb000011878101, 0x1ceb0000048b00c1, 0x1ceb00000e04c101, 0x1ceb0000021f00c1,
+	0x1ceb000004de40c1, 0x1ceb000003f480c1, 0x1ceb00000013c0c1, 0x1ceb0000075840c1,
+	0x1ceb0000021f00c1, 0x1ceb000004de40c1, 0x1ceb000007c78148, 0x1ceb0000006a4110,
+	0x1ceb00000c15c288, 0x1ceb000013a14201, 0x1ceb0000006f8101, 0x1ceb000019568181,
+	0x1ceb00001e85c181, 0x1ceb000013e54208, 0x1ceb0000003d80c1, 0x1ceb00000fd04241,
+	0x1ceb000000084541, 0x1ceb0000000847c8, 0x1ceb00000013c0c1, 0x1ceb00001a0041c8,
+	0x1ceb00001a3141c8, 0x1ceb0000012b4481, 0x1ceb00001f18c188, 0x1ceb000023e34148,
+	0x1ceb00000cecc288, 0x1ceb000010334248, 0x1ceb000003ac03c1, 0x1ceb00001b0881c8,
+	0x1ceb000026468101, 0x1ceb000024140141, 0x1ceb000002b3c401, 0x1ceb00001c3581c1,
+	0x1ceb000008718308, 0x1ceb000003fe00c1, 0x1ceb000016fd4201, 0x1ceb000020fbc188,
+	0x1ceb00000b6b8281, 0x1ceb00000aeec101, 0x1ceb0000275940c1, 0x1ceb000001fa4081,
+	0x1ceb00000bf242c1, 0x1ceb0000277f80c8, 0x1ceb0000075840c1, 0x1ceb0000007500c1,
+	0x1ceb000026188108, 0x1ceb00000013c0c1, 0x1ceb0000075840c1, 0x1ceb0000007500c1,
+	0x1ceb0000048b00c1, 0x1ceb0000021f00c1, 0x1ceb000004de40c1, 0x1ceb0000275ac0c1,
+	0x1ceb000000100081, 0x1ceb00001e56c0c8, 0x1ceb000026668108, 0x1ceb0000021f00c1,
+	0x1ceb000004de40c1, 0x1ceb000401e84148, 0x1cec00000013c0c1, 0x1cec0000075840c1,
+	0x1cec0000007500c1, 0x1cec0000048b00c1, 0x1cec000004de40c1, 0x1cec00000013c0c1,
+	0x1cec0000075840c1, 0x1cec0000007500c1, 0x1cec00001d2080c1, 0x1cec00000a0fc0c1,
+	0x1cec000004de40c1, 0x1cec00002390c0c8, 0x1cec000000100081, 0x1cec00000013c0c1,
+	0x1cec0000075840c1, 0x1cec0000021f00c1, 0x1cec000004de40c1, 0x1cec000021ee4088,
+	0x1cec0000003d4081, 0x1cec00000013c0c1, 0x1cec0000075840c1, 0x1cec0000007500c1,
+	0x1cec0000021f00c1, 0x1cec000004de40c1, 0x1cec000001b98148, 0x1cec00000edbc248,
+	0x1cec0000006a42d0, 0x1cec000000e040c8, 0x1cec0000006f8101, 0x1cec00000013c0c1,
+	0x1cec0000075840c1, 0x1cec000001518101, 0x1cec0000021f00c1, 0x1cec000004de40c1,
+	0x1cec000013e54208, 0x1cec00000013c0c1, 0x1cec0000075840c1, 0x1cec0000007500c1,
+	0x1cec0000021f00c1, 0x1cec000004de40c1, 0x1cec00000ea4c288, 0x1cec00000ff44248,
+	0x1cec00000289c108, 0x1cec00000013c0c1, 0x1cec0000000b4088, 0x1cec0000075840c1,
+	0x1cec0000239040c1, 0x1cec00000042c0c1, 0x1cec0000048b00c1, 0x1cec0000021f00c1,
+	0x1cec000004de40c1, 0x1cec000000908088, 0x1cec00000013c0c1, 0x1cec0000075840c1,
+	0x1cec0000007500c1, 0x1cec0000264b8101, 0x1cec000011878101, 0x1cec0000021f00c1,
+	0x1cec000004de40c1, 0x1cec0000002540c1, 0x1cec000001298088, 0x1cec000000100081,
+	0x1cec00000013c0c1, 0x1cec0000075840c1, 0x1cec0000007500c1, 0x1cec0000021f00c1,
+	0x1cec000004de40c1, 0x1cec0000273900c8, 0x1cec000013e54208, 0x1cec00000013c0c1,
+	0x1cec0000075840c1, 0x1cec0000007500c1, 0x1cec0000273b40c1, 0x1cec00000a1340c8,
+	0x1cec00001d2080c8, 0x1cec0000021f00c1, 0x1cec000004de40c1, 0x1cec000021724188,
+	0x1cec000022bac181, 0x1cec000022c84181, 0x1cec000022ccc181, 0x1cec00002305c181,
+	0x1cec000023074181, 0x1cec00002308c181, 0x1cec00002326c181, 0x1cec000023284181,
+	0x1cec00002329c181, 0x1cec0000232b4181, 0x1cec0000232cc181, 0x1cec0000232fc181,
+	0x1cec000009b58181, 0x1cec00002335c181, 0x1cec000023374181, 0x1cec0000003d8088,
+	0x1cec00000013c0c1, 0x1cec0000075840c1, 0x1cec0000239040c1, 0x1cec0000048b00c1,
+	0x1cec0000021f00c1, 0x1cec000004de40c1, 0x1cec00000a2182c8, 0x1cec000010088248,
+	0x1cec000004e701c8, 0x1cec000014bf4208, 0x1cec000006f54108, 0x1cec000008268108,
+	0x1cec000016774208, 0x1cec000020adc188, 0x1cec000001b94188, 0x1cec00001d9c41c8,
+	0x1cec00001e2d81c8, 0x1cec000011f78248, 0x1cec000013e54208, 0x1cec00000013c0c1,
+	0x1cec00000a4c8108, 0x1cec00001a774101, 0x1cec00000038c081, 0x1cec00000e04c101,
+	0x1cec000023600141, 0x1cec000002ca00c1, 0x1cec0000006f8101, 0x1cec00000013c0c1,
+	0x1cec00000a270101, 0x1cec0000075840c1, 0x1cec000014ab4101, 0x1cec000026468101,
+	0x1cec000011878101, 0x1cec000000f800c1, 0x1cec0000021f00c1, 0x1cec000004de40c1,
+	0x1cec000000a68141, 0x1cec0000074d00c1, 0x1cec000003f480c1, 0x1cec0000009200c1,
+	0x1cec000004e28101, 0x1cec000025f58101, 0x1cec000023628141, 0x1cec000013e54208,
+	0x1cec0000260e8101, 0x1cec00001eb74181, 0x1cec0000115d8101, 0x1cec000000100081,
+	0x1cec00001a3841c1, 0x1cec00001a3a01c1, 0x1cec000026358101, 0x1cec000011880141,
+	0x1cec000006774141, 0x1cec000003cdc141, 0x1cec000011878101, 0x1cec000015494201,
+	0x1cec00001fc0c181, 0x1cec000015e34201, 0x1cec000024780141, 0x1cec00001184c141,
+	0x1cec00000304c101, 0x1cec000004de40c1, 0x1cec000000374101, 0x1cec000021454181,
+	0x1cec00001f1580c1, 0x1cec000004e28101, 0x1cec00000edf4141, 0x1cec000026d18101,
+	0x1cec000026d48101, 0x1cec000001fa4081, 0x1cec0000220b4181, 0x1cec0000222f4181,
+	0x1cec000025cc0141, 0x1cec0000003d4081, 0x1cec00002390c0c1, 0x1cec000400100081,
+	0x1ced000024e14101, 0x1ced000026368108, 0x1ced000023f38148, 0x1ced000000750081,
+	0x1ced0000048b00c1, 0x1ced000401b80081, 0x1cee0000021f00c1, 0x1cee000000104081,
+	0x1cee00002128c181, 0x1cee000000fb80c1, 0x1cee0000002540c1, 0x1cee000013e54208,
+	0x1cee0000007500c1, 0x1cee000008718308, 0x1cee0000003d4081, 0x1cee001000100081,
+	0x1cf20000007500c1, 0x1cf20000148780c1, 0x1cf20000274440c1, 0x1cf2000000144101,
+	0x1cf20000021f00c1, 0x1cf2000004de40c1, 0x1cf20000003d4081, 0x1cf2000800100081,
+	0x1cf400000013c0c1, 0x1cf40000021f00c1, 0x1cf4000004de40c1, 0x1cf40000001f4088,
+	0x1cf40000004b4081, 0x1cf4000004e7c081, 0x1cf4000027828081, 0x1cf4000027830081,
+	0x1cf40000003d4081, 0x1cf40000005d0081, 0x1cf400000087c081, 0x1cf4000009e7c148,
+	0x1cf40000238e4141, 0x1cf400002390c0c1, 0x1cf4000013e54208, 0x1cf4000014014201,
+	0x1cf4000000154081, 0x1cf4000019d2c1c8, 0x1cf4000004bd0081, 0x1cf4000000100081,
+	0x1cf400000013c0c1, 0x1cf400000a270101, 0x1cf4000003d7c081, 0x1cf4000023c40141,
+	0x1cf400000048c081, 0x1cf40000075840c1, 0x1cf4000000228081, 0x1cf4000014ab4101,
+	0x1cf40000018fc0c1, 0x1cf40000007500c1, 0x1cf400001b1681c1, 0x1cf400000042c0c1,
+	0x1cf4000011878101, 0x1cf40000013d40c1, 0x1cf400000285c201, 0x1cf4000000114081,
+	0x1cf4000000200081, 0x1cf40000048b00c1, 0x1cf40000021f00c1, 0x1cf400000014c0c1,
+	0x1cf4000004de40c1, 0x1cf400001aea8081, 0x1cf4000000174101, 0x1cf4000003f480c1,
+	0x1cf40000033e40c1, 0x1cf4000018034208, 0x1cf4000001614181, 0x1cf4000004e7c081,
+	0x1cf400000536c081, 0x1cf4000009580081, 0x1cf40000006a4081, 0x1cf40000002540c8,
+	0x1cf4000000e504c8, 0x1cf4000000914508, 0x1cf4000009e7c148, 0x1cf4000019d2c1c8,
+	0x1cf400000cbfc248, 0x1cf400001efdc188, 0x1cf400000a4542c8, 0x1cf4000004d58188,
+	0x1cf400001a6941c8, 0x1cf400001f27c188, 0x1cf4000010628248, 0x1cf400000d084288,
+	0x1cf400000647c348, 0x1cf4000007e78308, 0x1cf400000d7b4288, 0x1cf4000016574208,
+	0x1cf4000024d48148, 0x1cf400000cc00208, 0x1cf4000021784188, 0x1cf4000022324188,
+	0x1cf4000022654188, 0x1cf40000006a0081, 0x1cf4000025f68108, 0x1cf400001e70c188,
+	0x1cf40000049a0390, 0x1cf400001e874188, 0x1cf4000007668308, 0x1cf4000809e242c0,
+	0x1cf6000009e7c148, 0x1cf6000009ed42c8, 0x1cf6000009f002c8, 0x1cf600040c4cc1c0,
+	0x1cf700040c4cc280, 0x1cf8000426088100, 0x1cf900000fb30248, 0x1cf9000013e94208,
+	0x1cf90000198781c8, 0x1cf9000001bf0448, 0x1cf900000c8b4288, 0x1cf900000013c0c1,
+	0x1cf9000414514200, 0x1cfa000023c2c148, 0x1cfa0000145f4208, 0x1cfa000026258108,
+	0x1cfa000023cf4148, 0x1cfa00001a2341c8, 0x1cfa00001f06c188, 0x1cfa00000a6bc2c8,
+	0x1cfa0004102a4240, 0x1cfb000015c40148, 0x1cfb000001cbc188, 0x1cfb00001f4a4188,
+	0x1cfb00000d174288, 0x1cfb000007de8308, 0x1cfb00002739c0c8, 0x1cfb000008268108,
+	0x1cfb0004267a8100, 0x1cfc000011678248, 0x1cfc00001169c1c8, 0x1cfc00000debc288,
+	0x1cfc000011a8c250, 0x1cfc000006698208, 0x1cfc000010624148, 0x1cfc0000274e00c8,
+	0x1cfc00041c8d01c0, 0x1cfd000017154210, 0x1cfd000020f8c190, 0x1cfd000020fa4190,
+	0x1cfd000008a18308, 0x1cfd000017554208, 0x1cfd0004251d0148, 0x1cfe0004275dc0c8,
+	0x1cff000026b48108, 0x1cff00000b89c2c8, 0x1cff00000b8f42c8, 0x1cff000408c28300,
+	0x1d000000276000d0, 0x1d000000178d4208, 0x1d0000000baac2c8, 0x1d000000126a4248,
+	0x1d0000002763c0c8, 0x1d00000017ef4208, 0x1d00000021ab4190, 0x1d0000001d98c1c8,
+	0x1d00001007148240, 0x1d0400001da881c8, 0x1d04000418914200, 0x1d05000026e78108,
+	0x1d0500001df201c8, 0x1d0500000ef4c288, 0x1d050004189f4200, 0x1d06000007384348,
+	0x1d060000133b8248, 0x1d06000018c14208, 0x1d0600000013c0c1, 0x1d060000075840c1,
+	0x1d060000007500c1, 0x1d060000048b00c1, 0x1d060000021f00c1, 0x1d06000004de40c1,
+	0x1d060000003d4081, 0x1d06000000100081, 0x1d060000007500c1, 0x1d060000003e0081,
+	0x1d060000021f00c1, 0x1d06000004de40c1, 0x1d06000000fb80c1, 0x1d0600000f30c281,
+	0x1d0600000f334281, 0x1d06000013e54208, 0x1d0600000013c0c1, 0x1d06000019f941c8,
+	0x1d060000075840c1, 0x1d060000007500c1, 0x1d060000013d40c1, 0x1d060000021f00c1,
+	0x1d06000004de40c1, 0x1d06000009be82c8, 0x1d06000025f48108, 0x1d06000025f78108,
+	0x1d06000025f88108, 0x1d0600000148c0c1, 0x1d060000191cc1c1, 0x1d060000008b0081,
+	0x1d0600000f4dc241, 0x1d06000000210081, 0x1d06000009cf02c1, 0x1d060000010d4281,
+	0x1d06000001710241, 0x1d060000000f0081, 0x1d0600001e67c181, 0x1d06000000484541,
+	0x1d060000004d8541, 0x1d06000000d6c4c1, 0x1d06000000db84c1, 0x1d06000000678081,
+	0x1d060000072a0141, 0x1d060000074e8301, 0x1d06000009d482c1, 0x1d06000004574141,
+	0x1d06000000274081, 0x1d0600000f788081, 0x1d0600001bec4181, 0x1d060000004a4081,
+	0x1d0600001e754181, 0x1d06000005c5c341, 0x1d060000075a8301, 0x1d06000002804101,
+	0x1d060000001cc081, 0x1d06000001458081, 0x1d06000013c34201, 0x1d060000004a0081,
+	0x1d0600000263c181, 0x1d0600000346c3c1, 0x1d0600000263c401, 0x1d0600000267c401,
+	0x1d0600001b548101, 0x1d0600000052c541, 0x1d06000000d844c1, 0x1d06000009ed40c1,
+	0x1d0600000c47c281, 0x1d060000196d41c1, 0x1d0600000faa0241, 0x1d0600001970c1c1,
+	0x1d0600001f4b8081, 0x1d06000001438081, 0x1d0600001e994181, 0x1d06000000698081,
+	0x1d06000013e54208, 0x1d0600000c594081, 0x1d060000011dc081, 0x1d060000198401c1,
+	0x1d06000001bac1c1, 0x1d06000001bac441, 0x1d060000034e4141, 0x1d06000004a80381,
+	0x1d060000034e43c1, 0x1d060000035203c1, 0x1d06000000660081, 0x1d060000198cc1c1,
+	0x1d06000013f54201, 0x1d0600000025c081, 0x1d0600000d9c4081, 0x1d060000026fc181,
+	0x1d060000035983c1, 0x1d060000026fc401, 0x1d0600000273c401, 0x1d06000027168081,
+	0x1d06000000154081, 0x1d060000140d4201, 0x1d06000001de80c1, 0x1d060000041a8201,
+	0x1d06000005e30341, 0x1d06000002da40c1, 0x1d06000014114201, 0x1d060000035d43c1,
+	0x1d06000004ab8381, 0x1d0600000c6d4281, 0x1d06000001c34441, 0x1d0600000277c401,
+	0x1d06000005e98341, 0x1d06000007758301, 0x1d06000019a701c1, 0x1d06000019aa81c1,
+	0x1d0600000fc74241, 0x1d06000002814081, 0x1d060000000f8081, 0x1d060000077b8301,
+	0x1d06000005f00341, 0x1d0600000a13c2c1, 0x1d060000077e8301, 0x1d06000000084081,
+	0x1d0600001ebd4181, 0x1d060000003dc081, 0x1d06000001440081, 0x1d06000004bd0081,
+	0x1d06000000100081, 0x1d060000261b8101, 0x1d06000019e7c1c1, 0x1d06000005754081,
+	0x1d06000019f241c1, 0x1d06000019f5c1c1, 0x1d06000003d7c081, 0x1d06000001fb4081,
+	0x1d06000023b3c141, 0x1d0600000c558081, 0x1d06000004cb0381, 0x1d06000006038341,
+	0x1d060000075840c1, 0x1d06000004e38381, 0x1d060000061a4341, 0x1d060000272700c1,
+	0x1d060000000a4081, 0x1d0600001cf34101, 0x1d060000150bc081, 0x1d06000001c14081,
+	0x1d06000023e84141, 0x1d0600001a49c1c1, 0x1d06000027350081, 0x1d060000005e8081,
+	0x1d0600001a57c1c1, 0x1d06000014b34201, 0x1d06000010c24081, 0x1d0600001f234181,
+	0x1d06000007b18301, 0x1d060000077d42c1, 0x1d06000006310341, 0x1d06000007b48301,
+	0x1d060000000e8081, 0x1d060000039943c1, 0x1d060000029bc401, 0x1d060000039d03c1,
+	0x1d06000000580541, 0x1d060000009b4501, 0x1d06000004ea8381, 0x1d06000004ee0381,
+	0x1d06000003a0c3c1, 0x1d06000004f18381, 0x1d06000000a04501, 0x1d06000000e9c4c1,
+	0x1d06000006344341, 0x1d060000103a0241, 0x1d060000273180c1, 0x1d060000006e8081,
+	0x1d06000024000141, 0x1d0600001f444181, 0x1d06000000750081, 0x1d0600001b0181c1,
+	0x1d060000007500c1, 0x1d06000001f04081, 0x1d06000014f14201, 0x1d06000024370148,
+	0x1d06000001e10441, 0x1d06000002b7c401, 0x1d0600000d354288, 0x1d06000001da0081,
+	0x1d0600001b5741c1, 0x1d060000000c4081, 0x1d0600001b6c41c1, 0x1d06000008418081,
+	0x1d0600001145c241, 0x1d0600001bec01c1, 0x1d06000016214201, 0x1d060000201f4181,
+	0x1d060000247a80c1, 0x1d060000247a8141, 0x1d06000000214081, 0x1d06000000134081,
+	0x1d060000247d0141, 0x1d060000247e4141, 0x1d06000000590081, 0x1d06000003d040c1,
+	0x1d0600001c0101c1, 0x1d0600001c0801c1, 0x1d060000008d4081, 0x1d060000162d4101,
+	0x1d0600000df040c1, 0x1d060000116c0241, 0x1d060000162f4201, 0x1d060000003a4081,
+	0x1d06000001400081, 0x1d0600001c10c1c1, 0x1d06000024938141, 0x1d060000163b4201,
+	0x1d0600001c2401c1, 0x1d06000001c240c1, 0x1d060000204dc181, 0x1d06000006990341,
+	0x1d060000085c8301, 0x1d06000020554181, 0x1d06000003588081, 0x1d06000005ba8081,
+	0x1d06000000200081, 0x1d06000003fac3c1, 0x1d060000053e8381, 0x1d0600001c3c81c1,
+	0x1d06000000468081, 0x1d06000020704141, 0x1d06000020704181, 0x1d06000005530081,
+	0x1d06000000628081, 0x1d0600002092c181, 0x1d0600000a9b00c1, 0x1d0600002095c181,
+	0x1d06000000628141, 0x1d06000006a60341, 0x1d06000000628541, 0x1d06000008688301,
+	0x1d06000006a94341, 0x1d06000001464481, 0x1d0600000099c081, 0x1d06000007190081,
+	0x1d06000008718308, 0x1d06000000480081, 0x1d06000020c2c181, 0x1d06000007eec181,
+	0x1d06000426988100, 0x1d07000000280081, 0x1d07000020e3c181, 0x1d07000003de8081,
+	0x1d07000024dac141, 0x1d07000000c98081, 0x1d07000004cc4241, 0x1d07000008988301,
+	0x1d0700000b5002c1, 0x1d07000000104081, 0x1d070000171f4201, 0x1d07000001ef8081,
+	0x1d070000006d0081, 0x1d070000210dc181, 0x1d07000025018141, 0x1d0700001ccf81c1,
+	0x1d07000025054141, 0x1d070000250a4141, 0x1d07000001b94081, 0x1d0700000a424081,
+	0x1d0700000043c081, 0x1d0700001cd141c1, 0x1d07000006cd0341, 0x1d07000008a48301,
+	0x1d0700001cd301c1, 0x1d0700001aea8081, 0x1d070000035e0081, 0x1d07000017334201,
+	0x1d07000017374201, 0x1d07000017394201, 0x1d07000026af8101, 0x1d0700001cdbc1c1,
+	0x1d070000275700c1, 0x1d07000014520081, 0x1d07000000174081, 0x1d070000121b8241,
+	0x1d0700001ced41c1, 0x1d07000000374081, 0x1d0700002516c141, 0x1d070000012e0081,
+	0x1d07000003748081, 0x1d070000213340c1, 0x1d07000021334181, 0x1d0700000f010081,
+	0x1d07000027818081, 0x1d070000000ec081, 0x1d070000213c4181, 0x1d0700001cf281c1,
+	0x1d070000006b0081, 0x1d0700000010c081, 0x1d0700000418c3c1, 0x1d07000006d38341,
+	0x1d070000055e0381, 0x1d07000008bc8301, 0x1d07000000098081, 0x1d07000000378081,
+	0x1d070000252ac141, 0x1d0700002149c181, 0x1d07000000624081, 0x1d07000000aa0081,
+	0x1d070000001f4081, 0x1d07000004264101, 0x1d0700000f4cc101, 0x1d0700002152c181,
+	0x1d07000000b70081, 0x1d0700001d1e41c1, 0x1d07000006cd80c1, 0x1d07000017934201,
+	0x1d07000017954201, 0x1d0700001d2c41c1, 0x1d0700002170c181, 0x1d07000000260081,
+	0x1d070000066a00c1, 0x1d0700001d5641c1, 0x1d070000218ec181, 0x1d07000025608141,
+	0x1d07000017d34201, 0x1d070000002e4081, 0x1d0700001d6d01c1, 0x1d07000000170081,
+	0x1d07000008b94081, 0x1d07000000470081, 0x1d070000017a0241, 0x1d070000060ec081,
+	0x1d070000180f4208, 0x1d070000017e8241, 0x1d0700000020c081, 0x1d07000000dfc0c1,
+	0x1d0700001d9381c1, 0x1d070000000cc081, 0x1d070000091c8301, 0x1d0700000bd402c1,
+	0x1d07000021e8c181, 0x1d0700002593c141, 0x1d070004048400c0, 0x1d080000040d4081,
+	0x1d08000000908081, 0x1d0800002200c181, 0x1d08000008fb00c1, 0x1d0800001db681c1,
+	0x1d080000006cc081, 0x1d080000004c4081, 0x1d08000000778541, 0x1d080000007cc541,
+	0x1d080000010184c1, 0x1d080000010644c1, 0x1d0800001dcb81c1, 0x1d08000002250441,
+	0x1d0800000307c401, 0x1d0800000165c481, 0x1d08000002294441, 0x1d080000016a4481,
+	0x1d080000022d8441, 0x1d080000010b0201, 0x1d080000030bc401, 0x1d0800000436c3c1,
+	0x1d080000010b04c1, 0x1d080000016ec481, 0x1d080000030fc401, 0x1d080000043a83c1,
+	0x1d08000001734481, 0x1d0800000231c441, 0x1d080000010fc4c1, 0x1d0800000177c481,
+	0x1d080000011484c1, 0x1d080000017c4481, 0x1d080000043e43c1, 0x1d080000057a0381,
+	0x1d0800000180c481, 0x1d08000002360441, 0x1d080000044203c1, 0x1d080000057d8381,
+	0x1d080000023a4441, 0x1d0800000313c401, 0x1d08000001854481, 0x1d080000023e8441,
+	0x1d0800000189c481, 0x1d0800000242c441, 0x1d0800000317c401, 0x1d0800000445c3c1,
+	0x1d08000002470441, 0x1d080000031bc401, 0x1d080000024b4441, 0x1d080000031fc401,
+	0x1d08000022114181, 0x1d0800001dcd41c1, 0x1d0800001dcf01c1, 0x1d08000000180081,
+	0x1d08000025ab8141, 0x1d0800001de5c1c1, 0x1d08000004e7c081, 0x1d0800000175c081,
+	0x1d08000025b1c141, 0x1d080000035840c1, 0x1d08000022264181, 0x1d0800000724c341,
+	0x1d080000092b8301, 0x1d08000000230081, 0x1d0800000be482c1, 0x1d0800000eed4281,
+	0x1d0800000eefc281, 0x1d08000013274241, 0x1d0800000be742c1, 0x1d08000007280341,
+	0x1d080000092e8301, 0x1d0800000ef24281, 0x1d08000009318301, 0x1d0800000bea02c1,
+	0x1d08000009348301, 0x1d08000005810381, 0x1d0800000becc2c1, 0x1d08000009378301,
+	0x1d080000072b4341, 0x1d0800000454c3c1, 0x1d080000093a8301, 0x1d080000072e8341,
+	0x1d080000277440c1, 0x1d0800002239c181, 0x1d08000013cf0081, 0x1d08000006074081,
+	0x1d080000064540c1, 0x1d08000000400081, 0x1d0800000059c0c1, 0x1d080000223cc181,
+	0x1d0800000059c1c1, 0x1d080000223e4181, 0x1d08000018a54201, 0x1d08000018a74201,
+	0x1d08000022414181, 0x1d080000001ec081, 0x1d08000007350341, 0x1d08000009438301,
+	0x1d0800001dfc81c1, 0x1d0800001e0381c1, 0x1d0800000a18c081, 0x1d08000003eec081,
+	0x1d080000060dc081, 0x1d08000007970081, 0x1d08000000100081, 0x1d080000021f00c1,
+	0x1d08000000934088, 0x1d08000004de40c1, 0x1d0800000013c0c1, 0x1d080000075840c1,
+	0x1d080000007500c1, 0x1d080000048b00c1, 0x1d0800000e04c101, 0x1d080000021f00c1,
+	0x1d08000004de40c1, 0x1d08000000fb80c1, 0x1d080000003d4081, 0x1d0800000060c081,
+	0x1d0800d0050d4141, 0x1d3c007006854141, 0x1d580000236dc148, 0x1d5800581e6f4181,
+	0x1d6e000013c54208, 0x1d6e000013c74208, 0x1d6e000013c94208, 0x1d6e0000196641c8,
+	0x1d6e00001ea24188, 0x1d6e000013e54208, 0x1d6e000023934148, 0x1d6e000003d2c0c8,
+	0x1d6e00000fb9c0c8, 0x1d6e00000fb9c248, 0x1d6e000010b200c8, 0x1d6e0000199901c8,
+	0x1d6e000014154208, 0x1d6e000023a24148, 0x1d6e000019ac41c8, 0x1d6e000023a88148,
+	0x1d6e00e80f230141, 0x1da80000141f4208, 0x1da8000014254208, 0x1da8000023a9c148,
+	0x1da8000019c4c1c8, 0x1da80000056540c8, 0x1da8000026168108, 0x1da8000000100081,
+	0x1da8000019d641c8, 0x1da8000014454208, 0x1da800001ed6c188, 0x1da80000144d4208,
+	0x1da800000f75c0c8, 0x1da8000005e90108, 0x1da800001c360108, 0x1da800001eeec188,
+	0x1da8000000954081, 0x1da800001f084188, 0x1da80058107f8141, 0x1dbe00001a4481c8,
+	0x1dbe000007a58308, 0x1dbe0000272940c8, 0x1dbe000023ed4148, 0x1dbe00001f204188,
+	0x1dbe0000263b8108, 0x1dbe00000cef4288, 0x1dbe003c23fb0141, 0x1dcd00fc1ad941c1,
+	0x1e0c00cc06834241, 0x1e3f009826418101, 0x1e6500000cfe4288, 0x1e65000024050148,
+	0x1e6500001f534188, 0x1e65000000750081, 0x1e650000240dc148, 0x1e65000001f04081,
+	0x1e6500001b0f81c8, 0x1e650090241b8141, 0x1e890000241cc148, 0x1e8900000aa582c8,
+	0x1e89000024244148, 0x1e890000012140c8, 0x1e8900000ae60108, 0x1e89000024294148,
+	0x1e89006402a18241, 0x1ea2023815234201, 0x1f30000026548108, 0x1f3000001f9cc188,
+	0x1f3000b824348141, 0x1f5e00cc1b4941c1, 0x1f9100001fa14188, 0x1f91004c11da8201,
+	0x1fa4000024474148, 0x1fa40088244c4141, 0x1fc6000010d9c248, 0x1fc6003c064cc181,
+	0x1fd5005010e50241, 0x1fe90078159d4201, 0x200700000d6ec288, 0x2007000415b74202,
+	0x2008000015bf4208, 0x20080000266b8108, 0x20080000266c8108, 0x200800040d78c282,
+	0x20090004266f8102, 0x200a007c24618141, 0x2029005c15f94201, 0x2040000024708148,
+	0x2040007c24744141, 0x205f0000034c8081, 0x205f00000da34288, 0x205f00001169c248,
+	0x205f00000da5c288, 0x205f00000df04108, 0x205f00001c0f01c8, 0x205f000016334208,
+	0x205f00000b0b4108, 0x205f007802ef00c1, 0x207d00802086c181, 0x209d006c167b4201,
+	0x20b80000268e8108, 0x20b8000014660108, 0x20b8000011a68248, 0x20b8000011a8c0c8,
+	0x20b8012c0680c181, 0x2103005816b94201, 0x2119000420bb4182, 0x211a00001c6841c8,
+	0x211a009811c84101, 0x21400008005a4081, 0x214200881c80c1c1, 0x2164000020d64188,
+	0x216400001c8b41c8, 0x216400001720c108, 0x2164004c2445c101, 0x217700681ca3c1c1,
+	0x219100a81ca901c1, 0x21bb000026a78108, 0x21bb000000104081, 0x21bb00c807d74141,
+	0x21ed000017294208, 0x21ed0000172b4208, 0x21ed000021184188, 0x21ed00002119c188,
+	0x21ed0000250b8148, 0x21ed00000bc4c188, 0x21ed0000250e0148, 0x21ed00001cd841c8,
+	0x21ed00002122c188, 0x21ed0000251f8148, 0x21ed000026b38108, 0x21ed000017594208,
+	0x21ed00000e4fc0c8, 0x21ed0000175f4208, 0x21ed0000122b4248, 0x21ed0000215ec188,
+	0x21ed00680e68c101, 0x220701141d1ac1c1, 0x224c00041d2a81c2, 0x224d000012518248,
+	0x224d00002173c188, 0x224d000406e8c182, 0x224e005c05474141, 0x2265005c1d4841c1,
+	0x227c009017cb4201, 0x22a0000025798148, 0x22a0000017fb4208, 0x22a000000dc980c8,
+	0x22a0000018014208, 0x22a0000012cb0248, 0x22a000001da6c1c8, 0x22a0000025978148,
+	0x22a0007c1dadc1c1, 0x22bf004412fc8241, 0x22d000e424750141, 0x23090000185f4208,
+	0x230900341dbbc1c1, 0x231600601507c181, 0x232e0000188d4148, 0x232e000025bbc148,
+	0x232e0000006a42c8, 0x232e0000223b4188, 0x232e00000ba48148, 0x232e000022444188,
+	0x232e0000224bc188, 0x232e007418b54201, 0x234b000022624188, 0x234b00001e1501c8,
+	0x234b000013400248, 0x234b008818cf4201, 0x236d004013490241, 0x237d0070134b4241,
+	0x2399000418d94202, 0x239a0000228dc188, 0x239a000022b7c181, 0x239a000022c0c181,
+	0x239a000022c3c181, 0x239a000022cfc181, 0x239a0000137f0241, 0x239a000022d14181,
+	0x239a000013814241, 0x239a000022dd4181, 0x239a000022dec181, 0x239a000022e1c181,
+	0x239a000022e34181, 0x239a000022e64181, 0x239a000022e7c181, 0x239a000022eac181,
+	0x239a000022ec4181, 0x239a000022edc181, 0x239a000022ef4181, 0x239a000022f0c181,
+	0x239a000022f24181, 0x239a000022f3c181, 0x239a000022f54181, 0x239a000022f84181,
+	0x239a000022fb4181, 0x239a000022fcc181, 0x239a000022fe4181, 0x239a0000230a4181,
+	0x239a000023104181, 0x239a00002311c181, 0x239a000023134181, 0x239a000023164181,
+	0x239a0000231c4181, 0x239a0000231dc181, 0x239a0000138c8241, 0x239a0000231f4181,
+	0x239a00002320c181, 0x239a000023224181, 0x239a00002323c181, 0x239a00002338c181,
+	0x239a0000233d4181, 0x239a000023434181, 0x239a00002344c181, 0x239a00002347c181,
+	0x239a000023494181, 0x239a0000234dc181, 0x239a00002350c181, 0x239a000023524181,
+	0x239a000013910241, 0x239a0000003d4081, 0x239a000400100081, 0x239b000000750081,
+	0x239b000011878101, 0x239b000000200081, 0x239b000001518101, 0x239b0000005a4081,
+	0x239b000000104081, 0x239b000000fb8081, 0x239b00000289c108, 0x239b00000013c0c1,
+	0x239b0000075840c1, 0x239b0000007500c1, 0x239b000000114088, 0x239b0000052fc088,
+	0x239b0000048b00c1, 0x239b0000021f00c1, 0x239b000004de40c1, 0x239b000004e7c088,
+	0x239b00000536c088, 0x239b0000006a4088, 0x239b00002390c0c1, 0x239b00000013c0c1,
+	0x239b0000075840c1, 0x239b0000007500c1, 0x239b000011878101, 0x239b0000021f00c1,
+	0x239b000004de40c1, 0x239b0000006d40c1, 0x239b0000006f8101, 0x239b00000013c0c1,
+	0x239b00000a270101, 0x239b0000075840c1, 0x239b000026468101, 0x239b0000007500c1,
+	0x239b00001c3581c1, 0x239b0000048b00c1, 0x239b000003fe00c1, 0x239b000016fd4201,
+	0x239b000004de40c1, 0x239b00000b6b82c1, 0x239b0000275940c1, 0x239b0000212ec181,
+	0x239b000001fa4081, 0x239b00000bf242c1, 0x239b0000075840c1, 0x239b0000007500c1,
+	0x239b0000021f00c1, 0x239b000004de40c1, 0x239b00000013c0c1, 0x239b0000075840c1,
+	0x239b0000007500c1, 0x239b000004de40c1, 0x239b0000029a40c1, 0x239b0000004c40c1,
+	0x239b0000003d4081, 0x239b000013e54208, 0x239b0000239ac141, 0x239b000014294201,
+	0x239b0000142b4201, 0x239b000000100081, 0x239b000023ba0141, 0x239b000019fcc1c1,
+	0x239b0000000d0081, 0x239b00001ae041c1, 0x239b000000750081, 0x239b00001b1841c1,
+	0x239b000010670241, 0x239b000014f74201, 0x239b000010694241, 0x239b000002ea8081,
+	0x239b00001b5c81c1, 0x239b0000265f8101, 0x239b00001b8301c1, 0x239b00001b84c1c1,
+	0x239b00000cfa0081, 0x239b0000048b00c1, 0x239b00000099c081, 0x239b0000005a4081,
+	0x239b000000104081, 0x239b00000043c081, 0x239b00000010c081, 0x239b000000fb8081,
+	0x239b000025590141, 0x239b000002700141, 0x239b000000100088, 0x239b0000075840c8,
+	0x239b00000013c0c1, 0x239b0000075840c1, 0x239b000003b3c0c1, 0x239b0000007500c1,
+	0x239b00000042c0c1, 0x239b0000021f00c1, 0x239b000004de40c1, 0x239b00000013c0c1,
+	0x239b0000075840c1, 0x239b0000021f00c1, 0x239b000004de40c1, 0x239b00000013c0c1,
+	0x239b0000075840c1, 0x239b0000007500c1, 0x239b0000052d0188, 0x239b00040aed02c0,
+	0x239c0000048b00c1, 0x239c0000021f00c1, 0x239c000004de40c1, 0x239c00002715c0c8,
+	0x239c000000084048, 0x239c00000013c0c1, 0x239c0000075840c1, 0x239c0000007500c1,
+	0x239c000011878101, 0x239c0000013d40c1, 0x239c0000021f00c1, 0x239c000004de40c1,
+	0x239c000000a680c1, 0x239c000803444188, 0x239e00000013c0c1, 0x239e0000075840c1,
+	0x239e0000007500c1, 0x239e0000021f00c1, 0x239e000004de40c1, 0x239e000000100081,
+	0x239e00000013c0c1, 0x239e0000075840c1, 0x239e0000007500c1, 0x239e0000021f00c1,
+	0x239e000004de40c1, 0x239e000002f40088, 0x239e000013e54208, 0x239e0000195600c8,
+	0x239e0000261e8108, 0x239e000018c64110, 0x239e000024b40148, 0x239e0000265280c8,
+	0x239e0000003d4081, 0x239e000026008101, 0x239e00000013c0c1, 0x239e0000075840c1,
+	0x239e0000007500c1, 0x239e00002736c0c1, 0x239e000003cdc141, 0x239e0000013d40c1,
+	0x239e00001d2080c1, 0x239e0000021f00c1, 0x239e00000a0940c1, 0x239e000004de40c1,
+	0x239e000000fb80c1, 0x239e0000007000c1, 0x239e0000002540c1, 0x239e00002751c0c8,
+	0x239e00000013c0c1, 0x239e0000075840c1, 0x239e0000007500c1, 0x239e0000021f00c1,
+	0x239e000004de40c1, 0x239e0000003d4081, 0x239e00002390c0c1, 0x239e000000100081,
+	0x239e0000000b4088, 0x239e0000075840c1, 0x239e0000007500c1, 0x239e000011878101,
+	0x239e0000021f00c1, 0x239e000004de40c1, 0x239e000000fb8081, 0x239e000013e54208,
+	0x239e0000007500c1, 0x239e00000c184288, 0x239e000013e54208, 0x239e000003a6c0c1,
+	0x239e00000013c0c1, 0x239e00000b278101, 0x239e0000075840c1, 0x239e0000007500c1,
+	0x239e0000003e0081, 0x239e0000048b00c1, 0x239e0000021f00c1, 0x239e000004de40c1,
+	0x239e00000013c0c1, 0x239e0000075840c1, 0x239e0000007500c1, 0x239e0000003e0081,
+	0x239e000000f800c1, 0x239e0000021f00c1, 0x239e000004de40c1, 0x239e0000275640c1,
+	0x239e000000fb80c1, 0x239e0000003d4081, 0x239e000000100081, 0x239e0000007500c1,
+	0x239e0000021f00c1, 0x239e000004de40c1, 0x239e0000033e0141, 0x239e000011b94188,
+	0x239e0000006f8101, 0x239e000001fa4081, 0x239e0000001cc088, 0x239e000013e54208,
+	0x239e0000000b4088, 0x239e0000052fc088, 0x239e000000908088, 0x239e0000003d4081,
+	0x239e000009e7c148, 0x239e000013f34208, 0x239e0000271800c8, 0x239e000000100081,
+	0x239e00041edfc188, 0x239f000014574108, 0x239f000000ca82c8, 0x239f00001ef1c188,
+	0x239f0000179f81c8, 0x239f0000100ac248, 0x239f0000075840c1, 0x239f00000a6e8208,
+	0x239f00000a6e82c8, 0x239f00000a7142c8, 0x239f00000a7402c8, 0x239f00000a76c2c8,
+	0x239f00000a7982c8, 0x239f00000a7c42c8, 0x239f00001f504188, 0x239f0000007500c1,
+	0x239f0000242f8148, 0x239f000026568108, 0x239f000007da00c1, 0x239f00001c0b81c8,
+	0x239f000020314188, 0x239f000024a00148, 0x239f000026878108, 0x239f000026938108,
+	0x239f0000021f00c1, 0x239f000020df4188, 0x239f0000269b8108, 0x239f000004de40c1,
+	0x239f000000374101, 0x239f00001cf0c1c8, 0x239f000012ab8248, 0x239f000000c84208,
+	0x239f000026d78108, 0x239f00041dc801c0, 0x23a00000277740c8, 0x23a0000022654188,
+	0x23a0000018c14208, 0x23a0000025e28148, 0x23a0000007ba8188, 0x23a0000009e7c148,
+	0x23a0000000100081, 0x23a000000013c0c1, 0x23a00000075840c1, 0x23a00000007500c1,
+	0x23a00000048b00c1, 0x23a0000003fe00c1, 0x23a0000004de40c1, 0x23a00000275940c1,
+	0x23a0000001fa4081, 0x23a0000013e54208, 0x23a000000013c0c1, 0x23a00000075840c1,
+	0x23a00000007500c1, 0x23a00000029140c1, 0x23a000000e04c101, 0x23a00000021f00c1,
+	0x23a0000004de40c1, 0x23a000000013c0c1, 0x23a00000075840c1, 0x23a0000026468101,
+	0x23a00000007500c1, 0x23a00000021f00c1, 0x23a0000004de40c1, 0x23a00000212ec181,
+	0x23a00000075840c1, 0x23a00000007500c1, 0x23a0000019e040c8, 0x23a0000004de40c1,
+	0x23a000000013c0c1, 0x23a00000075840c1, 0x23a00000007500c1, 0x23a00000021f00c1,
+	0x23a0000004de40c1, 0x23a0000009e7c148, 0x23a00000179f81c8, 0x23a0000002b60088,
+	0x23a0000013e54208, 0x23a00000007500c1, 0x23a000000013c0c1, 0x23a00000075840c1,
+	0x23a00000007500c1, 0x23a00000014880c8, 0x23a0000016734208, 0x23a00000021f00c1,
+	0x23a0000004de40c1, 0x23a000040013c0c1, 0x23a10000075840c1, 0x23a10000021f00c1,
+	0x23a1000004de40c1, 0x23a10000003d4081, 0x23a1000000100081, 0x23a100000013c0c1,
+	0x23a10000007500c1, 0x23a10000021f00c1, 0x23a1000000104081, 0x23a1000004de40c1,
+	0x23a10000191e81c1, 0x23a1000009cc42c1, 0x23a1000000d200c1, 0x23a1000013a94201,
+	0x23a10000193001c1, 0x23a100001e604181, 0x23a1000023678141, 0x23a100000f5fc241,
+	0x23a10000025bc201, 0x23a10000025bc241, 0x23a10000025bc401, 0x23a1000009d1c2c1,
+	0x23a100000f620241, 0x23a100000018c0c1, 0x23a100000f6d4241, 0x23a10000074b8181,
+	0x23a10000074b8301, 0x23a10000025dc201, 0x23a1000013b54201, 0x23a100000f7ac241,
+	0x23a1000004930381, 0x23a1000009d742c1, 0x23a1000007518301, 0x23a1000002ca00c1,
+	0x23a1000007548301, 0x23a100000f7f4241, 0x23a10000194181c1, 0x23a1000007578301,
+	0x23a100000c364281, 0x23a100000326c101, 0x23a1000005c28341, 0x23a1000013bb4201,
+	0x23a1000005c90341, 0x23a100001e7e4181, 0x23a10000006f82c1, 0x23a100000f884241,
+	0x23a10000194a41c1, 0x23a100001e814181, 0x23a100000f8f0241, 0x23a100000c3b4281,
+	0x23a1000005f7c201, 0x23a1000026018101, 0x23a10000196101c1, 0x23a100001962c1c1,
+	0x23a1000010108101, 0x23a10000049d8101, 0x23a100000f9c8241, 0x23a100000f9ec241,
+	0x23a1000013d54201, 0x23a1000023830141, 0x23a1000023844141, 0x23a100001e8d4181,
+	0x23a100000fa7c241, 0x23a1000005d2c341, 0x23a1000013d74201, 0x23a10000197281c1,
+	0x23a1000013d94201, 0x23a100000cc34181, 0x23a100000b984101, 0x23a10000238d0141,
+	0x23a100001e9f4181, 0x23a10000260a8101, 0x23a10000197b41c1, 0x23a100000c56c281,
+	0x23a1000026108101, 0x23a100001ea6c181, 0x23a10000034a8241, 0x23a10000034a83c1,
+	0x23a1000005d60341, 0x23a100001ea84181, 0x23a10000026bc401, 0x23a1000013f34181,
+	0x23a10000198e81c1, 0x23a100000355c1c1, 0x23a100000355c3c1, 0x23a100000fbe4241,
+	0x23a100001eacc181, 0x23a1000013fb41c1, 0x23a1000013fb4201, 0x23a100000fc08241,
+	0x23a1000013fd4201, 0x23a10000199741c1, 0x23a1000001cd00c1, 0x23a100001eafc181,
+	0x23a10000140b4201, 0x23a100000c6ac281, 0x23a100000fc50241, 0x23a10000025d00c1,
+	0x23a100001eb5c181, 0x23a100000c724281, 0x23a1000019a381c1, 0x23a100000c774281,
+	0x23a1000004af0381, 0x23a100001eb8c181, 0x23a1000019a8c1c1, 0x23a1000003d68181,
+	0x23a1000004c98181, 0x23a100000a1942c1, 0x23a100000c79c281, 0x23a1000005f34341,
+	0x23a1000019b6c1c1, 0x23a1000003610201, 0x23a1000003610241, 0x23a10000036103c1,
+	0x23a1000007848301, 0x23a100000fddc241, 0x23a1000004b60381, 0x23a100000c83c281,
+	0x23a100001ec7c181, 0x23a100001ecac181, 0x23a1000007878301, 0x23a10000078a8301,
+	0x23a1000014314201, 0x23a1000019cf41c1, 0x23a10000045b0141, 0x23a1000026198101,
+	0x23a1000004c08381, 0x23a10000261a8101, 0x23a1000019d801c1, 0x23a100000c954281,
+	0x23a1000000964501, 0x23a10000037003c1, 0x23a1000003578201, 0x23a10000143f4201,
+	0x23a10000001ac341, 0x23a10000001ac381, 0x23a1000006004241, 0x23a100000373c201,
+	0x23a100000373c3c1, 0x23a100000013c381, 0x23a1000003778301, 0x23a10000037783c1,
+	0x23a1000019e441c1, 0x23a100000ca1c281, 0x23a100000a29c2c1, 0x23a1000000100741,
+	0x23a1000014474201, 0x23a1000019eb41c1, 0x23a10000044dc341, 0x23a100001ed54181,
+	0x23a1000005754181, 0x23a100000fefc241, 0x23a100000e5cc201, 0x23a1000004c78201,
+	0x23a1000004c78381, 0x23a1000009cd41c1, 0x23a1000023b50141, 0x23a1000023b64141,
+	0x23a1000008df8101, 0x23a100001ede4181, 0x23a1000014534201, 0x23a10000147300c1,
+	0x23a1000003244381, 0x23a1000004310201, 0x23a100000a34c2c1, 0x23a100001a0201c1,
+	0x23a10000108e4141, 0x23a1000005398181, 0x23a100001a0581c1, 0x23a1000014674201,
+	0x23a100000ff68241, 0x23a1000023c90141, 0x23a10000146f4201, 0x23a100001efc4181,
+	0x23a100000cc9c281, 0x23a1000010064241, 0x23a1000007584241, 0x23a100000a5e02c1,
+	0x23a1000014854201, 0x23a10000100f4241, 0x23a100001f0cc181, 0x23a100000ccec281,
+	0x23a100000cd14281, 0x23a1000007a28301, 0x23a100001a3681c1, 0x23a100000cd3c281,
+	0x23a10000001e42c1, 0x23a10000001e4641, 0x23a1000014914201, 0x23a1000023de4141,
+	0x23a10000044f8181, 0x23a10000101a8241, 0x23a100001f15c181, 0x23a100000cddc281,
+	0x23a10000048a8181, 0x23a10000062a8101, 0x23a10000062a8341, 0x23a100001a4641c1,
+	0x23a10000101f0241, 0x23a1000023e98141, 0x23a1000014a74201, 0x23a1000010214241,
+	0x23a1000026358101, 0x23a1000014a941c1, 0x23a1000014a94201, 0x23a100001a5281c1,
+	0x23a1000014af4201, 0x23a100001a6081c1, 0x23a100000e7b8141, 0x23a1000007b78301,
+	0x23a10000102c8241, 0x23a100000cea4281, 0x23a10000102ec241, 0x23a1000010310241,
+	0x23a1000007bd8301, 0x23a100000a8f82c1, 0x23a1000014c14201, 0x23a1000014c34201,
+	0x23a100001a73c101, 0x23a1000014d74201, 0x23a10000104c0241, 0x23a100000c3701c1,
+	0x23a10000034cc181, 0x23a10000029601c1, 0x23a1000010550241, 0x23a100000a9a82c1,
+	0x23a100001ae581c1, 0x23a100001ae741c1, 0x23a100001aec81c1, 0x23a1000016210101,
+	0x23a100002408c141, 0x23a10000240f0141, 0x23a100000aa002c1, 0x23a1000026488101,
+	0x23a1000014f54201, 0x23a100000d0fc281, 0x23a100001b2641c1, 0x23a100001b2801c1,
+	0x23a10000012fc481, 0x23a100001f7bc181, 0x23a1000000398181, 0x23a1000004f88381,
+	0x23a100001f7ec181, 0x23a10000150d4201, 0x23a1000003b383c1, 0x23a1000002e5c201,
+	0x23a10000151f4201, 0x23a1000001dcc281, 0x23a1000001dcc441, 0x23a1000004ff8381,
+	0x23a1000000f9c281, 0x23a1000000f9c301, 0x23a1000000ac01c1, 0x23a1000002afc401,
+	0x23a1000015334201, 0x23a1000005018141, 0x23a100000d2b4281, 0x23a1000007e48301,
+	0x23a10000065b4341, 0x23a1000007ed8181, 0x23a1000007ed81c1, 0x23a1000007ed8301,
+	0x23a1000007f08301, 0x23a1000007f68301, 0x23a100000abb82c1, 0x23a10000265b8101,
+	0x23a10000001f0101, 0x23a1000010c10241, 0x23a100001b8141c1, 0x23a1000010d78241,
+	0x23a1000010dc0241, 0x23a100001b8681c1, 0x23a1000010de4181, 0x23a1000010de4241,
+	0x23a10000274080c1, 0x23a100000d4e4281, 0x23a100001b8a01c1, 0x23a100000adf42c1,
+	0x23a100000d534281, 0x23a1000026658101, 0x23a100001fd44181, 0x23a1000010f04241,
+	0x23a100001d97c101, 0x23a100000d82c281, 0x23a100002462c141, 0x23a1000005378141,
+	0x23a100000688c341, 0x23a1000005378381, 0x23a100002476c141, 0x23a100002014c181,
+	0x23a100001be6c1c1, 0x23a100000d96c281, 0x23a10000201ac181, 0x23a10000267b8101,
+	0x23a100001bedc1c1, 0x23a100000b0302c1, 0x23a100001c0481c1, 0x23a1000013b40101,
+	0x23a1000004518181, 0x23a1000004518341, 0x23a1000008568301, 0x23a100002032c181,
+	0x23a100000da84281, 0x23a1000020344181, 0x23a1000016354201, 0x23a100001c1281c1,
+	0x23a100000dad4281, 0x23a10000203d4181, 0x23a1000024988141, 0x23a10000122c80c1,
+	0x23a100002044c181, 0x23a1000016414201, 0x23a100000dbec281, 0x23a10000164341c1,
+	0x23a1000016434201, 0x23a1000026868101, 0x23a100001c25c1c1, 0x23a1000016454201,
+	0x23a1000016474201, 0x23a10000164f4201, 0x23a100000dc3c281, 0x23a100001184c141,
+	0x23a100001c3741c1, 0x23a1000000f804c1, 0x23a10000205b4181, 0x23a1000016614201,
+	0x23a10000118b8241, 0x23a10000166b4201, 0x23a100000b1902c1, 0x23a10000166d4201,
+	0x23a100000b044101, 0x23a1000020764181, 0x23a100002077c181, 0x23a1000011990241,
+	0x23a100001c4381c1, 0x23a1000007b88201, 0x23a1000020944181, 0x23a100001648c101,
+	0x23a1000024adc141, 0x23a1000016854201, 0x23a100000dee4281, 0x23a1000016874201,
+	0x23a10000209bc181, 0x23a100000b298281, 0x23a10000169b4201, 0x23a100000a6c8201,
+	0x23a10000169d4201, 0x23a1000020a34181, 0x23a1000020a7c181, 0x23a10000086b8301,
+	0x23a1000002d3c401, 0x23a1000006698141, 0x23a1000002dfc201, 0x23a1000002dfc401,
+	0x23a1000002e3c401, 0x23a10000054c8381, 0x23a1000000aa4381, 0x23a1000000aa4501,
+	0x23a10000040d83c1, 0x23a10000015a4181, 0x23a1000001fec441, 0x23a1000000fcc4c1,
+	0x23a1000016d54201, 0x23a1000024c80141, 0x23a1000016dd4201, 0x23a1000024cbc141,
+	0x23a1000008838301, 0x23a1000011ca8241, 0x23a1000011ccc241, 0x23a100001c79c1c1,
+	0x23a1000011cf0241, 0x23a100001c7b81c1, 0x23a1000020d34181, 0x23a100001c9081c1,
+	0x23a1000000280141, 0x23a10000274f80c1, 0x23a1000019e040c1, 0x23a1000026a18101,
+	0x23a1000006c00341, 0x23a10000041143c1, 0x23a1000024ec4141, 0x23a1000005638181,
+	0x23a100001cb8c1c1, 0x23a100001cbc41c1, 0x23a100000b584181, 0x23a100000b5842c1,
+	0x23a1000024f50141, 0x23a10000210c4181, 0x23a100001ccdc1c1, 0x23a1000012074241,
+	0x23a100002110c181, 0x23a100002502c141, 0x23a100000b6342c1, 0x23a100002113c181,
+	0x23a1000025040141, 0x23a10000172d4201, 0x23a1000017314201, 0x23a1000002efc301,
+	0x23a1000002efc401, 0x23a1000012104241, 0x23a100001cd681c1, 0x23a100000b6e42c1,
+	0x23a1000021214181, 0x23a100000e394281, 0x23a100000b7102c1, 0x23a100000e3bc281,
+	0x23a100002125c181, 0x23a1000025144141, 0x23a10000212a4181, 0x23a10000174b4201,
+	0x23a100000e45c281, 0x23a1000000174701, 0x23a1000008ad8301, 0x23a10000174d4201,
+	0x23a10000033e0141, 0x23a10000062f41c1, 0x23a1000003e24181, 0x23a10000251e4141,
+	0x23a100002137c181, 0x23a10000175d4201, 0x23a100001cef01c1, 0x23a1000017714201,
+	0x23a100000e614281, 0x23a1000008c58301, 0x23a10000123d4241, 0x23a100001d0b01c1,
+	0x23a1000004264101, 0x23a1000021574181, 0x23a100000e6b4281, 0x23a1000010dd0141,
+	0x23a1000008dd8301, 0x23a1000017854201, 0x23a10000178f4201, 0x23a1000008e38301,
+	0x23a1000008e68301, 0x23a1000012488241, 0x23a100001d2701c1, 0x23a1000008e98301,
+	0x23a1000026be8101, 0x23a100000e77c281, 0x23a1000008ec8301, 0x23a100000b9d02c1,
+	0x23a100000b9fc2c1, 0x23a1000012518181, 0x23a100001d3501c1, 0x23a100000153c1c1,
+	0x23a100000427c3c1, 0x23a1000002184441, 0x23a100000153c481, 0x23a1000005688341,
+	0x23a1000005688381, 0x23a10000056c0381, 0x23a1000001584201, 0x23a1000001584481,
+	0x23a10000179d4201, 0x23a100001d3881c1, 0x23a100000e8e4281, 0x23a1000017b54201,
+	0x23a10000255cc141, 0x23a100000e934281, 0x23a100001d5481c1, 0x23a1000026c78101,
+	0x23a100000533c0c1, 0x23a1000025658141, 0x23a1000001df41c1, 0x23a100001d6b41c1,
+	0x23a1000005768381, 0x23a1000007078341, 0x23a1000012adc241, 0x23a1000000e10141,
+	0x23a1000012b000c1, 0x23a100001d708181, 0x23a1000013090141, 0x23a1000017f34201,
+	0x23a1000012b24241, 0x23a10000042f4141, 0x23a10000042f43c1, 0x23a1000000cb81c1,
+	0x23a10000257c0141, 0x23a100000eb14281, 0x23a1000021a84181, 0x23a1000012c20241,
+	0x23a10000090a8301, 0x23a1000012c8c241, 0x23a1000021afc181, 0x23a1000009138301,
+	0x23a1000021b8c181, 0x23a10000180b4201, 0x23a1000021c34181, 0x23a1000021c4c181,
+	0x23a1000026d58101, 0x23a10000276c00c1, 0x23a100000eca4281, 0x23a100000220c441,
+	0x23a100000ecf4281, 0x23a1000025950141, 0x23a100001da181c1, 0x23a100001da341c1,
+	0x23a1000004840101, 0x23a100000bd6c2c1, 0x23a1000018634201, 0x23a100002200c181,
+	0x23a1000017b3c141, 0x23a100000303c101, 0x23a100000ede4241, 0x23a1000026e08101,
+	0x23a100001dd281c1, 0x23a100001dd60141, 0x23a100001dd601c1, 0x23a1000004fac141,
+	0x23a10000277200c1, 0x23a10000188d4201, 0x23a100000ee5c281, 0x23a10000040640c1,
+	0x23a100000ee84281, 0x23a10000222c4181, 0x23a10000044d43c1, 0x23a10000131e4241,
+	0x23a100000323c401, 0x23a1000018974201, 0x23a1000013208241, 0x23a100001df041c1,
+	0x23a10000045103c1, 0x23a1000026ed8101, 0x23a1000026ee8101, 0x23a10000026e4181,
+	0x23a100002236c181, 0x23a100000ef9c281, 0x23a100002248c181, 0x23a100001dfe41c1,
+	0x23a1000018ad4201, 0x23a10000073841c1, 0x23a100001e01c1c1, 0x23a100000f014281,
+	0x23a100000bfa82c1, 0x23a1000025d24141, 0x23a1000018b94201, 0x23a10000043200c1,
+	0x23a10000094f8301, 0x23a10000045883c1, 0x23a10000073b8341, 0x23a10000014c01c1,
+	0x23a10000133dc241, 0x23a100001e1c01c1, 0x23a1000018c74201, 0x23a1000000984301,
+	0x23a1000018c94201, 0x23a1000018cd4201, 0x23a1000013568101, 0x23a1000013568241,
+	0x23a1000018e54201, 0x23a1000025e50141, 0x23a100000f26c281, 0x23a100001e40c1c1,
+	0x23a1000018fd4201, 0x23a1000005998381, 0x23a100000f494101, 0x23a100002390c0c1,
+	0x23a100000013c0c1, 0x23a100000a270101, 0x23a10000075840c1, 0x23a10000007500c1,
+	0x23a1000011878101, 0x23a10000013d40c1, 0x23a10000048b00c1, 0x23a1000000adc181,
+	0x23a100000e04c101, 0x23a10000021f00c1, 0x23a1000004de40c1, 0x23a1000003f480c1,
+	0x23a10000003d4081, 0x23a100002390c0c1, 0x23a1000000100081, 0x23a100000013c0c1,
+	0x23a100000a270101, 0x23a10000075840c1, 0x23a10000007500c1, 0x23a10000013d40c1,
+	0x23a1000000adc181, 0x23a10000021f00c1, 0x23a1000004de40c1, 0x23a1000013e54208,
+	0x23a100000013c0c1, 0x23a10000075840c1, 0x23a10000239040c1, 0x23a10000021f00c1,
+	0x23a1000004de40c1, 0x23a100002390c0c1, 0x23a1000013e54208, 0x23a100000013c0c1,
+	0x23a10000075840c1, 0x23a10000007500c1, 0x23a10000048b00c1, 0x23a100000e04c101,
+	0x23a10000021f00c1, 0x23a1000004de40c1, 0x23a10000003d4081, 0x23a100000060c0c1,
+	0x23a1000000100081, 0x23a10000075840c1, 0x23a10000007500c1, 0x23a10000048b00c1,
+	0x23a10000021f00c1, 0x23a1000004de40c1, 0x23a1000000154081, 0x23a10000003d8081,
+	0x23a1000000100081, 0x23a100000013c0c1, 0x23a100000048c081, 0x23a10000003b0081,
+	0x23a1000011878101, 0x23a1000001518101, 0x23a1000027358081, 0x23a100000e04c101,
+	0x23a1000000104081, 0x23a1000004de40c1, 0x23a1000003f480c1, 0x23a1000012518181,
+	0x23a1000004e7c081, 0x23a10000006a4081, 0x23a10000008c8081, 0x23a10004012140c0,
+	0x23a2000400ac00c0, 0x23a30000006f8101, 0x23a3000003fe00c1, 0x23a3000005b8c348,
+	0x23a30000047e0388, 0x23a3000004818388, 0x23a300001e5d4188, 0x23a3000000874188,
+	0x23a3000004850388, 0x23a3000001194288, 0x23a3000001194488, 0x23a300000257c208,
+	0x23a300000257c408, 0x23a3000000874308, 0x23a3000000874508, 0x23a3000001b24248,
+	0x23a3000001b24448, 0x23a300000c2ec288, 0x23a300001e724188, 0x23a30000075d8308,
+	0x23a3000405cc4340, 0x23a4000007638308, 0x23a4001c034303c8, 0x23ab000005cf8348,
+	0x23ab000026028108, 0x23ab000026038108, 0x23ab000026048108, 0x23ab000009e7c148,
+	0x23ab00000fb0c248, 0x23ab0000076f8308, 0x23ab0000197ec1c8, 0x23ab00001ea3c188,
+	0x23ab000013ed4208, 0x23ab0000198941c8, 0x23ab000013f94208, 0x23ab00000c634288,
+	0x23ab000019a541c8, 0x23ab00000fc98248, 0x23ab000407788140, 0x23ac00000fcbc248,
+	0x23ac00040a1682c8, 0x23ad00000a1ec2c8, 0x23ad00000a2182c8, 0x23ad00000fe48208,
+	0x23ad00000c8dc288, 0x23ad000004b98388, 0x23ad00080c904288, 0x23af000005fd0348,
+	0x23af000006004348, 0x23af00000a2f42d0, 0x23af00000cb0c288, 0x23af000014574108,
+	0x23af00001ee8c188, 0x23af0000145f4208, 0x23af0000146b4208, 0x23af00001a0741c8,
+	0x23af00000cbfc148, 0x23af00001a0ac1c8, 0x23af00000ffb0248, 0x23af00001a1701c8,
+	0x23af000014794208, 0x23af00001001c248, 0x23af000026278108, 0x23af000023d1c148,
+	0x23af000004dc8388, 0x23af00001a2181c8, 0x23af0000038681c8, 0x23af0000038683c8,
+	0x23af000001c78248, 0x23af000001c78448, 0x23af000c1f09c180, 0x23b20000061d8348,
+	0x23b20000149b4208, 0x23b20010028bc180, 0x23b60004149d4208, 0x23b700000ce2c288,
+	0x23b7000014a34208, 0x23b700000293c408, 0x23b7000007ab8308, 0x23b700001a7c81c8,
+	0x23b700001a8001c8, 0x23b700001a96c1c8, 0x23b700001ab2c1c8, 0x23b70000011d8088,
+	0x23b700001aeac1c8, 0x23b7000007c68308, 0x23b700001f84c188, 0x23b700002426c148,
+	0x23b700001b3b41c8, 0x23b700001f954188, 0x23b700001091c248, 0x23b70000152d4208,
+	0x23b7000001cc8088, 0x23b70000003b0088, 0x23b700001faec188, 0x23b700000ab8c2c8,
+	0x23b700001fb04188, 0x23b70000265a8108, 0x23b700001b61c1c8, 0x23b7000010ba4248,
+	0x23b7000010bc8248, 0x23b7000015554208, 0x23b70000052fc088, 0x23b70000110b4248,
+	0x23b7000015c34208, 0x23b700000d7dc288, 0x23b7000015ed4208, 0x23b700040b10c2c0,
+	0x23b8000011870248, 0x23b800002062c188, 0x23b8000011a8c248, 0x23b800001c5501c8,
+	0x23b800001c5881c8, 0x23b8000011bd0248, 0x23b8000016a94208, 0x23b800001c5dc1c8,
+	0x23b8000011bf4248, 0x23b8000024b54148, 0x23b8000002d7c408, 0x23b8000008718308,
+	0x23b8000020d1c188, 0x23b8000024d48148, 0x23b800001c9401c8, 0x23b8000006c34348,
+	0x23b8000011f0c248, 0x23b80008275280c0, 0x23ba000024fc8148, 0x23ba0000211cc188,
+	0x23ba00001ce101c8, 0x23ba000000374608, 0x23ba0000175b4208, 0x23ba00000e5ec288,
+	0x23ba000005618388, 0x23ba000000090088, 0x23ba00080b9a42c0, 0x23bc00040e7a4280,
+	0x23bd00000e7f4288, 0x23bd000006ea4348, 0x23bd0000001e0088, 0x23bd000021754188,
+	0x23bd000021784188, 0x23bd000017a34208, 0x23bd00000e86c288, 0x23bd000017a54208,
+	0x23bd0000125cc248, 0x23bd000017a74208, 0x23bd000005730388, 0x23bd00000e9fc288,
+	0x23bd000017d54208, 0x23bd00001d7081c8, 0x23bd000826cf8100, 0x23bf0000070e0348,
+	0x23bf000018034208, 0x23bf00000e538148, 0x23bf000018174208, 0x23bf000412dd0240,
+	0x23c0000018514208, 0x23c000040ed94288, 0x23c1000000180088, 0x23c1000418794200,
+	0x23c20000221ec188, 0x23c200000536c088, 0x23c2000026e58108, 0x23c200002251c188,
+	0x23c2000418b14208, 0x23c3000022654188, 0x23c300080c0842c8, 0x23c5000000634088,
+	0x23c5000023664150, 0x23c5000025ff8108, 0x23c50000195d81c8, 0x23c5000000100088,
+	0x23c5000026db8108, 0x23c500000e18c288, 0x23c500000326c101, 0x23c500000013c0c1,
+	0x23c5000014ab4101, 0x23c5000011878101, 0x23c50000021f00c1, 0x23c500001990c141,
+	0x23c5000000a680c1, 0x23c5000001fd00c1, 0x23c5000007a74141, 0x23c50000002540c1,
+	0x23c50000009640c8, 0x23c500040013c0c1, 0x23c60000075840c1, 0x23c6000014ab4108,
+	0x23c60000018fc0c8, 0x23c60000007500c1, 0x23c600000008c041, 0x23c600001d2080c8,
+	0x23c60000048b00c1, 0x23c6000001518101, 0x23c600000e04c101, 0x23c60000021f00c1,
+	0x23c600000a0940c8, 0x23c6000004de40c1, 0x23c6000000fb80c1, 0x23c60000003d4081,
+	0x23c600002390c0c1, 0x23c6000000100081, 0x23c600000013c0c1, 0x23c60000075840c1,
+	0x23c60000239040c1, 0x23c60000003b0081, 0x23c6000011878101, 0x23c60000013d40c1,
+	0x23c60000048b00c1, 0x23c60000021f00c1, 0x23c6000003fe00c1, 0x23c6000004de40c1,
+	0x23c60000002540c1, 0x23c600000c184288, 0x23c6000013e54208, 0x23c6000019ca01c8,
+	0x23c6000000100088, 0x23c6000023c68148, 0x23c60000007500c8, 0x23c6000003ca03c8,
+	0x23c600001fe94188, 0x23c6000008718308, 0x23c6000018714210, 0x23c6000005b58348,
+	0x23c6000400e00081, 0x23c7000013954201, 0x23c700001e544181, 0x23c700000efd4181,
+	0x23c700001923c1c1, 0x23c7000401dc8081, 0x23c8000413ab4200, 0x23c900000c274281,
+	0x23c9000013ad4201, 0x23c9000000210081, 0x23c900000f524241, 0x23c900001931c1c1,
+	0x23c90000034c4181, 0x23c900000f590241, 0x23c9000005e34101, 0x23c900001e664181,
+	0x23c90000090e8101, 0x23c900000ec38101, 0x23c900000f68c241, 0x23c900001e694181,
+	0x23c9000021680141, 0x23c900001d23c181, 0x23c9000011760141, 0x23c90000193c41c1,
+	0x23c90000193e01c1, 0x23c9000011754101, 0x23c900001e784181, 0x23c9000023704141,
+	0x23c9000023718141, 0x23c900002372c141, 0x23c900001946c1c1, 0x23c900001e7b4181,
+	0x23c9000023740141, 0x23c900000f8a8241, 0x23c9000023790141, 0x23c9000018950101,
+	0x23c90000194f81c1, 0x23c9000004968381, 0x23c90000033f43c1, 0x23c900000f8cc241,
+	0x23c900000f914241, 0x23c900001e844181, 0x23c90000195301c1, 0x23c9000013cb4201,
+	0x23c9000009df82c1, 0x23c9000007698301, 0x23c900001e88c181, 0x23c90000196481c1,
+	0x23c90000237e0141, 0x23c900000c42c281, 0x23c900000f980241, 0x23c900000f9a4241,
+	0x23c900001e8a4181, 0x23c9000023808141, 0x23c900002381c141, 0x23c900000fa58241,
+	0x23c9000009f2c2c1, 0x23c90000076c8301, 0x23c900001e904181, 0x23c900001e94c181,
+	0x23c900000fb8c101, 0x23c900001e964181, 0x23c9000013db4201, 0x23c900000fac4241,
+	0x23c900001e9ac181, 0x23c90000197981c1, 0x23c900001ea0c181, 0x23c9000013df4201,
+	0x23c90000197d01c1, 0x23c9000013e14201, 0x23c900000fb54241, 0x23c9000023920141,
+	0x23c9000013e54208, 0x23c90000260b8101, 0x23c900002395c141, 0x23c90000260d8101,
+	0x23c9000023970141, 0x23c900000fbc0241, 0x23c900000a0601c1, 0x23c900000a0602c1,
+	0x23c900000c60c281, 0x23c9000023998141, 0x23c9000005d94241, 0x23c9000005d94341,
+	0x23c90004009a4081, 0x23ca00001993c1c1, 0x23ca000414034200, 0x23cb0000199ac1c1,
+	0x23cb0000239d4141, 0x23cb000007728301, 0x23cb000005dfc341, 0x23cb000014074201,
+	0x23cb000014094201, 0x23cb0000199c81c1, 0x23cb00000a0b82c1, 0x23cb00000c65c281,
+	0x23cb00001eb14181, 0x23cb00001eb2c181, 0x23cb00000c684281, 0x23cb000000100088,
+	0x23cb00000a3202c1, 0x23cb000007968301, 0x23cb00000cb5c281, 0x23cb00001ee74181,
+	0x23cb0000004340c1, 0x23cb0000060a0341, 0x23cb0000079c8301, 0x23cb0000060d4341,
+	0x23cb000023ca4141, 0x23cb000023ccc141, 0x23cb00001a11c1c1, 0x23cb00000ffd4241,
+	0x23cb00001ef64181, 0x23cb00001a1541c1, 0x23cb000023d30141, 0x23cb00001f024181,
+	0x23cb00001f03c181, 0x23cb000014834201, 0x23cb00000e0400c1, 0x23cb000014874201,
+	0x23cb000014894201, 0x23cb00001a26c1c1, 0x23cb0000148b4201, 0x23cb0000100d0241,
+	0x23cb00001a2c01c1, 0x23cb00001a34c1c1, 0x23cb0000148d4201, 0x23cb00001a3d8101,
+	0x23cb00001a3d81c1, 0x23cb000014974201, 0x23cb00001f12c181, 0x23cb00000a6642c1,
+	0x23cb0000039583c1, 0x23cb00001a4801c1, 0x23cb00001f174181, 0x23cb000023e5c141,
+	0x23cb000001c140c1, 0x23cb00001a4f01c1, 0x23cb0000263280c1, 0x23cb00001f1a4181,
+	0x23cb00001a5441c1, 0x23cb00001f1bc181, 0x23cb00001f1d4181, 0x23cb000007d08141,
+	0x23cb0000036d80c1, 0x23cb000014ad4201, 0x23cb00001025c241, 0x23cb00000a81c2c1,
+	0x23cb000014b14201, 0x23cb000023ee8141, 0x23cb000023efc141, 0x23cb00001f21c181,
+	0x23cb000026398101, 0x23cb000410c24081, 0x23cc000010280241, 0x23cc00001a6781c1,
+	0x23cc000023f10141, 0x23cc00001a6e81c1, 0x23cc00001f294181, 0x23cc000023f4c141,
+	0x23cc00000a8a02c1, 0x23cc000014c14101, 0x23cc000023f60141, 0x23cc00001a7581c1,
+	0x23cc00001f2ac181, 0x23cc000023f74141, 0x23cc00001f2dc181, 0x23cc00001f2f4181,
+	0x23cc00001adcc1c1, 0x23cc00001ade81c1, 0x23cc00001276c101, 0x23cc00000cf44281,
+	0x23cc000014df4201, 0x23cc00001f36c181, 0x23cc000010508241, 0x23cc000023fec141,
+	0x23cc00001f39c181, 0x23cc00000cf6c281, 0x23cc00001f3b4181, 0x23cc00001ae201c1,
+	0x23cc0000063e0341, 0x23cc000010574241, 0x23cc00000cfbc281, 0x23cc000024064141,
+	0x23cc00001af1c1c1, 0x23cc000014e54201, 0x23cc000014e74201, 0x23cc00001af381c1,
+	0x23cc00001f4d4181, 0x23cc00001af541c1, 0x23cc00001af8c1c1, 0x23cc00000ff980c1,
+	0x23cc000005e80101, 0x23cc00002412c141, 0x23cc00001b0c01c1, 0x23cc000010604241,
+	0x23cc000014ef4201, 0x23cc000024168141, 0x23cc0000264a8101, 0x23cc0000241a4141,
+	0x23cc000006448341, 0x23cc00000d0ac281, 0x23cc00001b1bc1c1, 0x23cc000007c38301,
+	0x23cc000001000081, 0x23cc00001f5dc181, 0x23cc00001f624181, 0x23cc00000d0d4281,
+	0x23cc00001f684181, 0x23cc0000241f4141, 0x23cc00001b248141, 0x23cc00001b2481c1,
+	0x23cc000007cc8301, 0x23cc00000d124281, 0x23cc00001f6fc181, 0x23cc00000db3c141,
+	0x23cc00001f72c181, 0x23cc00001b2b81c1, 0x23cc00001f7a4181, 0x23cc000007cf8301,
+	0x23cc000010748241, 0x23cc000c1b2f01c0, 0x23cf00001f804141, 0x23cf00001f804181,
+	0x23cf0000150f4201, 0x23cf00000af98141, 0x23cf0000242a8141, 0x23cf000015214201,
+	0x23cf00000d23c281, 0x23cf000408ed0081, 0x23d0000404a68081, 0x23d10000242bc141,
+	0x23d100001f924181, 0x23d10000199840c1, 0x23d1000015254201, 0x23d10000049880c1,
+	0x23d1000026538101, 0x23d100000ab342c1, 0x23d1000015294201, 0x23d100001088c241,
+	0x23d1000015314201, 0x23d1000410988240, 0x23d20000109ac241, 0x23d200001f99c181,
+	0x23d20000153b4201, 0x23d20000109f4241, 0x23d200001f9e4181, 0x23d2000024320141,
+	0x23d200001f9fc181, 0x23d2000010a18241, 0x23d200001b45c1c1, 0x23d20000050a0381,
+	0x23d200001b4781c1, 0x23d200001e4400c1, 0x23d200000ab602c1, 0x23d2000010a60241,
+	0x23d2000010a84241, 0x23d200000d2dc281, 0x23d200001b4b01c1, 0x23d200001fa2c181,
+	0x23d200001b5e41c1, 0x23d2000015434201, 0x23d200001b7881c1, 0x23d20000265c8101,
+	0x23d2000410d30241, 0x23d3000015694201, 0x23d30000156b4201, 0x23d300001b8841c1,
+	0x23d30000119dc181, 0x23d3000010e2c241, 0x23d3000015774201, 0x23d300000d55c281,
+	0x23d300001b8d81c1, 0x23d300000d64c281, 0x23d3000015a34201, 0x23d300001ba0c1c1,
+	0x23d300001fd8c181, 0x23d300001ba281c1, 0x23d300000d6c4281, 0x23d3000015c74201,
+	0x23d30000245dc141, 0x23d30000245f0141, 0x23d300001ff6c181, 0x23d300001bcc81c1,
+	0x23d30000111f8241, 0x23d300000af542c1, 0x23d3000015e54201, 0x23d3000011264241,
+	0x23d300001bd541c1, 0x23d3000015eb4201, 0x23d3000008418301, 0x23d3000008448301,
+	0x23d300000d8a4281, 0x23d3000008478301, 0x23d300000d8cc281, 0x23d300000af802c1,
+	0x23d3000016134201, 0x23d3000016154201, 0x23d3000026788101, 0x23d3000011384241,
+	0x23d30000113a8241, 0x23d300000d91c281, 0x23d30000113cc241, 0x23d300001be181c1,
+	0x23d3000016174201, 0x23d30000113f0241, 0x23d300000d944281, 0x23d30000068c0341,
+	0x23d30000161d4201, 0x23d30000084d8301, 0x23d3000020164181, 0x23d30000161f4201,
+	0x23d30000114c8241, 0x23d30000201c4181, 0x23d30000201dc181, 0x23d300001bf301c1,
+	0x23d3000016234201, 0x23d300000b0042c1, 0x23d3000008538301, 0x23d300001bf681c1,
+	0x23d3000011510241, 0x23d3000011534241, 0x23d300001bfbc1c1, 0x23d300000ffa0101,
+	0x23d300001bfd81c1, 0x23d300002026c181, 0x23d3000020284181, 0x23d3000024834141,
+	0x23d3000016274201, 0x23d300002029c101, 0x23d300002029c181, 0x23d300000b05c2c1,
+	0x23d30000115a0241, 0x23d30000202b4181, 0x23d30000115e8241, 0x23d300001c0641c1,
+	0x23d30000202cc181, 0x23d300001c09c1c1, 0x23d30000162d4201, 0x23d300000df040c1,
+	0x23d30000248e8141, 0x23d30000116e4241, 0x23d30000248fc141, 0x23d3000016394101,
+	0x23d300002038c181, 0x23d3000024960141, 0x23d30000203a4181, 0x23d3000003cc4181,
+	0x23d300001c1601c1, 0x23d30000203ec181, 0x23d300001c17c1c1, 0x23d300001c1981c1,
+	0x23d3000011708241, 0x23d300000dafc281, 0x23d3000020404181, 0x23d300000b0e02c1,
+	0x23d300001c2241c1, 0x23d3000020494181, 0x23d30000204c4181, 0x23d300002050c181,
+	0x23d3000011750241, 0x23d300000dc64281, 0x23d30000249ec141, 0x23d30000069c4341,
+	0x23d300000d24c181, 0x23d30000205e4181, 0x23d30000205fc181, 0x23d3000024a3c141,
+	0x23d3000020614181, 0x23d300001c3ac1c1, 0x23d3000016674201, 0x23d300001c3e41c1,
+	0x23d3000005420381, 0x23d30000048b00c1, 0x23d30000119d8241, 0x23d300000de94281,
+	0x23d30000119fc241, 0x23d300001c4701c1, 0x23d300001c48c1c1, 0x23d3000024ab4141,
+	0x23d3000024ac8141, 0x23d30008040243c0, 0x23d500001c4e01c1, 0x23d5000016934201,
+	0x23d5000016954201, 0x23d50000268f8101, 0x23d50000209d4181, 0x23d5000016994201,
+	0x23d500041c4fc081, 0x23d6000020a4c181, 0x23d600001c5181c1, 0x23d6000000adc181,
+	0x23d6000008718308, 0x23d6000008748301, 0x23d600000409c3c1, 0x23d6000016b54201,
+	0x23d600001c6141c1, 0x23d6000802dbc400, 0x23d8000005490381, 0x23d800000e024281,
+	0x23d8000020bfc181, 0x23d800000e074281, 0x23d8000011c60241, 0x23d8000024c58141,
+	0x23d8000016d34201, 0x23d8000020c74181, 0x23d8000016d74201, 0x23d8000016d94201,
+	0x23d800000b3742c1, 0x23d8000024ca8141, 0x23d8000016df4201, 0x23d8000008808301,
+	0x23d800001c7101c1, 0x23d8000020cec181, 0x23d8000016ef4201, 0x23d8000016f14201,
+	0x23d80004053ac081, 0x23d900000b4242c1, 0x23d9000011e34241, 0x23d9000011e58241,
+	0x23d900001c8ec1c1, 0x23d9000016f74201, 0x23d9001016f94200, 0x23dd00000b4502c1,
+	0x23dd000011e7c241, 0x23dd000006b98341, 0x23dd000017014201, 0x23dd000017054201,
+	0x23dd0004000bc081, 0x23de000011ea0241, 0x23de000002ebc401, 0x23de00001c95c1c1,
+	0x23de00000e1b4281, 0x23de000026a38101, 0x23de000400934081, 0x23df000020f2c181,
+	0x23df000400968081, 0x23e000000e204281, 0x23e0000021004181, 0x23e00000171d4201,
+	0x23e0000011f9c241, 0x23e0000017214201, 0x23e0000021034181, 0x23e000002104c181,
+	0x23e0000021064181, 0x23e000000ec48141, 0x23e0000019e80101, 0x23e0000426a88101,
+	0x23e1000021094181, 0x23e100001cc341c1, 0x23e1000017254201, 0x23e100041cc501c0,
+	0x23e200000b5dc2c1, 0x23e200001cc881c1, 0x23e200001202c241, 0x23e200000e31c281,
+	0x23e2000026aa8101, 0x23e2000017274201, 0x23e2000006c9c341, 0x23e20000121dc241,
+	0x23e2000017494201, 0x23e2000012200241, 0x23e200000e434281, 0x23e2000000374101,
+	0x23e2000006064101, 0x23e2000025234141, 0x23e20000213ac181, 0x23e200000b8702c1,
+	0x23e20000213dc181, 0x23e200001d1d8141, 0x23e200001226c241, 0x23e2000017614201,
+	0x23e2000005488101, 0x23e2000012290241, 0x23e2000025248141, 0x23e20000176d4201,
+	0x23e200001cfb41c1, 0x23e20000176f4201, 0x23e20000122d8241, 0x23e20000214b4181,
+	0x23e200001d0941c1, 0x23e2000012368241, 0x23e200001238c241, 0x23e20000252c0141,
+	0x23e20000252d4141, 0x23e20000214cc181, 0x23e20004004a8081, 0x23e3000018738101,
+	0x23e3000025338141, 0x23e3000021514181, 0x23e3000022148141, 0x23e300001d0cc1c1,
+	0x23e300002534c141, 0x23e3000012b54101, 0x23e3000021544181, 0x23e300001d0e81c1,
+	0x23e300002155c181, 0x23e3000025388141, 0x23e3000006da0341, 0x23e30000215bc181,
+	0x23e30000253b0141, 0x23e300001d13c1c1, 0x23e300001241c241, 0x23e300001d1581c1,
+	0x23e3000017794201, 0x23e30000215d4181, 0x23e30000253c4141, 0x23e300001d1741c1,
+	0x23e30000177b4201, 0x23e3000017834201, 0x23e3000014960141, 0x23e300001d2001c1,
+	0x23e3000012440241, 0x23e300000e704281, 0x23e3000006e3c1c1, 0x23e3000008e08301,
+	0x23e3000006e3c341, 0x23e300002167c181, 0x23e300001d2381c1, 0x23e30000124ac241,
+	0x23e30000254f0141, 0x23e30000179b4201, 0x23e30000027f80c1, 0x23e3000025554141,
+	0x23e3000025568141, 0x23e300001d3a41c1, 0x23e3000403990081, 0x23e400001d5801c1,
+	0x23e4000021904181, 0x23e400002191c181, 0x23e4000021934181, 0x23e400002194c181,
+	0x23e4000017d74201, 0x23e400002561c141, 0x23e40000070101c1, 0x23e4000007010341,
+	0x23e400000533c0c1, 0x23e4000025630141, 0x23e400001d5d41c1, 0x23e4000017d94201,
+	0x23e4000017db4201, 0x23e4000025644141, 0x23e4000017dd4201, 0x23e4000012998241,
+	0x23e4000021964181, 0x23e400002197c181, 0x23e400001d60c1c1, 0x23e40000129e0241,
+	0x23e400001d6281c1, 0x23e40000256bc141, 0x23e40000219ac181, 0x23e40000219c4181,
+	0x23e40000256d0141, 0x23e400000ea9c281, 0x23e40000219dc181, 0x23e400001d6441c1,
+	0x23e40000219f4181, 0x23e400001d67c1c1, 0x23e400002570c141, 0x23e400001d6981c1,
+	0x23e4000025720101, 0x23e4000021a24181, 0x23e4000025734141, 0x23e400000bb882c1,
+	0x23e4000012a70241, 0x23e400000eac4281, 0x23e4000017e54201, 0x23e4000017e74201,
+	0x23e4000008fe8301, 0x23e400001d6ec1c1, 0x23e4000017eb4201, 0x23e4000017ed4201,
+	0x23e400002575c141, 0x23e400000eaec281, 0x23e4000012b00241, 0x23e40004000c8081,
+	0x23e5000021a54181, 0x23e5000000cb8101, 0x23e5000012bb4241, 0x23e5000012bd8241,
+	0x23e500001d75c1c1, 0x23e500001d7781c1, 0x23e5000012bfc241, 0x23e5000004330201,
+	0x23e50000043303c1, 0x23e5000002ffc241, 0x23e5000002ffc401, 0x23e5000021a9c181,
+	0x23e500000bbe02c1, 0x23e500001d7b0141, 0x23e500001d7b01c1, 0x23e5000012c68241,
+	0x23e500000ab48181, 0x23e500001d7cc1c1, 0x23e50000257e8141, 0x23e500000fee4101,
+	0x23e5000021b2c181, 0x23e5000005db8101, 0x23e500001d7e81c1, 0x23e5000018074201,
+	0x23e5000418094201, 0x23e600002584c141, 0x23e600001d8581c1, 0x23e6000012cf8241,
+	0x23e6000021c7c181, 0x23e600001d8ac1c1, 0x23e6000021c94181, 0x23e6000021cac181,
+	0x23e6000009168301, 0x23e600000bcbc2c1, 0x23e6000012d1c241, 0x23e6000012d40241,
+	0x23e6000007114341, 0x23e6000018154201, 0x23e6000012d64241, 0x23e6000021cc4181,
+	0x23e6000005348101, 0x23e6000018414201, 0x23e60008184b4200, 0x23e8000004840101,
+	0x23e8000018534201, 0x23e8000026d98101, 0x23e8000012ef0241, 0x23e80000259a0141,
+	0x23e8000021eec181, 0x23e8000401fa4081, 0x23e9000021aa0141, 0x23e9000025a18141,
+	0x23e9000018614201, 0x23e900001db4c1c1, 0x23e90004004c4081, 0x23ea000025a7c141,
+	0x23ea0000220e4181, 0x23ea0000220fc181, 0x23ea00001dc9c1c1, 0x23ea000018734201,
+	0x23ea000018754201, 0x23ea000022144181, 0x23ea00002215c181, 0x23ea00001dd441c1,
+	0x23ea000013058241, 0x23ea000022174181, 0x23ea00002218c181, 0x23ea00001307c241,
+	0x23ea00000be1c2c1, 0x23ea000025af4141, 0x23ea000022204181, 0x23ea000018814201,
+	0x23ea00002221c181, 0x23ea000022234181, 0x23ea00001de781c1, 0x23ea000013178241,
+	0x23ea00000ee0c281, 0x23ea00000ee34281, 0x23ea000025b58141, 0x23ea00001deb01c1,
+	0x23ea000018934201, 0x23ea00002230c181, 0x23ea000400230081, 0x23eb00001df581c1,
+	0x23eb000025c48141, 0x23eb00002233c181, 0x23eb000025c5c101, 0x23eb000025c5c141,
+	0x23eb000022354181, 0x23eb00001df741c1, 0x23eb0000026e4141, 0x23eb00000bfe8101,
+	0x23eb0000189d4201, 0x23eb000025c70141, 0x23eb000022384181, 0x23eb00001df901c1,
+	0x23eb000025c84141, 0x23eb000025c98141, 0x23eb0000125fc101, 0x23eb000013298241,
+	0x23eb00000ef74281, 0x23eb000018a14201, 0x23eb0000223fc181, 0x23eb00002242c181,
+	0x23eb00002245c181, 0x23eb000418ab4200, 0x23ec00001dfac1c1, 0x23ec00000731c341,
+	0x23ec000009408301, 0x23ec0000132bc241, 0x23ec00000bef82c1, 0x23ec0000132e0241,
+	0x23ec00041ab28081, 0x23ed00002775c0c1, 0x23ed0000042500c1, 0x23ed000020c80141,
+	0x23ed00000efc4281, 0x23ed0000224d4181, 0x23ed000025ce8141, 0x23ed00000bfd4101,
+	0x23ed00000bfd42c1, 0x23ed00001334c241, 0x23ed00002254c181, 0x23ed000018b34201,
+	0x23ed000022564181, 0x23ed00001e08c1c1, 0x23ed000009648301, 0x23ed00000f294281,
+	0x23ed0000228f4181, 0x23ed0000161d81c1, 0x23ed000013640241, 0x23ed00000cfd80c1,
+	0x23ed000018f54201, 0x23ed000018f74201, 0x23ed000025edc141, 0x23ed000025ef0141,
+	0x23ed00002290c181, 0x23ed00001c0740c1, 0x23ed00001e4441c1, 0x23ed000022924181,
+	0x23ed00001e4601c1, 0x23ed00001e47c1c1, 0x23ed00001e4981c1, 0x23ed00002293c181,
+	0x23ed000418f94200, 0x23ee000009678301, 0x23ee00000c1082c1, 0x23ee000025f04141,
+	0x23ee000013664241, 0x23ee000005928381, 0x23ee0000096a8301, 0x23ee00002390c0c1,
+	0x23ee00000013c0c1, 0x23ee0000075840c1, 0x23ee0000007500c1, 0x23ee000011878101,
+	0x23ee0000021f00c1, 0x23ee000004de40c1, 0x23ee0000038a43c8, 0x23ee000016654208,
+	0x23ee000005744108, 0x23ee000017b74208, 0x23ee0000003d4081, 0x23ee000400100081,
+	0x23ef000019f400c1, 0x23ef00000cf94101, 0x23ef0000018fc0c1, 0x23ef000026478101,
+	0x23ef000000398181, 0x23ef00001af780c1, 0x23ef0000266d8101, 0x23ef0000249d8141,
+	0x23ef0000048b00c1, 0x23ef000020b3c181, 0x23ef0000021f00c1, 0x23ef000004de40c1,
+	0x23ef00000e36c281, 0x23ef000012518181, 0x23ef000000100081, 0x23ef00000013c0c1,
+	0x23ef0000075840c1, 0x23ef0000007500c1, 0x23ef000000f800c1, 0x23ef000000adc181,
+	0x23ef0000021f00c1, 0x23ef000004de40c1, 0x23ef000003f480c1, 0x23ef0000152b4208,
+	0x23ef00040e5e4148, 0x23f0000017af41c8, 0x23f0000009e7c148, 0x23f000000ccc4108,
+	0x23f000000ccc4288, 0x23f000000ad2c108, 0x23f000000f470248, 0x23f0000001474088,
+	0x23f000000c2c4288, 0x23f00004236b4140, 0x23f1000009e7c148, 0x23f10000197ec1c8,
+	0x23f1000013e34208, 0x23f1000004a48388, 0x23f10000011dc488, 0x23f100000a0e42c8,
+	0x23f100001eb44188, 0x23f1000807788140, 0x23f3000407788300, 0x23f4000019afc1c8,
+	0x23f4000019d2c1c8, 0x23f400000c97c288, 0x23f40000027bc408, 0x23f4000023c04148,
+	0x23f4000000ca82c8, 0x23f40000146b4208, 0x23f400001a0741c8, 0x23f400000a4282c8,
+	0x23f400000ffb0248, 0x23f400001a1001c8, 0x23f400001a1701c8, 0x23f400001a18c1c8,
+	0x23f400001eff4188, 0x23f4000014794208, 0x23f4000804d58188, 0x23f600001a1e01c8,
+	0x23f60000061d8348, 0x23f600000283c408, 0x23f600dc006a0088, 0x242d0000149b4208,
+	0x242d0000028fc408, 0x242d000007a88308, 0x242d000c062dc340, 0x2430000014584208,
+	0x243000000a8cc2c8, 0x243000001a9c01c8, 0x243000001052c248, 0x243000001f594188,
+	0x2430000015114208, 0x24300000068e8088, 0x243000000d264288, 0x243000001b3981c8,
+	0x243000001b3b41c8, 0x243000001091c248, 0x24300000152d4208, 0x24300000242f8148,
+	0x243000001b4241c8, 0x243000001faec188, 0x243000001fb04188, 0x2430000005148388,
+	0x24300000051b8388, 0x2430000003d543c8, 0x2430000010ba4248, 0x2430000010bc8248,
+	0x243000000ac682c8, 0x2430000003dcc3c8, 0x2430000008088308, 0x243000000acec2c8,
+	0x24300000154f4208, 0x243000001b6701c8, 0x2430000015514208, 0x243000000ad9c2c8,
+	0x24300000081a8308, 0x24300000081d8308, 0x2430000008208308, 0x2430000006788348,
+	0x2430000015554208, 0x243000000bc7c088, 0x24300000110b4248, 0x2430000016574208,
+	0x243000000b26c2c8, 0x24300000208e4188, 0x243000000b2c42c8, 0x243000000b2f02c8,
+	0x243000000df5c288, 0x2430000024b18148, 0x2430000002d7c408, 0x2430000020b24188,
+	0x2430000020d04188, 0x2430000024d48148, 0x243000001c9401c8, 0x24300000173b4208,
+	0x243000001ce101c8, 0x243000001ce481c8, 0x243000001ceb81c8, 0x2430000021304188,
+	0x243000000e54c288, 0x243000000e574288, 0x2430000021784188, 0x2430000006ed8348,
+	0x2430000017a54208, 0x2430000017a74208, 0x24300000125f0248, 0x2430000012a04248,
+	0x2430000025784148, 0x2430000009108308, 0x243000000bc382c8, 0x243000041d9701c0,
+	0x2431000013010248, 0x2431000026e28108, 0x2431000013130248, 0x24310000221ec188,
+	0x243100002224c188, 0x24310000006a4088, 0x2431000022654188, 0x243100000c0002c8,
+	0x243100001e24c1c8, 0x243100001e2681c8, 0x2431000000634088, 0x2431000025ea0148,
+	0x243100001c6f41c8, 0x243100000c50c0c1, 0x24310000003d4081, 0x243100000013c0c1,
+	0x24310000075840c1, 0x24310000239040c1, 0x24310000008b80c1, 0x2431000000f800c1,
+	0x24310000021f00c1, 0x2431000003fe00c1, 0x2431000004de40c1, 0x24310000276540c1,
+	0x24310000143b4208, 0x24310000265280c8, 0x2431000026528108, 0x2431000020464188,
+	0x2431000025788108, 0x243100001cdd8148, 0x24310000251bc148, 0x2431000025310148,
+	0x243100000edbc288, 0x243100001ea980c8, 0x2431000013e54208, 0x243100000013c0c1,
+	0x24310000075840c1, 0x24310000239040c1, 0x24310000048b00c1, 0x24310000021f00c1,
+	0x2431000003fe00c1, 0x2431000004de40c1, 0x243100000013c0c1, 0x24310000075840c1,
+	0x2431000004de40c1, 0x243100000013c0c1, 0x24310000075840c1, 0x24310000007500c1,
+	0x243100000008c041, 0x24310000048b00c1, 0x24310000021f00c1, 0x243100000a0940c1,
+	0x2431000004de40c1, 0x2431000007ba8188, 0x2431000025f38108, 0x243100002390c0c1,
+	0x243100000013c0c1, 0x24310000075840c1, 0x24310000048a80c1, 0x24310000239040c1,
+	0x24310000161cc0c1, 0x243100000b5900c1, 0x24310000273600c1, 0x243100000fc380c1,
+	0x24310000007500c1, 0x2431000011878101, 0x24310000021f00c1, 0x2431000004de40c1,
+	0x24310000002540c1, 0x2431000004f4c101, 0x24310000064680c1, 0x2431000002ca00c8,
+	0x24310000069f00c1, 0x2431000013bf4201, 0x243100000c3b4101, 0x243100000c404281,
+	0x243100001e934181, 0x2431000026068108, 0x24310000197441c1, 0x243100000c51c281,
+	0x243100000fae8241, 0x243100001977c1c1, 0x243100000c544281, 0x243100002390c0c1,
+	0x2431000009fdc2c1, 0x243100000fc2c241, 0x24310000239e8141, 0x2431000019c841c1,
+	0x2431000000100088, 0x243100000013c0c1, 0x2431000019fb01c1, 0x2431000023b78141,
+	0x243100000ff8c241, 0x2431000004e00388, 0x24310000075840c1, 0x243100001f0b4181,
+	0x243100000eaf80c1, 0x2431000012a7c0c8, 0x243100001f3cc188, 0x243100001f3e4188,
+	0x243100001af701c8, 0x243100001f51c181, 0x24310000240c8141, 0x243100001afa81c1,
+	0x243100001b0341c1, 0x243100bc007500c1, 0x246000001b0a41c1, 0x24600000256a40c1,
+	0x2460000010964248, 0x24600000243ac141, 0x2460000011878101, 0x2460000015654201,
+	0x2460000008268301, 0x2460000024514141, 0x246000001fce4181, 0x246000001ba441c1,
+	0x246000001ba601c1, 0x246000001baec1c1, 0x2460000015ab4201, 0x2460000003ef83c1,
+	0x24600000245a0141, 0x246000001bb401c1, 0x246000001bc3c1c1, 0x246000000d804281,
+	0x24600000111d4241, 0x2460000024668141, 0x246000000d854281, 0x246000002005c188,
+	0x246000001bd701c8, 0x246000002471c141, 0x24600000267c8101, 0x2460000020224181,
+	0x246000002023c188, 0x246000001bfa01c1, 0x246000001bff41c1, 0x24600000162b4201,
+	0x24600000248ac141, 0x246000002035c181, 0x2460000016374208, 0x2460000024924141,
+	0x2460000020374188, 0x246000002494c141, 0x24600000063c4101, 0x246000001c2081c1,
+	0x246000000db74281, 0x24600000165b4201, 0x246000002059c181, 0x2460000000f800c8,
+	0x246000001184c141, 0x2460000020644181, 0x2460000020674181, 0x246000002068c181,
+	0x24600000048b00c1, 0x246000001c4fc1c1, 0x2460000008718308, 0x2460000024bb8141,
+	0x24600000021f00c1, 0x2460000006b64341, 0x2460000003fe00c1, 0x2460000017094201,
+	0x2460000026a28101, 0x2460000024eb0141, 0x2460000020f44181, 0x2460000020f5c181,
+	0x246000001cae41c1, 0x246000001cbe01c1, 0x2460000024f28141, 0x2460000004de40c1,
+	0x246000001cc6c1c1, 0x2460000011fc0241, 0x2460000011fe4241, 0x246000000e2f4281,
+	0x2460000001b94081, 0x2460000020174101, 0x2460000026b08101, 0x246000001cdf41c1,
+	0x2460000017434201, 0x246000001214c241, 0x2460000012170241, 0x246000001ce9c1c1,
+	0x2460000012194248, 0x24600000212bc181, 0x24600000212d4188, 0x2460000000374101,
+	0x246000000e484281, 0x2460000017574201, 0x2460000012248241, 0x246000002134c181,
+	0x2460000025220141, 0x2460000017654201, 0x246000000e59c281, 0x24600000009200c1,
+	0x246000002158c181, 0x246000001d1201c1, 0x24600000254c8141, 0x24600000179f4208,
+	0x246000002552c141, 0x246000001f1580c1, 0x2460000004e28101, 0x2460000012974248,
+	0x246000000ea4c288, 0x2460000010974141, 0x246000001d5f01c1, 0x2460000025680141,
+	0x2460000021994181, 0x2460000025748148, 0x2460000012a940c1, 0x2460000012a94241,
+	0x2460000009048301, 0x2460000009078301, 0x2460000017f54201, 0x246000001d83c1c1,
+	0x24600000180d4201, 0x246000001d8741c8, 0x246000000eb3c281, 0x246000000bc642c1,
+	0x2460000018114201, 0x2460000018134201, 0x2460000021c64181, 0x24600000258d8141,
+	0x246000000ec04281, 0x2460000025964141, 0x2460000001fa4081, 0x246000001dbd81c1,
+	0x2460000001614181, 0x2460000025aa4141, 0x246000001310c241, 0x2460000025ae0141,
+	0x24600000188f4208, 0x2460000025c0c141, 0x2460000013370241, 0x24600000225f4181,
+	0x2460000018bd4201, 0x2460000018bec0c1, 0x246000002266c181, 0x2460000022684181,
+	0x2460000013424141, 0x2460000013424241, 0x2460000013448241, 0x246000001e2841c1,
+	0x246000001e2f4108, 0x246000001e2f41c1, 0x24600000135f8241, 0x2460000025e8c141,
+	0x2460000018ed4208, 0x2460000025eb4141, 0x2460000025ec8141, 0x246000001361c241,
+	0x2460000000100088, 0x246000000e04c108, 0x24600000030400c8, 0x2460000000100081,
+	0x24600000075840c1, 0x24600000007500c1, 0x24600000021f00c1, 0x2460000004de40c1,
+	0x24600000243fc148, 0x24600000003d4081, 0x246000002390c0c1, 0x246000000013c0c1,
+	0x24600000075840c1, 0x24600000004040c1, 0x24600000007500c1, 0x2460000011878101,
+	0x2460000013458101, 0x246000000e04c101, 0x24600000021f00c1, 0x2460000004de40c1,
+	0x2460000003f480c1, 0x246000000b7c0101, 0x24600000270c00c1, 0x2460000005e2c0c1,
+	0x2460000025fa8101, 0x2460000019568181, 0x24600000004a00c1, 0x2460000009e7c148,
+	0x2460000019d2c1c8, 0x24600000120b80c1, 0x24600004146d4200, 0x24610000006e00c1,
+	0x246100000b8940c1, 0x24610000043180c1, 0x2461000000f800c1, 0x2461000025270141,
+	0x246100000013c0c1, 0x24610000075840c1, 0x24610000007500c1, 0x24610000021f00c1,
+	0x2461000004de40c1, 0x24610000092780c1, 0x2461000002d840c1, 0x2461000007488308,
+	0x2461000013e54208, 0x246100000013c0c1, 0x24610000075840c1, 0x24610000007500c1,
+	0x24610000013d40c1, 0x24610000021f00c1, 0x2461000004ad4101, 0x2461000004de40c1,
+	0x2461000003e24101, 0x2461000009e7c148, 0x2461000023880141, 0x2461000019d2c1c8,
+	0x2461000000100081, 0x2461000000954081, 0x2461000000750081, 0x24610000005a4081,
+	0x2461000000104081, 0x2461000026fe8108, 0x246100000013c0c1, 0x246100000a270101,
+	0x24610000075840c1, 0x24610000007500c1, 0x24610000048b00c1, 0x24610000021f00c1,
+	0x2461000004de40c1, 0x2461000013e54208, 0x246100000013c0c1, 0x24610000075840c1,
+	0x24610000007500c1, 0x24610000048b00c1, 0x246100000e04c101, 0x24610000021f00c1,
+	0x2461000004de40c1, 0x2461000000fb80c1, 0x24610000006f8101, 0x2461000013e54208,
+	0x246100000013c0c1, 0x2461000003fe00c1, 0x246100001ea980c8, 0x2461000026178108,
+	0x246100000326c101, 0x2461000009e7c148, 0x2461000013e54208, 0x2461000000100088,
+	0x246100000013c0c1, 0x2461000014ab4101, 0x2461000011878101, 0x2461000003fe00c1,
+	0x24610000000bc081, 0x2461000004de40c1, 0x2461000001fd00c1, 0x2461000004e28108,
+	0x2461000007a74141, 0x2461000001fa4081, 0x246100001e4c00c1, 0x24610000115c4248,
+	0x2461000020ac4188, 0x2461000018bf4208, 0x24610000003d4081, 0x2461000013e54208,
+	0x24610004198b01c0, 0x2462000000100081, 0x24620000075840c1, 0x24620000007500c1,
+	0x24620000003b0081, 0x2462000004de40c1, 0x2462000008b2c088, 0x24620000014e8088,
+	0x24620000191941c8, 0x24620000003d4088, 0x24620000192041c8, 0x246200000fa34248,
+	0x246200000c56c0c8, 0x2462000013e54208, 0x246200002718c0c8, 0x2462000419cd81c0,
+	0x246300000013c0c8, 0x24630000144f4208, 0x24630000075840c8, 0x246300001a3f41c8,
+	0x24630000007500c8, 0x246300001f5c4188, 0x24630000013d40c8, 0x2463000015834208,
+	0x24630000160f4208, 0x24630000274800c8, 0x24630000204f4188, 0x2463000424a00148,
+	0x2464000024a14148, 0x24640000048b00c8, 0x2464000016774208, 0x2464000016894208,
+	0x24640000177a00c8, 0x2464001020af4188, 0x2468000024b68148, 0x2468000026958108,
+	0x246800001c6681c8, 0x24680000021f00c8, 0x246800000c7fc0c8, 0x2468000004de40c8,
+	0x2468000000e08088, 0x246800000e4fc288, 0x2468000006be80c8, 0x2468000408bf8300,
+	0x24690000276840c8, 0x2469000002878108, 0x246900000bf7c2c8, 0x2469000018af4208,
+	0x246900000a388150, 0x246900000d04c110, 0x2469000014534210, 0x246900000a3a42c8,
+	0x2469000002ea8088, 0x2469000011924250, 0x2469000017194208, 0x246900001cf0c1c8,
+	0x24690000029a4108, 0x246900000ba3c1c8, 0x24690000003d4081, 0x2469000000100081,
+	0x246900000a270101, 0x24690000007500c1, 0x24690000048b00c1, 0x24690000021f00c1,
+	0x2469000004de40c1, 0x246900000013c0c1, 0x24690000075840c1, 0x24690000007500c1,
+	0x2469000000f800c1, 0x24690000021f00c1, 0x2469000004de40c1, 0x2469000003e240c1,
+	0x2469000000fb80c1, 0x246900000013c0c1, 0x24690000075840c1, 0x24690000007500c1,
+	0x24690000021f00c1, 0x2469000004de40c1, 0x246900000013c0c1, 0x24690000075840c1,
+	0x24690000007500c1, 0x24690000021f00c1, 0x2469000004de40c1, 0x246900001ea980c8,
+	0x24690000075840c8, 0x24690004007500c8, 0x246a00000013c0c1, 0x246a0000075840c1,
+	0x246a0000007500c1, 0x246a000011878101, 0x246a000000f800c1, 0x246a0000021f00c1,
+	0x246a000004de40c1, 0x246a000004e7c081, 0x246a00000c134288, 0x246a0000000f0041,
+	0x246a0000003d4081, 0x246a00000025c041, 0x246a000014570081, 0x246a000013e54208,
+	0x246a0000026bc141, 0x246a000000084041, 0x246a00000013c0c8, 0x246a00000b278108,
+	0x246a0000000b4041, 0x246a0000000a4041, 0x246a0000000e8041, 0x246a00000a6bc081,
+	0x246a000026328101, 0x246a0000272a00c1, 0x246a00000009c041, 0x246a000000088041,
+	0x246a00000008c041, 0x246a00001fb4c188, 0x246a000008238308, 0x246a000000878041,
+	0x246a00001bcac1c1, 0x246a000003f703c1, 0x246a00001ffcc181, 0x246a000000134041,
+	0x246a00002017c181, 0x246a000000144041, 0x246a000008718308, 0x246a0000000a8041,
+	0x246a000005500381, 0x246a000000104041, 0x246a000004de40c1, 0x246a000000174041,
+	0x246a00041803c100, 0x246b000025068141, 0x246b000000e08081, 0x246b0000033e0141,
+	0x246b000000090041, 0x246b0000000ac041, 0x246b0000000c0041, 0x246b000001fa4081,
+	0x246b000000094041, 0x246b000000254041, 0x246b0000012b8041, 0x246b000000ad8041,
+	0x246b000000390041, 0x246b000011678248, 0x246b000013e54208, 0x246b00000013c0c1,
+	0x246b0000075840c1, 0x246b0000148f4208, 0x246b0000007500c1, 0x246b0000021f00c1,
+	0x246b000004de40c1, 0x246b000000a680c1, 0x246b00002712c0c8, 0x246b00000013c0c1,
+	0x246b0000007500c1, 0x246b000015034208, 0x246b0000048b00c1, 0x246b0000021f00c1,
+	0x246b0000009440c8, 0x246b000004de40c1, 0x246b001005fb0200, 0x246f0000277800c8,
+	0x246f000018c14208, 0x246f000009e7c148, 0x246f00000a800108, 0x246f00001f984188,
+	0x246f000013e54208, 0x246f00001f48c188, 0x246f00001af001c8, 0x246f000009e7c148,
+	0x246f000026128108, 0x246f000014394210, 0x246f0000261e8108, 0x246f000004e701c8,
+	0x246f0000263a8108, 0x246f000007ae8308, 0x246f000008268108, 0x246f00002480c148,
+	0x246f000011678248, 0x246f00001c4001c8, 0x246f000017074208, 0x246f000020f74188,
+	0x246f00000e2a4288, 0x246f00000e3e4290, 0x246f000026d08108, 0x246f0000070dc0d0,
+	0x246f000013e54208, 0x246f00000013c0c1, 0x246f0000075840c1, 0x246f0000007500c1,
+	0x246f0000021f00c1, 0x246f000004de40c1, 0x246f000002ca00c1, 0x246f000013e54208,
+	0x246f00000013c0c1, 0x246f0000075840c1, 0x246f000026468101, 0x246f000004de40c1,
+	0x246f000000a68141, 0x246f00000ee5c101, 0x246f00000013c0c1, 0x246f0000075840c1,
+	0x246f0000007500c1, 0x246f000000200081, 0x246f0000021f00c1, 0x246f000004de40c1,
+	0x246f000000fb80c8, 0x246f000023c7c150, 0x246f000020adc188, 0x246f00000ad7c108,
+	0x246f0000226cc188, 0x246f00002390c0c1, 0x246f00000013c0c1, 0x246f0000075840c1,
+	0x246f0000007500c1, 0x246f000000200081, 0x246f0000021f00c1, 0x246f000004de40c1,
+	0x246f000000fb80c1, 0x246f000000100081, 0x246f00000013c0c1, 0x246f00000fed8241,
+	0x246f0000075840c1, 0x246f00001013c241, 0x246f0000048b00c1, 0x246f0000021f00c1,
+	0x246f000020df4108, 0x246f000004de40c1, 0x246f000017514201, 0x246f00001d28c1c1,
+	0x246f000007a74141, 0x246f00002179c188, 0x246f000017cf4208, 0x246f000012c44248,
+	0x246f000013994208, 0x246f0000192041c8, 0x246f00000c29c288, 0x246f000009dcc2c8,
+	0x246f0000193fc1c8, 0x246f000013b94208, 0x246f00000c3dc288, 0x246f000013cd4208,
+	0x246f00000fa34248, 0x246f0000199201c8, 0x246f0000199581c8, 0x246f000014274208,
+	0x246f0000144f4208, 0x246f00000382c3c8, 0x246f000023df8148, 0x246f00001ae741c8,
+	0x246f00001f5c4188, 0x246f00001b76c1c8, 0x246f00001fbac188, 0x246f000015834208,
+	0x246f00001fcfc188, 0x246f00001b9b81c8, 0x246f000010ee0248, 0x246f00001b9f01c8,
+	0x246f000011090248, 0x246f000011288248, 0x246f0000200d4188, 0x246f0000160f4208,
+	0x246f000024820148, 0x246f00000dc14288, 0x246f000016894208, 0x246f0000177a00c8,
+	0x246f000016a34208, 0x246f00001c6681c8, 0x246f000024c94148, 0x246f000002e7c408,
+	0x246f00000c7fc0c8, 0x246f00001c9941c8, 0x246f0000250cc148, 0x246f000017454208,
+	0x246f0000256e4148, 0x246f0000276840c8, 0x246f000018474208, 0x246f000021ea4188,
+	0x246f000012f5c248, 0x246f00001dd0c1c8, 0x246f00000bdf02c8, 0x246f000026e18108,
+	0x246f000026e38108, 0x246f00000bf7c2c8, 0x246f000018af4208, 0x246f00001e0541c8,
+	0x246f000009e7c148, 0x246f00000013c0c1, 0x246f0000075840c1, 0x246f0000239040c1,
+	0x246f000004de40c1, 0x246f00000121c0c1, 0x246f0000007500c1, 0x246f00000013c0c1,
+	0x246f0000075840c1, 0x246f0000007500c1, 0x246f0000048b00c1, 0x246f0000021f00c1,
+	0x246f000004de40c1, 0x246f00000af282c8, 0x246f0000003d4081, 0x246f000000100081,
+	0x246f000004de40c1, 0x246f000000084088, 0x246f000000200088, 0x246f000000254088,
+	0x246f000013e54208, 0x246f00000ff44248, 0x246f000015674208, 0x246f000000100088,
+	0x246f000000fb80c8, 0x246f0000003d4081, 0x246f000000100081, 0x246f000000750081,
+	0x246f0000003b0081, 0x246f000000468081, 0x246f0000021f00c1, 0x246f000005638188,
+	0x246f000000104081, 0x246f000004e28108, 0x246f0000003d4081, 0x246f00002390c0c1,
+	0x246f000000100081, 0x246f00000013c0c1, 0x246f0000075840c1, 0x246f000000750081,
+	0x246f0000007500c1, 0x246f0000013d40c1, 0x246f0000048b00c1, 0x246f00000e04c101,
+	0x246f0000021f00c1, 0x246f00000014c0c1, 0x246f000004de40c1, 0x246f000002878101,
+	0x246f0000002540c1, 0x246f0000007500c1, 0x246f000000100081, 0x246f00000013c0c1,
+	0x246f0000075840c1, 0x246f0000007500c1, 0x246f0000048b00c1, 0x246f0000021f00c1,
+	0x246f000003fe00c1, 0x246f000004de40c1, 0x246f00000013c0c1, 0x246f0000000a40c1,
+	0x246f0000005e80c1, 0x246f0000007500c1, 0x246f00000042c0c1, 0x246f000011878101,
+	0x246f00000e6bc101, 0x246f00002074c181, 0x246f000000aa40c1, 0x246f0000021f00c1,
+	0x246f00000b5582c8, 0x246f000004de40c1, 0x246f000000a68141, 0x246f00001dbd81c1,
+	0x246f0000270b40c8, 0x246f00000013c0c1, 0x246f0000075840c1, 0x246f0000007500c1,
+	0x246f0000048b00c1, 0x246f0000021f00c1, 0x246f000026a08108, 0x246f000004de40c1,
+	0x246f000002f400c8, 0x246f000408b38300, 0x247000000efe40c8, 0x247000001e0701c8,
+	0x2470000008aa8308, 0x247000001c9401c8, 0x2470000024d98148, 0x2470000001458081,
+	0x2470000017a680c1, 0x24700000070600c1, 0x247000002390c0c1, 0x247000040013c0c1,
+	0x247100000048c081, 0x24710000075840c1, 0x24710000018fc0c1, 0x24710000007500c1,
+	0x2471000011878101, 0x24710000274440c1, 0x24710000245a00c1, 0x24710000048b00c1,
+	0x247100000e04c101, 0x24710004000f4081, 0x24720000021f00c1, 0x2472000004de40c1,
+	0x24720000074d00c1, 0x247200000012c0c1, 0x24720000083740c1, 0x2472000004e7c081,
+	0x24720000002540c1, 0x247200001ea980c8, 0x247200000f494101, 0x247200002390c0c1,
+	0x2472000000100081, 0x247200000013c0c1, 0x247200000a270101, 0x24720000075840c1,
+	0x24720000007500c1, 0x2472000011878101, 0x24720000013d40c1, 0x2472000026608101,
+	0x2472000001518101, 0x2472000000adc181, 0x247200000e04c101, 0x24720000021f00c1,
+	0x2472000004de40c1, 0x2472000003f480c1, 0x2472000001614181, 0x2472000009f582c8,
+	0x2472000004d58188, 0x247200000e27c288, 0x247200000f12c288, 0x2472000013e54208,
+	0x2472000013a04101, 0x247200040013c0c1, 0x2473000026298101, 0x24730000075840c1,
+	0x24730000025b8101, 0x24730000007500c1, 0x24730000273b40c1, 0x24730000048b00c1,
+	0x24730000021f00c1, 0x2473000004de40c1, 0x247300000ea400c8, 0x2473000022da4181,
+	0x2473000023284181, 0x24730000232e4181, 0x24730000003d4081, 0x2473000000100081,
+	0x2473000000750081, 0x2473000003cdc141, 0x2473000011878101, 0x2473000000200081,
+	0x24730000048b00c1, 0x2473000001518101, 0x24730000005a4081, 0x2473000000104081,
+	0x2473000000fb8081, 0x2473000004e7c081, 0x247300002390c0c8, 0x24730000003d8088,
+	0x247300000fd28241, 0x24730000141d4201, 0x247300000fd4c241, 0x247300000fd70241,
+	0x247300000c7c4281, 0x247300000c7ec281, 0x24730000021d4081, 0x2473000004bd0081,
+	0x2473000000100088, 0x247300000013c0c1, 0x2473000005754081, 0x247300001ed84181,
+	0x2473000026ef4081, 0x24730000275e0088, 0x2473000003820081, 0x2473000004ce8381,
+	0x2473000004d20381, 0x247300001a0e41c1, 0x2473000005fac081, 0x24730000075840c1,
+	0x24730000007500c1, 0x2473000002ce8081, 0x24730000003b0081, 0x24730000029140c8,
+	0x2473000003ebc3c1, 0x24730000021d8081, 0x247300001bb5c1c1, 0x247300001bb781c1,
+	0x247300001bb941c1, 0x2473000008358301, 0x2473000008388301, 0x2473000026698101,
+	0x247300000d764281, 0x247300000dc38081, 0x2473000008418081, 0x2473000026748101,
+	0x2473000000cb4081, 0x247300000d91c081, 0x2473000026798101, 0x24730000034c8081,
+	0x24730000003a4081, 0x247300001d2080c8, 0x247300001c1441c1, 0x2473000024974141,
+	0x24730000018e0081, 0x2473000026838101, 0x2473000014280081, 0x2473000016ad4201,
+	0x24730000021f00c1, 0x2473000016e74201, 0x2473000001f70081, 0x2473000024e10141,
+	0x2473000020ecc181, 0x2473000004de40c1, 0x2473000002030081, 0x247300001ce801c1,
+	0x2473000000e08088, 0x24730000252fc141, 0x2473000025360141, 0x247300000022c081,
+	0x24730000009a0081, 0x247300000e81c281, 0x247300000e90c281, 0x2473000002d04081,
+	0x2473000026d28101, 0x24730000000cc081, 0x24730000184f4201, 0x2473000006d78081,
+	0x2473000018994201, 0x24730000001ec048, 0x247300001e0001c1, 0x2473000013304241,
+	0x247300000d84c081, 0x2473000025d10141, 0x2473000025e00141, 0x247300000c0dc2c1,
+	0x2473000009618301, 0x2473000018f14201, 0x2473000018f34201, 0x247300001a430081,
+	0x247300000aed8081, 0x24730000003d4081, 0x2473000013e54208, 0x2473000000100081,
+	0x247300000013c0c1, 0x2473000000750081, 0x24730000005a4081, 0x2473000000104081,
+	0x2473000004de40c1, 0x2473000000fb8081, 0x24730000003d4081, 0x2473000009e7c148,
+	0x2473002c00100081, 0x247e000008b4c108, 0x247e000023b14148, 0x247e0010007500c1,
+	0x2482000015374108, 0x248200000042c588, 0x2482000000ee84c8, 0x2482000000f344c8,
+	0x2482000001e54448, 0x248200000138c488, 0x248200001d2080c1, 0x2482000000200081,
+	0x24820000021f00c1, 0x24820000274d40c1, 0x2482001804de40c1, 0x24880000275640c1,
+	0x2488000021274181, 0x2488000005570388, 0x248800002520c148, 0x2488000002f3c408,
+	0x2488000000fb80c2, 0x2488000c00874081, 0x248b000c00210081, 0x248e000c004a4081,
+	0x2491000c00298081, 0x2494000c02e98081, 0x2497000c00154081, 0x249a000019d2c1c8,
+	0x249a000c00100081, 0x249d000c01fb4081, 0x24a0000c0365c081, 0x24a3000c000b4081,
+	0x24a6000004d200c1, 0x24a6000026258108, 0x24a60004148f4200, 0x24a70000028fc0c1,
+	0x24a7000c036d8081, 0x24aa000014584208, 0x24aa000c00414081, 0x24ad00001affc1c8,
+	0x24ad00001b0dc1c8, 0x24ad000c028a8081, 0x24b0000800088081, 0x24b2000c00494081,
+	0x24b5000c003e0081, 0x24b8000c00988081, 0x24bb000c003b0081, 0x24be000024438148,
+	0x24be0000050fc0c1, 0x24be00001d97c101, 0x24be000c00cb4081, 0x24c1000c053bc081,
+	0x24c4000c00608081, 0x24c700000afd82c8, 0x24c7000c00880081, 0x24ca000c0e02c081,
+	0x24cd000c00200081, 0x24d0002c00468081, 0x24db000016774208, 0x24db000c05530081,
+	0x24de000c00628081, 0x24e1000c0099c081, 0x24e4000c07190081, 0x24e7000c000f4081,
+	0x24ea000800190081, 0x24ec000c005a4081, 0x24ef000c00f98081, 0x24f2000c0797c081,
+	0x24f5000c001fc081, 0x24f80000269b8108, 0x24f800001c9540c1, 0x24f8000c001e8081,
+	0x24fb000c02d2c081, 0x24fe000c04ff4081, 0x2501000c03d34081, 0x2504000c00104081,
+	0x2507000c006d0081, 0x250a0000173f4208, 0x250a00001ce2c1c8, 0x250a000c00374081,
+	0x250d000800378081, 0x250f000c00fb8081, 0x2512000801268081, 0x2514000009108308,
+	0x2514000c040d4081, 0x2517000c1ac58081, 0x251a000c002ec081, 0x251d000c00230081,
+	0x2520000c001ec081, 0x2523000c060dc081, 0x2526000c0294c081, 0x2529000c00984081,
+	0x252c00041ad00081, 0x252d000c0acb0081, 0x253000040013c0c1, 0x25310000075840c1,
+	0x25310000131980c1, 0x25310000048b00c1, 0x25310000021f00c1, 0x2531000004de40c1,
+	0x2531000000100081, 0x253100000013c0c1, 0x25310000021f00c1, 0x2531000004de40c1,
+	0x2531000025f18088, 0x253100000013c0c1, 0x25310000075840c1, 0x25310000007500c1,
+	0x2531000409404088, 0x25320000048b00c1, 0x25320000021f00c1, 0x2532000004de40c1,
+	0x253200000326c101, 0x253200000cf5c0c1, 0x2532000000100081, 0x253200000013c0c1,
+	0x2532000009c100c1, 0x25320000075840c1, 0x2532000014ab4101, 0x25320000239040c1,
+	0x25320000007500c1, 0x2532000011878101, 0x25320000013d40c1, 0x25320000048b00c1,
+	0x25320000021f00c1, 0x2532000003fe00c1, 0x2532000004de40c1, 0x25320000028e40c1,
+	0x2532000001fd00c1, 0x2532000007a74141, 0x253200000752c0c1, 0x25320000002540c1,
+	0x25320000001cc088, 0x2532000000100081, 0x253200000013c0c1, 0x25320000274440c1,
+	0x25320000021f00c1, 0x2532000004de40c1, 0x25320000003d4081, 0x253200002390c0c1,
+	0x2532000013e54208, 0x253200000013c0c1, 0x25320000075840c1, 0x25320000007500c1,
+	0x2532000000398181, 0x2532000011878101, 0x25320000013d40c1, 0x253200000e04c101,
+	0x25320000021f00c1, 0x2532000004de40c1, 0x2532000003f480c1, 0x253200000289c108,
+	0x2532000004bd0088, 0x253200000013c0c1, 0x25320000037b40c8, 0x25320000075840c1,
+	0x2532000000200088, 0x25320000021f00c1, 0x2532000004de40c1, 0x2532000007ba8188,
+	0x253200002390c0c8, 0x2532000000fb80c8, 0x2532000007ba8188, 0x253200000060c1d0,
+	0x2532000019d101c8, 0x253200000013c0c1, 0x2532000004d58188, 0x25320000075840c1,
+	0x25320000007500c1, 0x2532000020b0c188, 0x25320000021f00c1, 0x2532000004de40c1,
+	0x2532000013e34208, 0x2532000014494208, 0x2532000011654248, 0x253200001d98c1d0,
+	0x2532000025ea0148, 0x253200000013c0c1, 0x25320000075840c1, 0x25320000007500c1,
+	0x25320000048b00c1, 0x25320000021f00c1, 0x2532000004de40c1, 0x2532000004de40c8,
+	0x25320000003d4081, 0x2532000009cc4141, 0x25320000003a00c1, 0x2532000400100081,
+	0x25330000075840c1, 0x25330000007500c1, 0x253300001b1301c1, 0x25330000043180c1,
+	0x25330000048b00c1, 0x25330000021f00c1, 0x253300000a0940c1, 0x253300000014c0c1,
+	0x2533000002c380c1, 0x2533000003fe00c1, 0x2533000004de40c1, 0x2533000012518181,
+	0x2533000001fa4081, 0x25330000002540c1, 0x25330000003d4081, 0x253300002390c0c1,
+	0x2533000000100081, 0x253300000013c0c1, 0x25330000075840c1, 0x25330000007500c1,
+	0x2533000011878101, 0x25330000048b00c1, 0x25330000021f00c1, 0x2533000004de40c1,
+	0x2533000000fb80c1, 0x2533000019d101c8, 0x2533000002ea8088, 0x25330000115c4108,
+	0x253300002212c190, 0x25330000003d4081, 0x2533000000100081, 0x25330000007500c1,
+	0x25330000048b00c1, 0x2533000004de40c1, 0x253300002296c188, 0x2533000018fdc188,
+	0x2533000018ff4208, 0x2533000022984188, 0x25330000229cc188, 0x25330000229fc188,
+	0x2533000009738308, 0x2533000019034208, 0x2533000022a2c188, 0x2533000027880088,
+	0x2533000027058101, 0x25330000229e4181, 0x2533000027088101, 0x25330000229fc181,
+	0x2533000022a7c101, 0x2533000022a74181, 0x2533000009768301, 0x2533000019054201,
+	0x2533000019074201, 0x2533000022abc181, 0x2533000009a38301, 0x2533000001a04481,
+	0x2533000001a4c481, 0x25330000047683c1, 0x2533000001a94481, 0x2533000009a68301,
+	0x2533000022c84181, 0x2533000022ccc181, 0x253300002305c181, 0x253300002308c181,
+	0x2533000023284181, 0x25330000232b4181, 0x25330000000f0048, 0x253300000b7682c8,
+	0x2533000013e54208, 0x25330000058cc0c1, 0x2533000013e54208, 0x2533000026f78108,
+	0x25330000012b4090, 0x25330000003b0090, 0x25330000012b4090, 0x253300002008c190,
+	0x2533000013e54208, 0x253300040fe6c240, 0x2534000008718308, 0x2534000001fb00c1,
+	0x25340000141b4201, 0x25340004275040c1, 0x25350000000bc081, 0x25350000275e80c1,
+	0x2535000000b70081, 0x25350000060e40c1, 0x25350000049fc0c1, 0x253500000294c081,
+	0x25350000275e80c1, 0x2535000000b70081, 0x25350000060e40c1, 0x25350000049fc0c1,
+	0x253500000294c081, 0x2535000001b98148, 0x253500001a42c1c8, 0x2535000013e54208,
+	0x253500000ea4c288, 0x2535000409498300, 0x25360000003d4081, 0x2536000000210081,
+	0x253600000087c081, 0x2536000000274081, 0x25360000004a0081, 0x25360000000f8081,
+	0x2536000002938081, 0x25360000000d0081, 0x2536000000750081, 0x2536000000880081,
+	0x2536000014e70081, 0x253600000099c081, 0x2536000007190081, 0x25360000006d0081,
+	0x2536000007694081, 0x253600000043c081, 0x25360000035e0081, 0x2536000000374081,
+	0x25360000040c4081, 0x2536000000aa0081, 0x25360000001f4081, 0x2536000000108081,
+	0x2536000000a70081, 0x2536000000fb8081, 0x25360000001e0081, 0x2536000000170081,
+	0x2536000000908081, 0x25360000003d4088, 0x2536000000210088, 0x253600000087c088,
+	0x2536000000274088, 0x25360000004a0088, 0x25360000000f8088, 0x2536000002938088,
+	0x25360000000d0088, 0x2536000000750088, 0x2536000000880088, 0x2536000014e70088,
+	0x253600000099c088, 0x2536000007190088, 0x25360000006d0088, 0x2536000007694088,
+	0x253600000043c088, 0x25360000035e0088, 0x2536000000374088, 0x25360000040c4088,
+	0x2536000000aa0088, 0x25360000001f4088, 0x2536000000108088, 0x2536000000a70088,
+	0x2536000000fb8088, 0x25360000001e0088, 0x2536000000170088, 0x2536000000908088,
+	0x2536000013e54208, 0x2536000000f70088, 0x2536000010b5c248, 0x25360000276a80d0,
+	0x2536000401474080, 0x253700000f764248, 0x25370000262a8108, 0x25370000006a0088,
+	0x253700000efec0c8, 0x253700040d4d0140, 0x25380000273cc0c8, 0x2538000003d7c088,
+	0x25380000040d4088, 0x253800000536c088, 0x253800000536c088, 0x2538000000154088,
+	0x253800000536c088, 0x25380000006a4088, 0x2538000c0fa10240, 0x253b001414b94180,
+	0x2540000810b5c240, 0x25420000274500c8, 0x2542001016f34180, 0x2546000c21244180,
+	0x25490000124f4248, 0x2549000007044348, 0x2549000000084088, 0x25490000000b4088,
+	0x254900100f5d8240, 0x254d000001fc8188, 0x254d000013e54208, 0x254d0000003e0088,
+	0x254d0000003e0088, 0x254d0000037b40d0, 0x254d00040c1d4280, 0x254e00040f71c240,
+	0x254f000800274380, 0x25510010020c4380, 0x25550004048c0380, 0x2556001006de0280,
+	0x255a0008002d8380, 0x255c00080033c380, 0x255e0010041d4300, 0x256200000373c1d0,
+	0x256200000feb4250, 0x25620000118980d0, 0x2562001004210300, 0x256600040cd64280,
+	0x256700040cd8c280, 0x2568000800b20240, 0x256a001008cc4240, 0x256e001008cf4240,
+	0x257200040dcdc280, 0x2573000000248088, 0x2573000002074448, 0x25730000020b8448,
+	0x2573000006dd4348, 0x25730000020fc448, 0x2573000002140448, 0x25730000041c83c8,
+	0x25730000042043c8, 0x2573000008c88308, 0x2573000008cb8308, 0x2573000008ce8308,
+	0x2573000006e08348, 0x2573000000724548, 0x2573000008d18308, 0x2573000008d48308,
+	0x2573000002f7c408, 0x2573000008d78308, 0x2573000008da8308, 0x2573000000248648,
+	0x25730000002ac648, 0x2573000000310648, 0x2573000000af4508, 0x2573000000b44508,
+	0x2573000000b94508, 0x2573000000be4508, 0x2573000000c34508, 0x2573000800b70240,
+	0x2575000800bc0248, 0x2577001008d54240, 0x257b000400c10240, 0x257c000400c60240,
+	0x257d000000090050, 0x257d000001b68148, 0x257d00000c4f4108, 0x257d00000a3881d0,
+	0x257d00000a3782d0, 0x257d00000bbb42d0, 0x257d0000007040d0, 0x257d0000275100d0,
+	0x257d00000b8bc0d0, 0x257d000006630108, 0x257d000010b5c208, 0x257d000000274388,
+	0x257d0000020c4388, 0x257d0000048c0388, 0x257d000006de0288, 0x257d0000002d8388,
+	0x257d00000033c388, 0x257d0000041d4308, 0x257d000004210308, 0x257d000000b20248,
+	0x257d000008cc4248, 0x257d000008cf4248, 0x257d000000b70248, 0x257d000000bc0248,
+	0x257d000008d54248, 0x257d000000744348, 0x257d000000c10248, 0x257d000000c60248,
+	0x257d0000262b8108, 0x257d0000262c8108, 0x257d0000262d8108, 0x257d0000262e8108,
+	0x257d000026e98108, 0x257d000026ea8108, 0x257d000026eb8108, 0x257d000026ec8108,
+	0x257d000000e04108, 0x257d00001e178108, 0x257d000008b98248, 0x257d000008b98308,
+	0x257d0000052d0188, 0x257d0000052d0388, 0x257d000006630108, 0x257d0000271e00d0,
+	0x257d00001c3901c8, 0x257d000008928310, 0x257d000000734088, 0x257d000000e040c8,
+	0x257d00001e178108, 0x257d0000006a0088, 0x257d0000199e41c8, 0x257d000007938208,
+	0x257d000014a14208, 0x257d000026848108, 0x257d000020eb4188, 0x257d00001cb701c8,
+	0x257d00000062c108, 0x257d000021a3c188, 0x257d000003eec088, 0x257d0000012b8048,
+	0x257d00001635c108, 0x257d000001b98148, 0x257d000003444188, 0x257d0000277c80c8,
+	0x257d0000006a0088, 0x257d000000e040c8, 0x257d0000052840c8, 0x257d000000260108,
+	0x257d000003f480c8, 0x257d0000273fc0c8, 0x257d00000096c0c8, 0x257d00000ff840c8,
+	0x257d0000014f4148, 0x257d00000bc7c088, 0x257d000018834088, 0x257d000018834208,
+	0x257d000013e54208, 0x257d00040e7a4280, 0x257e000001b98150, 0x257e000007938188,
+	0x257e000801b98140, 0x25800000037f00c8, 0x25800000037f00c8, 0x2580000004d58188,
+	0x25800000037f00c8, 0x2580000400264080, 0x2581000007938208, 0x25810000006a4110,
+	0x258100002032c188, 0x25810000271740d0, 0x2581000010598248, 0x2581000013e54208,
+	0x2581000013e54208, 0x2581000013e54208, 0x2581000015674208, 0x25810000006a4108,
+	0x25810000005e8088, 0x2581000006630108, 0x258100001e178108, 0x258100001edb4188,
+	0x2581000013e54208, 0x25810000056b80d0, 0x2581000013e54208, 0x258100001c5f81c8,
+	0x258100001cf441c8, 0x258100000eb64288, 0x258100001d2080c1, 0x25810000275640c1,
+	0x2581000000e040c8, 0x258100000f95c250, 0x258100001e178108, 0x2581000025540148,
+	0x25810000277140c8, 0x2581000004d58188, 0x25810000003e0088, 0x2581000000e04108,
+	0x258100000c8a0148, 0x25810000268d8108, 0x258100000415c0d0, 0x25810000049f41c8,
+	0x25810008037b40c0, 0x258300081ef04180, 0x258500040602c100, 0x258600081d9fc1c0,
+	0x258800000fe24248, 0x258800000009c048, 0x25880000052d0088, 0x258800040ca20100,
+	0x258900002363c141, 0x258900000087c0c1, 0x2589000024c20101, 0x2589000023768141,
+	0x258900001ebec181, 0x2589000023ab0141, 0x25890000263e8101, 0x2589000014e14201,
+	0x2589000024208141, 0x25890000195f0101, 0x258900001b30c1c1, 0x258900000d1ec281,
+	0x258900000d304281, 0x258900001b5ac1c1, 0x258900001b6001c1, 0x258900001b7181c1,
+	0x258900001b7dc1c1, 0x2589000024550141, 0x258900001fd74181, 0x258900001bad01c1,
+	0x2589000015bfc101, 0x258900001ff54181, 0x258900001ff84181, 0x2589000024654141,
+	0x2589000017ba0101, 0x25890000206bc181, 0x2589000002ae01c1, 0x258900000b3cc181,
+	0x258900001c8601c1, 0x25890000140100c1, 0x2589000020ee4181, 0x2589000024e74141,
+	0x258900001ca581c1, 0x258900000e344281, 0x2589000014f24101, 0x25890000088a0201,
+	0x2589000012854241, 0x258900001d5101c1, 0x2589000021d0c181, 0x25890000181d4201,
+	0x2589000012f14241, 0x2589000026dd8101, 0x2589000026de8101, 0x2589000025a04141,
+	0x2589000018594201, 0x258900001dbf41c1, 0x2589000013034241, 0x2589000018674201,
+	0x2589000022054181, 0x2589000022084181, 0x2589000001dac201, 0x2589000022804181,
+	0x2589000006854141, 0x258900001edcc181, 0x2589000014c94201, 0x258900001f54c181,
+	0x258900000aa2c2c1, 0x258900001f714181, 0x258900000654c341, 0x25890000135e4141,
+	0x258900001f96c181, 0x2589000003a90141, 0x2589000010e98241, 0x258900001b92c1c1,
+	0x2589000015a94201, 0x258900001fe64181, 0x2589000011144241, 0x258900001fffc181,
+	0x2589000024758141, 0x25890000050f8181, 0x258900000de04181, 0x2589000011af8241,
+	0x2589000020d4c181, 0x258900001c9241c1, 0x2589000012744141, 0x2589000003c500c1,
+	0x258900000aa44141, 0x258900001d3c01c1, 0x258900002284c181, 0x25890000135d4241,
+	0x258900001e6f4181, 0x258900001f57c181, 0x25890000106b8241, 0x2589000010700241,
+	0x258900001b3441c1, 0x25890000151b4201, 0x2589000010c34241, 0x25890000160b4201,
+	0x25890000207dc181, 0x2589000011b64141, 0x258900000dffc281, 0x2589000020ddc181,
+	0x2589000020f14181, 0x2589000024fb4141, 0x2589000017774201, 0x258900001d2541c1,
+	0x258900000e95c281, 0x258900002182c181, 0x25890000258c4141, 0x2589000022024181,
+	0x258900001ddb41c1, 0x258900001de241c1, 0x25890000235d8141, 0x258900000e358141,
+	0x258900001ec04181, 0x258900001ec1c181, 0x258900001ec34181, 0x258900000bb20101,
+	0x2589000010454241, 0x258900001f354181, 0x258900000d14c281, 0x25890000153d4201,
+	0x25890000050d8201, 0x258900000d304281, 0x2589000024410141, 0x2589000002ad4141,
+	0x2589000015854201, 0x25890000159b4201, 0x258900000ae781c1, 0x25890000182dc181,
+	0x2589000006b44201, 0x258900001bbe81c1, 0x2589000015c94201, 0x2589000020044181,
+	0x2589000015f34201, 0x2589000020134181, 0x258900001c3201c1, 0x258900002065c181,
+	0x25890000206bc181, 0x258900000dda4281, 0x2589000020914181, 0x2589000011b64241,
+	0x2589000020b6c181, 0x258900000dfd4281, 0x2589000011c84241, 0x2589000020c44181,
+	0x2589000011298101, 0x2589000006bcc341, 0x258900001cb001c1, 0x2589000020fd4181,
+	0x25890000160e0141, 0x258900002543c141, 0x258900000e6dc181, 0x2589000012758241,
+	0x258900001d4d81c1, 0x258900000d3b8181, 0x258900002188c181, 0x2589000012a4c241,
+	0x2589000015cbc101, 0x2589000024450101, 0x2589000018494201, 0x2589000021f34181,
+	0x2589000018554201, 0x25890000185d4201, 0x258900001dee81c1, 0x258900001346c241,
+	0x258900001e32c1c1, 0x258900000f21c281, 0x25890000046003c1, 0x258900000f244281,
+	0x258900001fca0141, 0x25890000218a8141, 0x2589000011d90141, 0x258900001b53c1c1,
+	0x2589000002ae40c1, 0x2589000006c7c201, 0x258900001feac181, 0x25890000112d0241,
+	0x2589000020524181, 0x258900001c33c1c1, 0x2589000002a58241, 0x2589000016cd4201,
+	0x258900001c8281c1, 0x2589000026e6c0c1, 0x2589000025400141, 0x2589000025518141,
+	0x258900000bb042c1, 0x258900000fabc101, 0x2589000002ca8101, 0x2589000003aa8181,
+	0x258900001df3c1c1, 0x258900000f1f4281, 0x2589000006a101c1, 0x258900001a2881c1,
+	0x2589000023fb0141, 0x2589000024398141, 0x2589000011024241, 0x25890000206bc181,
+	0x25890000069f8341, 0x2589000024dd4141, 0x2589000004ff40c1, 0x258900000a3080c1,
+	0x25890000253d8141, 0x258900000b94c141, 0x25890000181d4201, 0x25890000092881c1,
+	0x2589000010f44181, 0x258900000658c181, 0x25890000239c0141, 0x2589000019ba41c1,
+	0x258900000fdb81c1, 0x2589000019bc01c1, 0x258900000c814281, 0x2589000014234201,
+	0x258900000bb20101, 0x2589000019fe81c1, 0x258900000a9501c1, 0x258900001f63c181,
+	0x2589000001d881c1, 0x2589000015194201, 0x25890000151d4201, 0x258900001fa8c181,
+	0x2589000015414201, 0x258900000ab2c101, 0x258900001bad0181, 0x258900001fda4181,
+	0x258900001fe04181, 0x258900001fe7c181, 0x258900000e088101, 0x25890000200bc181,
+	0x2589000020104181, 0x2589000006534181, 0x2589000003fe8181, 0x258900000de6c181,
+	0x2589000003ff8181, 0x2589000016794201, 0x25890000167d4201, 0x25890000169f4201,
+	0x2589000016bd4201, 0x2589000020be4181, 0x2589000008868141, 0x2589000020dc4181,
+	0x2589000024e4c141, 0x258900001ca201c1, 0x258900000d5e8141, 0x2589000004c480c1,
+	0x2589000024eec141, 0x258900001ae18101, 0x258900000de80141, 0x2589000001ef80c1,
+	0x258900001d1901c1, 0x2589000017974201, 0x2589000021844181, 0x2589000006fdc341,
+	0x25890000218a4181, 0x25890000256f8141, 0x25890000011200c1, 0x2589000012d88241,
+	0x2589000015d1c181, 0x2589000006d88181, 0x258900001f94c101, 0x258900001dc2c1c1,
+	0x25890000221a4181, 0x2589000025b44141, 0x2589000002adc0c1, 0x2589000025834101,
+	0x2589000022774181, 0x25890000033b4101, 0x2589000018d74201, 0x25890000135b0241,
+	0x258900000f500241, 0x258900000c24c281, 0x2589000005bf4341, 0x25890000194341c1,
+	0x258900001e8bc181, 0x25890000051e0101, 0x2589000006834241, 0x2589000014db4201,
+	0x258900001f324181, 0x258900001f6cc181, 0x2589000001d881c1, 0x25890000128c4181,
+	0x258900001c930181, 0x258900001fa5c181, 0x258900000d3a4281, 0x2589000011da8201,
+	0x2589000011e20141, 0x2589000010cc4241, 0x258900000d584281, 0x2589000015a14201,
+	0x2589000015b34201, 0x2589000015d74201, 0x25890000083b8301, 0x258900002467c141,
+	0x2589000005908201, 0x25890000200a4181, 0x25890000206d4181, 0x258900001c41c1c1,
+	0x2589000006a00141, 0x2589000024c08141, 0x2589000011d14241, 0x258900001c8441c1,
+	0x2589000015fb0141, 0x258900001cb381c1, 0x258900000a3080c1, 0x2589000021df8141,
+	0x2589000017874201, 0x258900001d4a01c1, 0x2589000002a98241, 0x25890000255f4141,
+	0x2589000026cc8101, 0x2589000017ff4201, 0x258900001d9001c1, 0x25890000183b4201,
+	0x25890000183f4201, 0x2589000025914141, 0x25890000226e4181, 0x25890000058f0181,
+	0x25890000134fc241, 0x258900001e3801c1, 0x2589000018e94181, 0x258900001938c1c1,
+	0x25890000049e40c1, 0x2589000026418101, 0x258900002403c141, 0x2589000026458101,
+	0x25890000264d8101, 0x258900001b2d41c1, 0x258900001b3281c1, 0x258900000a940101,
+	0x2589000002a7c401, 0x258900001b4cc1c1, 0x2589000024398141, 0x25890000082c8301,
+	0x258900000b324101, 0x2589000015a54201, 0x2589000010f4c241, 0x258900001fe1c181,
+	0x2589000015cf4201, 0x2589000008640101, 0x2589000016754201, 0x2589000020824181,
+	0x25890000167f4201, 0x2589000020a1c181, 0x258900000b3482c1, 0x258900001e6e0141,
+	0x25890000177f4201, 0x25890000082a4101, 0x258900000e844281, 0x2589000002a98241,
+	0x2589000021d54181, 0x25890000182f4201, 0x25890000258ec141, 0x2589000015dac101,
+	0x2589000021f7c181, 0x2589000018bb4201, 0x2589000018cf4201, 0x258900002278c181,
+	0x2589000020cac101, 0x258900001e6ac181, 0x2589000019c301c1, 0x258900001ad5c1c1,
+	0x2589000003b743c1, 0x258900001b6e01c1, 0x258900001b7501c1, 0x258900001b964141,
+	0x2589000024564141, 0x2589000010fdc241, 0x258900001fdec181, 0x25890000245c8141,
+	0x258900001bdc41c1, 0x25890000163d4201, 0x2589000024a28141, 0x258900002065c181,
+	0x25890000166f4201, 0x258900000df84281, 0x2589000016c14201, 0x258900001c6bc1c1,
+	0x2589000020e6c181, 0x2589000010cc0181, 0x25890000029080c1, 0x25890000027d40c1,
+	0x2589000012680241, 0x25890000127c4241, 0x2589000017c14181, 0x25890000255f4141,
+	0x25890000182b4201, 0x25890000182f4201, 0x25890000183d4201, 0x258900000bd142c1,
+	0x258900001db141c1, 0x25890000130e8241, 0x25890000187b4201, 0x25890000188b4101,
+	0x2589000018e74201, 0x258900000f83c241, 0x2589000023bc8141, 0x258900001a3bc1c1,
+	0x2589000023f88141, 0x2589000014d34201, 0x258900000aab02c1, 0x25890000029fc401,
+	0x25890000242e4141, 0x2589000008298301, 0x2589000024528141, 0x258900000bb700c1,
+	0x2589000020074181, 0x2589000026768101, 0x258900000e69c181, 0x2589000002ae01c1,
+	0x2589000005350101, 0x2589000017174201, 0x2589000006c68341, 0x2589000024f64141,
+	0x2589000004b50101, 0x2589000012584101, 0x2589000012584241, 0x2589000017bd4201,
+	0x258900001d52c1c1, 0x2589000018334201, 0x2589000013974201, 0x25890000192b4141,
+	0x25890000192581c1, 0x25890000192ac1c1, 0x25890000192e41c1, 0x258900000f818241,
+	0x258900000f860241, 0x258900001e79c181, 0x258900001e7cc181, 0x2589000003b98181,
+	0x25890000238bc141, 0x2589000026078101, 0x258900001e9c4181, 0x258900001e9dc181,
+	0x2589000013dd4201, 0x258900000a1c02c1, 0x2589000019c141c1, 0x25890000051e0101,
+	0x258900001f054181, 0x258900001a2dc1c1, 0x2589000023da8141, 0x2589000023dd0141,
+	0x25890000115b0101, 0x258900001f114181, 0x2589000014cd4201, 0x2589000006834241,
+	0x2589000008610181, 0x2589000014d94201, 0x258900001f5f4181, 0x2589000014fd4201,
+	0x2589000007c98301, 0x258900001f864181, 0x25890000064e4341, 0x258900000aadc2c1,
+	0x258900001c984141, 0x258900001b3601c1, 0x258900001f93c181, 0x25890000152f4201,
+	0x25890000109d0241, 0x2589000015354201, 0x2589000024398141, 0x258900001b5581c1,
+	0x2589000015594201, 0x2589000010c58241, 0x258900001fb7c181, 0x258900000d5ac281,
+	0x2589000015914201, 0x258900000ae4c2c1, 0x2589000008328301, 0x2589000015994201,
+	0x258900001fe4c181, 0x2589000015bb4201, 0x258900001bbb01c1, 0x25890000110fc241,
+	0x2589000006858341, 0x258900001ff0c181, 0x2589000015dd4201, 0x2589000011240241,
+	0x2589000016074201, 0x2589000016094201, 0x25890000084a8301, 0x258900001bde01c1,
+	0x258900001bdfc1c1, 0x2589000024758141, 0x258900001c2b01c1, 0x2589000016534201,
+	0x25890000206ec181, 0x25890000085f8301, 0x2589000016834201, 0x2589000016914201,
+	0x25890000178bc181, 0x258900001c5341c1, 0x2589000026978101, 0x2589000016bd4201,
+	0x2589000008778301, 0x25890000087a8301, 0x2589000024bcc141, 0x258900001c6d81c1,
+	0x2589000020ca4181, 0x2589000020cbc181, 0x2589000016e54201, 0x2589000020d64101,
+	0x258900000b3cc2c1, 0x258900000b3f82c1, 0x2589000020e6c181, 0x258900001c9781c1,
+	0x2589000024de8141, 0x2589000024e9c141, 0x258900000b3e0181, 0x2589000024f8c141,
+	0x2589000024fa0141, 0x258900001cca41c1, 0x2589000012008241, 0x2589000002d34101,
+	0x2589000025004141, 0x258900002511c141, 0x2589000017634201, 0x258900002525c141,
+	0x2589000012344241, 0x258900000b9201c1, 0x258900000b9202c1, 0x25890000216dc181,
+	0x25890000124d0241, 0x2589000017b94201, 0x25890000255a4141, 0x2589000017bb4201,
+	0x258900000f864201, 0x258900001d4141c1, 0x258900001d4301c1, 0x25890000126ec241,
+	0x258900000bda81c1, 0x25890000127a0241, 0x2589000008fb8301, 0x2589000017c14201,
+	0x258900001289c241, 0x258900001d4f41c1, 0x25890000128e4241, 0x258900001d6601c1,
+	0x2589000008338201, 0x2589000025888141, 0x2589000021d9c181, 0x2589000018374201,
+	0x2589000018394201, 0x2589000012ea8241, 0x258900001dac01c1, 0x25890000259f0141,
+	0x2589000012fec241, 0x2589000021f64181, 0x258900001507c101, 0x258900002203c181,
+	0x25890000186d4201, 0x258900001dc481c1, 0x2589000018774201, 0x25890000130c4241,
+	0x258900001decc1c1, 0x25890000222ac181, 0x258900001b36c101, 0x258900001322c241,
+	0x2589000018b74201, 0x258900001e0e01c1, 0x258900002275c181, 0x2589000022834181,
+	0x2589000025fb8101, 0x2589000010f90181, 0x25890000083e40c1, 0x258900000f5b4241,
+	0x258900001e6dc181, 0x25890000182a0141, 0x258900000658c181, 0x258900000b200141,
+	0x2589000014d14201, 0x258900001b0501c1, 0x258900001f744181, 0x258900001f8ac181,
+	0x25890000050d8201, 0x25890000128141c1, 0x258900001ff10141, 0x2589000015814201,
+	0x25890000158d4201, 0x2589000015914201, 0x258900002458c141, 0x258900001bad0181,
+	0x258900001106c241, 0x2589000008300101, 0x258900000b1e82c1, 0x258900000b3a02c1,
+	0x2589000011e10241, 0x258900000a3080c1, 0x258900000f688141, 0x2589000021694181,
+	0x2589000017994201, 0x2589000026c08101, 0x2589000021844181, 0x2589000012830241,
+	0x258900000bb7c141, 0x25890000117ec181, 0x258900001d900181, 0x2589000006d88101,
+	0x258900000ebdc281, 0x2589000018294201, 0x2589000018394181, 0x25890000161a0141,
+	0x258900001d9541c1, 0x258900001dc101c1, 0x25890000226e4101, 0x25890000119bc1c1,
+	0x258900000f21c101, 0x2589000015dbc181, 0x25890000037fc0c1, 0x258900000e358141,
+	0x25890000237f4141, 0x2589000014214201, 0x2589000023bb4141, 0x258900001040c241,
+	0x2589000007db81c1, 0x258900000ab082c1, 0x2589000007db8301, 0x258900000d214281,
+	0x258900001b4941c1, 0x25890000005ec0c1, 0x25890000153f4201, 0x258900002444c141,
+	0x258900002449c141, 0x2589000026618101, 0x2589000008328181, 0x2589000010f4c181,
+	0x2589000012e441c1, 0x258900000aea42c1, 0x258900000e088101, 0x25890000268c8101,
+	0x2589000010ec4101, 0x25890000168b4181, 0x2589000005350101, 0x2589000016c94201,
+	0x2589000024dc0141, 0x2589000003c50141, 0x258900001cb1c1c1, 0x25890000123f8241,
+	0x258900000b94c141, 0x258900000e6dc281, 0x2589000012734241, 0x258900000e9ac281,
+	0x258900001292c241, 0x2589000026ce8101, 0x2589000025824141, 0x25890000181b4201,
+	0x258900000bce82c1, 0x2589000025a04141, 0x2589000021fac181, 0x258900000a050101,
+	0x2589000021ff4181, 0x25890000130a0241, 0x258900001ddd01c1, 0x2589000018854201,
+	0x25890000222dc181, 0x258900001e32c1c1, 0x2589000018cf4201, 0x258900002281c181,
+	0x2589000027018101, 0x25890000193541c1, 0x25890000241e0141, 0x258900001b22c1c1,
+	0x25890000064cc101, 0x258900001fc84181, 0x25890000159f4201, 0x2589000015b14201,
+	0x25890000083e81c1, 0x2589000016c34201, 0x2589000024be0141, 0x2589000003fe0101,
+	0x2589000016f54201, 0x2589000016c44101, 0x25890000064c0141, 0x2589000021bec101,
+	0x258900001dd981c1, 0x258900001de081c1, 0x2589000018874201, 0x2589000015afc181,
+	0x2589000023f9c141, 0x2589000014cb4201, 0x2589000015014201, 0x2589000010820241,
+	0x258900001c930181, 0x258900000e964201, 0x258900000d32c281, 0x2589000015614201,
+	0x25890000244c4141, 0x258900001fbc4181, 0x2589000015874201, 0x258900000d624281,
+	0x258900001ba7c1c1, 0x258900000d6ec141, 0x2589000015d54201, 0x2589000026758101,
+	0x258900001777c181, 0x2589000016114201, 0x258900000de6c181, 0x2589000016814201,
+	0x258900001c4c41c1, 0x2589000020d7c181, 0x2589000011298101, 0x258900001c9cc1c1,
+	0x25890000246d0101, 0x258900001ccc01c1, 0x2589000006d6c341, 0x258900000ae80141,
+	0x258900000bb5c2c1, 0x2589000021b5c181, 0x2589000018434201, 0x258900000a304101,
+	0x2589000022714181, 0x2589000022774181, 0x25890000195bc1c1, 0x25890000064b0341,
+	0x258900001b9801c1, 0x2589000015e74201, 0x25890000249c4141, 0x25890000164b4201,
+	0x258900002083c181, 0x2589000016cf4201, 0x25890000216c4181, 0x258900001d8e41c1,
+	0x2589000012e18241, 0x258900001db301c1, 0x2589000018894201, 0x2589000025c34141,
+	0x2589000018ef4201, 0x2589000023650141, 0x25890000206c8141, 0x2589000024280141,
+	0x25890000050fc0c1, 0x25890000100f8101, 0x258900000c250141, 0x2589000010e50241,
+	0x258900001fd14181, 0x2589000015b54201, 0x25890000245b4141, 0x258900001bd381c1,
+	0x258900000db4c281, 0x25890000117e0241, 0x258900000ddf4281, 0x2589000016c54201,
+	0x25890000088c8301, 0x2589000006e70341, 0x25890000276780c1, 0x2589000018454201,
+	0x2589000025e78141, 0x258900001e58c181, 0x258900001e7fc181, 0x2589000017b28141,
+	0x258900000fd94241, 0x2589000023d6c141, 0x2589000014cb4201, 0x258900001f60c181,
+	0x258900001f654181, 0x2589000010844241, 0x258900001b6a81c1, 0x258900001fcb4181,
+	0x2589000015894201, 0x2589000015db4201, 0x258900001c3041c1, 0x2589000005458381,
+	0x2589000024aa0141, 0x2589000024ba4141, 0x2589000016e94201, 0x258900001c9b01c1,
+	0x25890000011f8081, 0x258900001bdb4101, 0x258900000e68c281, 0x25890000178b4201,
+	0x25890000221bc181, 0x2589000018d14201, 0x25890000058f0181, 0x2589000018db4201,
+	0x2589000018e94201, 0x25890000228ac101, 0x258900000d888141, 0x25890000115d8104,
+	0x25890000115d8104, 0x25890000115d8104, 0x25890000068540c1, 0x258900001f414181,
+	0x258900001f864181, 0x2589000007d88301, 0x25890000010c40c1, 0x258900000d584181,
+	0x2589000006c7c101, 0x2589000015d14201, 0x2589000024618141, 0x258900000e69c181,
+	0x2589000016974201, 0x2589000020a64181, 0x2589000020bcc181, 0x2589000016bf4201,
+	0x258900001c6a01c1, 0x2589000011dec241, 0x258900000e13c281, 0x258900000360c101,
+	0x258900000d5e8141, 0x25890000186d0141, 0x25890000210ac181, 0x2589000019438181,
+	0x2589000021b14181, 0x2589000021ba4181, 0x258900000b970101, 0x258900000bd982c1,
+	0x2589000013034101, 0x2589000007da4141, 0x2589000025b6c141, 0x25890000227d4181,
+	0x2589000018eb4201, 0x258900000ae301c1, 0x2589000011f00101, 0x258900000a90c0c1,
+	0x2589000023ad8141, 0x258900001b1a01c1, 0x258900000ae202c1, 0x258900001bbcc1c1,
+	0x2589000015f94201, 0x258900001c2cc1c1, 0x25890000206a4181, 0x2589000016714201,
+	0x258900000b2402c1, 0x2589000020b84181, 0x2589000011d5c241, 0x258900000b258141,
+	0x2589000026e6c0c1, 0x25890000117ec181, 0x2589000018254201, 0x258900001111c0c1,
+	0x258900000c3b80c1, 0x258900000a96c181, 0x25890000058f0181, 0x258900000f1cc281,
+	0x258900001fe50141, 0x258900000a9502c1, 0x25890000065802c1, 0x25890000003e00c1,
+	0x2589000000a940c1, 0x2589000026628101, 0x258900001b9101c1, 0x2589000015994101,
+	0x2589000005340101, 0x25890000266e8101, 0x2589000015ff4201, 0x2589000016194201,
+	0x2589000011414241, 0x25890000161b4201, 0x258900001c1ec1c1, 0x2589000003fe8181,
+	0x2589000003fe83c1, 0x258900002089c181, 0x2589000026918101, 0x258900000dfac281,
+	0x258900001c6301c1, 0x2589000020c14181, 0x2589000012050241, 0x2589000025450141,
+	0x258900001fcc0141, 0x2589000011420181, 0x2589000003a880c1, 0x25890000131c0241,
+	0x258900001fe3c181, 0x25890000134d8241, 0x258900000f1f4181, 0x258900000e358141,
+	0x25890000243d4141, 0x25890000038c00c1, 0x25890000158b4201, 0x258900001bb081c1,
+	0x258900001feac101, 0x2589000015cb4201, 0x25890000225a4101, 0x258900001ff9c181,
+	0x2589000020074181, 0x258900002011c181, 0x2589000011804241, 0x2589000024a28141,
+	0x25890000206bc181, 0x2589000011948241, 0x25890000207f4181, 0x2589000003ff8181,
+	0x2589000020b54181, 0x2589000001d98141, 0x2589000021bec181, 0x258900001d8e4101,
+	0x2589000025888141, 0x2589000011160101, 0x2589000021dcc181, 0x2589000026dc8101,
+	0x2589000001dac0c1, 0x2589000014dc0141, 0x2589000018954201, 0x258900001e0fc1c1,
+	0x2589000013544241, 0x2589000014dd4201, 0x2589000001d88441, 0x258900000d46c281,
+	0x258900001b7f81c1, 0x258900001fccc181, 0x2589000006c7c101, 0x2589000015b74201,
+	0x25890000164d4201, 0x2589000001da4281, 0x2589000006a2c341, 0x25890000050f8181,
+	0x25890000183e4181, 0x2589000020c8c181, 0x258900001c9e81c1, 0x2589000015e80141,
+	0x258900001cb541c1, 0x2589000006c68141, 0x258900000b934101, 0x25890000217b4181,
+	0x258900001d3dc1c1, 0x2589000006f74341, 0x25890000158881c1, 0x2589000017c54201,
+	0x2589000012908241, 0x2589000021cf4181, 0x25890000244bc141, 0x2589000007944101,
+	0x2589000021f94181, 0x25890000225ac181, 0x258900002260c181, 0x2589000018d34201,
+	0x25890000006740c1, 0x2589000003c140c1, 0x2589000023d80141, 0x258900001f564181,
+	0x258900002435c141, 0x2589000015794201, 0x258900000d714281, 0x25890000266a8101,
+	0x2589000015d14201, 0x2589000015d74201, 0x258900001bc041c1, 0x258900001118c241,
+	0x2589000016054201, 0x258900000683c1c1, 0x258900002071c181, 0x258900000de6c281,
+	0x25890000167b4201, 0x2589000011b1c241, 0x2589000016e34201, 0x2589000011dc8241,
+	0x258900001c8981c1, 0x2589000025428141, 0x2589000021814181, 0x2589000017c34201,
+	0x25890000181f4201, 0x2589000018274201, 0x2589000018314201, 0x2589000007da4141,
+	0x2589000004b24101, 0x2589000013a34201, 0x25890000025d4101, 0x258900001ca8c101,
+	0x258900000e358141, 0x25890000195f41c1, 0x258900000fdb8241, 0x2589000019bdc1c1,
+	0x258900000d308141, 0x2589000014c74181, 0x258900001f66c181, 0x25890000082e8101,
+	0x258900001f90c181, 0x258900000cb74101, 0x258900001fa44181, 0x258900001fa74181,
+	0x258900001faa4181, 0x2589000024398141, 0x258900001b5201c1, 0x25890000005ec0c1,
+	0x2589000010f28241, 0x258900000d5ec201, 0x2589000006824101, 0x2589000006824341,
+	0x2589000015cd4201, 0x2589000015df4201, 0x258900001ffb4181, 0x25890000117bc241,
+	0x25890000117e0241, 0x2589000024a64141, 0x258900000dd7c281, 0x258900000ddcc281,
+	0x2589000008628301, 0x2589000008640181, 0x2589000020854181, 0x25890000208b4181,
+	0x2589000011a20241, 0x258900000680c181, 0x2589000008340181, 0x2589000020b9c181,
+	0x2589000016bd4201, 0x2589000016c14181, 0x258900000b4a82c1, 0x2589000024dfc141,
+	0x2589000003c50141, 0x258900001ca3c141, 0x2589000008f70181, 0x2589000003fe40c1,
+	0x2589000020fec181, 0x258900001cba81c1, 0x25890000160e0141, 0x2589000024f78141,
+	0x258900002543c141, 0x2589000021634181, 0x258900000e6dc101, 0x2589000021664181,
+	0x25890000127e8241, 0x2589000008f58301, 0x2589000017c74201, 0x25890000127fc101,
+	0x2589000021bd4181, 0x2589000021d84181, 0x2589000018254201, 0x25890000182f4201,
+	0x2589000012e84241, 0x258900001d9541c1, 0x2589000012f38241, 0x2589000021f4c181,
+	0x2589000007000101, 0x2589000026e48101, 0x2589000011134101, 0x2589000018cf4201,
+	0x258900000f1a4281, 0x25890000227a4181, 0x258900001e39c1c1, 0x2589000019b881c1,
+	0x25890000124e0141, 0x2589000008fa8101, 0x258900001f774181, 0x258900001f8dc181,
+	0x25890000083040c1, 0x258900001b68c1c1, 0x2589000015b94201, 0x258900000d8f4281,
+	0x2589000016554201, 0x2589000016b94201, 0x2589000024dd4141, 0x258900002228c141,
+	0x2589000024f3c141, 0x2589000021604181, 0x25890000216f4181, 0x2589000025504141,
+	0x25890000126c8241, 0x2589000008f88301, 0x258900001daf81c1, 0x2589000001dac201,
+	0x2589000025b94141, 0x25890000115d8104, 0x2589000021058101, 0x2589000024104101,
+	0x258900001f7d4181, 0x2589000004fc0381, 0x258900001b5041c1, 0x258900001c2f8141,
+	0x25890000082f8301, 0x258900001b9481c1, 0x258900001bab41c1, 0x2589000010f70241,
+	0x2589000011000241, 0x258900000d6ec141, 0x258900000d5ec201, 0x258900001106c241,
+	0x2589000024690141, 0x25890000160d4201, 0x258900001597c181, 0x2589000020884181,
+	0x2589000011c84101, 0x2589000016fb4201, 0x2589000014fc80c1, 0x2589000005bb4101,
+	0x2589000024ff0141, 0x258900001d1c81c1, 0x25890000254a0141, 0x258900001277c241,
+	0x2589000006fa8341, 0x258900002185c181, 0x25890000120a8101, 0x25890000182d4201,
+	0x258900000ec54281, 0x258900001d9e01c1, 0x2589000025928141, 0x2589000005bb80c1,
+	0x25890000058f0381, 0x25890000095e8301, 0x258900001e3641c1, 0x2589000004fdc1c1,
+	0x25890004194501c0, 0x258a00001f3fc188, 0x258a000003b900c1, 0x258a000006810141,
+	0x258a000024104141, 0x258a0000155f4201, 0x258a000010ce8241, 0x258a00001fbf4181,
+	0x258a000015994101, 0x258a00001fd5c181, 0x258a00000ae782c1, 0x258a000008658301,
+	0x258a00001c4541c1, 0x258a000024b04141, 0x258a000016a14201, 0x258a000024b2c141,
+	0x258a00000dfac1c1, 0x258a00001c80c1c1, 0x258a000024e88141, 0x258a000003fe40c1,
+	0x258a000026bc8101, 0x258a0000254b4141, 0x258a00002176c141, 0x258a00002176c181,
+	0x258a000017a14201, 0x258a00001d3dc1c1, 0x258a000015a9c181, 0x258a000021d24181,
+	0x258a000021f1c181, 0x258a000012f80241, 0x258a00001dd7c1c1, 0x258a0000221d4181,
+	0x258a000008670181, 0x258a00002272c181, 0x258a000025e14141, 0x258a000022894181,
+	0x258a0000238a8141, 0x258a000013ff4201, 0x258a00000a08c2c1, 0x258a00001f75c181,
+	0x258a000019b8c101, 0x258a0000107fc241, 0x258a000007db8101, 0x258a000015974201,
+	0x258a00001bc901c1, 0x258a000015f34101, 0x258a000016034201, 0x258a000026778101,
+	0x258a00002445c101, 0x258a000025414141, 0x258a000021db4181, 0x258a00001ddec1c1,
+	0x258a0000040640c1, 0x258a000025c20141, 0x258a000027008101, 0x258a00001e5ec181,
+	0x258a000013b74201, 0x258a00002390c141, 0x258a00001076c241, 0x258a00001b218141,
+	0x258a0000157b4201, 0x258a00001ba981c1, 0x258a000015bd4201, 0x258a00001bd8c1c1,
+	0x258a00001133c241, 0x258a00001fb70181, 0x258a0000207ac181, 0x258a00000640c101,
+	0x258a000024ce4141, 0x258a000008868301, 0x258a00001ca3c1c1, 0x258a00001d2e01c1,
+	0x258a000017b34201, 0x258a00002185c181, 0x258a000026c68101, 0x258a000026ca8101,
+	0x258a000012df4241, 0x258a000021de4181, 0x258a000002a601c1, 0x258a000026f58101,
+	0x258a000022744181, 0x258a000018e20141, 0x258a00001aee41c1, 0x258a00001f474181,
+	0x258a00001064c241, 0x258a00001b1f41c1, 0x258a000001d881c1, 0x258a00001f8f4181,
+	0x258a000024384141, 0x258a000015574201, 0x258a000011da8201, 0x258a00001fb64181,
+	0x258a0000244d8141, 0x258a00001fc6c181, 0x258a000004c4c0c1, 0x258a000011168241,
+	0x258a000005340381, 0x258a000015fd4201, 0x258a000016014201, 0x258a00000b2142c1,
+	0x258a000020a04181, 0x258a00001246c101, 0x258a00000d0cc101, 0x258a000005350281,
+	0x258a00001c64c1c1, 0x258a000024c1c141, 0x258a000011d5c241, 0x258a000024e38141,
+	0x258a00001ca901c1, 0x258a00001b854101, 0x258a00001d4bc1c1, 0x258a000018354201,
+	0x258a000021e2c181, 0x258a000012fa4241, 0x258a00000ed6c281, 0x258a000021fc4181,
+	0x258a000022294181, 0x258a00001c1fc141, 0x258a000025db0141, 0x258a00001e3d41c1,
+	0x258a000018df4201, 0x258a000018e14201, 0x258a0000228ac181, 0x258a0000235c4141,
+	0x258a000004b28381, 0x258a00000bb20101, 0x258a00000b22c141, 0x258a0000103e8241,
+	0x258a000014f94201, 0x258a00001f6e4181, 0x258a000007d58301, 0x258a000002abc401,
+	0x258a000003c283c1, 0x258a000015154201, 0x258a00001b4941c1, 0x258a000024398141,
+	0x258a00000c250141, 0x258a000010c7c241, 0x258a000010ca0241, 0x258a00000e004181,
+	0x258a00001b8f41c1, 0x258a00002453c141, 0x258a000010f94241, 0x258a00001fdbc181,
+	0x258a0000067f0341, 0x258a000011120241, 0x258a000005340101, 0x258a000015fb4201,
+	0x258a000011798241, 0x258a00000b960181, 0x258a000024a78141, 0x258a0000207ac181,
+	0x258a000011ab0241, 0x258a000016e14201, 0x258a000008868141, 0x258a00000bb44101,
+	0x258a00000b5b02c1, 0x258a00000b94c141, 0x258a00000b5c4181, 0x258a0000217e4181,
+	0x258a0000217fc181, 0x258a00000bad82c1, 0x258a000012710241, 0x258a000025838141,
+	0x258a00001d8c81c1, 0x258a00001d900181, 0x258a000021d6c181, 0x258a000018234201,
+	0x258a000012e60241, 0x258a0000091f8301, 0x258a000018694201, 0x258a00002206c181,
+	0x258a0000074e40c1, 0x258a00001e76c181, 0x258a00000e128141, 0x258a000014cf4201,
+	0x258a00001f42c181, 0x258a000014ff4201, 0x258a000006a18141, 0x258a0000243c0141,
+	0x258a000015934201, 0x258a00001b99c1c1, 0x258a00001b9d41c1, 0x258a000012e441c1,
+	0x258a000015cf4201, 0x258a000015d34201, 0x258a00001ff24181, 0x258a00001bd001c1,
+	0x258a00001be341c1, 0x258a00000e114281, 0x258a0000049500c1, 0x258a000008f70181,
+	0x258a00000f1b8141, 0x258a00000e68c101, 0x258a000012908241, 0x258a00001b754101,
+	0x258a00000907c101, 0x258a000020a24101, 0x258a00000c0b02c1, 0x258a000015ae81c1,
+	0x258a000004b4c141, 0x258a000003bcc201, 0x258a000014c74181, 0x258a000014c74201,
+	0x258a00001f30c181, 0x258a00002421c141, 0x258a000024230141, 0x258a00001f78c181,
+	0x258a000015054201, 0x258a000015074201, 0x258a00001f864181, 0x258a000003bb03c1,
+	0x258a000002a3c401, 0x258a0000135e4141, 0x258a0000005ec0c1, 0x258a000024424141,
+	0x258a000015634201, 0x258a000010e74241, 0x258a000015914201, 0x258a000015954201,
+	0x258a000015a74201, 0x258a00001bb081c1, 0x258a000003a94241, 0x258a000015af4201,
+	0x258a000026678101, 0x258a000015d94201, 0x258a00001121c241, 0x258a00001bd1c1c1,
+	0x258a00001febc101, 0x258a000015f74201, 0x258a00000dc8c281, 0x258a000020734181,
+	0x258a0000050f8181, 0x258a0000119b4241, 0x258a000002ae01c1, 0x258a0000168d4201,
+	0x258a000016bb4201, 0x258a000016cb4201, 0x258a000024cf8141, 0x258a000024e24141,
+	0x258a000003c50141, 0x258a000024e60141, 0x258a00001ca741c1, 0x258a000007dd4141,
+	0x258a0000160e0141, 0x258a0000213f4181, 0x258a00001d1041c1, 0x258a00001d1ac1c1,
+	0x258a00002161c181, 0x258a0000254dc141, 0x258a00000b5c4181, 0x258a00001134c141,
+	0x258a000017c94201, 0x258a000026cb8101, 0x258a000021ae4181, 0x258a0000259dc101,
+	0x258a000018574201, 0x258a00000ed44281, 0x258a000009288301, 0x258a000025bd0141,
+	0x258a0000225dc181, 0x258a0000227bc181, 0x258a000022864181, 0x258a0000130fc101,
+	0x258a000025e3c141, 0x258a00000a9341c1, 0x258a00001358c241, 0x258a00001e3f01c1,
+	0x258a0000115d8104, 0x258a0000115d8104, 0x258a0000218b4141, 0x258a000019718101,
+	0x258a00000d1c4281, 0x258a00001f894181, 0x258a000026718101, 0x258a000024654141,
+	0x258a0000246b8141, 0x258a000015e74101, 0x258a00001bdc41c1, 0x258a00001c1d01c1,
+	0x258a0000168b4201, 0x258a000016b74201, 0x258a000011d38241, 0x258a000017034201,
+	0x258a00000b52c2c1, 0x258a000008fd8101, 0x258a0000252e8141, 0x258a00002539c141,
+	0x258a000012e3c241, 0x258a000012e60241, 0x258a000018654201, 0x258a0000186b4201,
+	0x258a000011bb0101, 0x258a000021d88141, 0x258a00000087c0c1, 0x258a000024118141,
+	0x258a00001f69c181, 0x258a000007d28301, 0x258a0000050e0181, 0x258a00001bbe0181,
+	0x258a000007d44141, 0x258a0000157f4201, 0x258a00002053c181, 0x258a00002056c181,
+	0x258a0000050f8181, 0x258a0000088f8301, 0x258a000026a48101, 0x258a00000e1dc281,
+	0x258a000017114201, 0x258a00001d4841c1, 0x258a000021dfc181, 0x258a00001de401c1,
+	0x258a000025b80141, 0x258a00002275c181, 0x258a0000227ec181, 0x258a00001e3b81c1,
+	0x258a000012d9c101, 0x258a000016728141, 0x258a000003a84101, 0x258a00001ad401c1,
+	0x258a000003a84201, 0x258a00000cf1c281, 0x258a00001adb01c1, 0x258a00001b06c1c1,
+	0x258a00001b2101c1, 0x258a0000106dc241, 0x258a000007d28281, 0x258a000006ffc0c1,
+	0x258a0000244b0141, 0x258a000005bf80c1, 0x258a000010d0c241, 0x258a0000157d4201,
+	0x258a00001b9641c1, 0x258a000011048241, 0x258a00001fe34181, 0x258a000015c14201,
+	0x258a0000246a4141, 0x258a00000db24281, 0x258a000011828241, 0x258a00001196c241,
+	0x258a00001c41c1c1, 0x258a000011ad4241, 0x258a000016ed4201, 0x258a000020e84181,
+	0x258a000017134201, 0x258a00001d4681c1, 0x258a00000bda81c1, 0x258a0000127341c1,
+	0x258a000017cb4201, 0x258a000021bbc181, 0x258a000025dec141, 0x258a00000a9341c1,
+	0x258a0000064bc201, 0x258a00001db98101, 0x258a0000264e8101, 0x258a00001b4e81c1,
+	0x258a00001b7a41c1, 0x258a00000d5d4281, 0x258a00001fd2c181, 0x258a00000d674281,
+	0x258a00001bda81c1, 0x258a00001c2e81c1, 0x258a0000268b8101, 0x258a0000127ac101,
+	0x258a0000209ec181, 0x258a0000087d8101, 0x258a0000087d8301, 0x258a000024d0c141,
+	0x258a000011d80241, 0x258a0000269a8101, 0x258a000015e80141, 0x258a0000170f4201,
+	0x258a00000d610141, 0x258a00000e6dc181, 0x258a000010cb4101, 0x258a00000e984281,
+	0x258a000021874181, 0x258a00000ebb4281, 0x258a00001dadc1c1, 0x258a00000b358141,
+	0x258a000025b30141, 0x258a00000eeac281, 0x258a000025dd8141, 0x258a00000c24c181,
+	0x258a0000025d4101, 0x258a00000f22c181, 0x258a000024460141, 0x258a00001fc9c181,
+	0x258a0000083e8301, 0x258a00000dcb4281, 0x258a00000b104101, 0x258a000003fe8181,
+	0x258a000002ae01c1, 0x258a000026908101, 0x258a000016bd4201, 0x258a000020c5c181,
+	0x258a000012464241, 0x258a000012950241, 0x258a000012fc8241, 0x258a000022594181,
+	0x258a00001e52c181, 0x258a0000192c81c1, 0x258a000012704201, 0x258a00000f6f8241,
+	0x258a000015ae81c1, 0x258a00001eae4181, 0x258a000019c301c1, 0x258a000023ac4141,
+	0x258a00000bb20101, 0x258a00001a2501c1, 0x258a000023f88141, 0x258a000023fc4141,
+	0x258a00001b1d81c1, 0x258a000014fb4201, 0x258a00001f6b4181, 0x258a000006518341,
+	0x258a000003bec3c1, 0x258a000006580341, 0x258a000004fe8101, 0x258a00001c8c8181,
+	0x258a000015134201, 0x258a0000243e8141, 0x258a0000155d4201, 0x258a00000d69c281,
+	0x258a000005340101, 0x258a00001ff3c181, 0x258a00001bc581c1, 0x258a00001bc741c1,
+	0x258a0000111b0241, 0x258a000024640141, 0x258a000015e74101, 0x258a00000d87c281,
+	0x258a0000112f4241, 0x258a00001c1b41c1, 0x258a0000205cc181, 0x258a00000b960181,
+	0x258a00002080c181, 0x258a0000208cc181, 0x258a0000040603c1, 0x258a000011b40241,
+	0x258a000016c14181, 0x258a000020cd4181, 0x258a000011ee8241, 0x258a00001caac1c1,
+	0x258a000003ac80c1, 0x258a000002a24181, 0x258a0000027d40c1, 0x258a000017b14201,
+	0x258a00001d3f81c1, 0x258a00001280c241, 0x258a000017bf4201, 0x258a000017fd4201,
+	0x258a000021b44181, 0x258a000012dac241, 0x258a0000258b0141, 0x258a00000bce8101,
+	0x258a00001dba01c1, 0x258a000011a28141, 0x258a000004fe8101, 0x258a000015ad4201,
+	0x258a000003ab8101, 0x258a00001bce41c1, 0x258a0000207c4181, 0x258a000024bf4141,
+	0x258a000016e34201, 0x258a00000b94c2c1, 0x258a00001dbbc1c1, 0x258a000010f44181,
+	0x258a0000208a4101, 0x258a00002287c181, 0x258a00000e358141, 0x258a000023f88141,
+	0x258a000010430241, 0x258a000010478241, 0x258a00000bdac101, 0x258a00000bdb0141,
+	0x258a000003fec141, 0x258a00001fc24181, 0x258a0000158f4201, 0x258a0000200ec181,
+	0x258a00000b31c2c1, 0x258a00000e04c281, 0x258a000024c30141, 0x258a000020e9c181,
+	0x258a000024fdc141, 0x258a00002589c141, 0x258a00001d91c1c1, 0x258a000018494201,
+	0x258a000012f38101, 0x258a000021fdc181, 0x258a00001507c181, 0x258a00001de941c1,
+	0x258a000026e68101, 0x258a000022774181, 0x258a00000f7d0141, 0x258a00000f7d0241,
+	0x258a000026448101, 0x258a000010724241, 0x258a00001f864181, 0x258a000015174201,
+	0x258a000003fec141, 0x258a000024488141, 0x258a00001fc9c181, 0x258a000010ebc241,
+	0x258a000011000241, 0x258a00001fec4181, 0x258a000015c54201, 0x258a000006fbc201,
+	0x258a00000f19c101, 0x258a000015e94101, 0x258a000015e94201, 0x258a000015f14201,
+	0x258a000011360241, 0x258a0000206bc181, 0x258a0000050f8181, 0x258a000006b30341,
+	0x258a000021844181, 0x258a000012878241, 0x258a000025874141, 0x258a000011420181,
+	0x258a000018b54201, 0x258a000025e64141, 0x258a00001be38101, 0x258a00000e358141,
+	0x258a000014d54201, 0x258a0000107d8241, 0x258a0000103f4101, 0x258a00001fc84181,
+	0x258a00000d5fc281, 0x258a000015a14201, 0x258a00001106c241, 0x258a00000db9c281,
+	0x258a00001591c181, 0x258a000003c08201, 0x258a000024b90141, 0x258a000016c74201,
+	0x258a000024c44141, 0x258a000011da4241, 0x258a000011ec4241, 0x258a000008bdc081,
+	0x258a00000b258141, 0x258a000020efc181, 0x258a00001ca041c1, 0x258a0000253ec141,
+	0x258a00002164c181, 0x258a000017814201, 0x258a00002185c181, 0x258a0000128c0241,
+	0x258a0000218a4181, 0x258a000018214201, 0x258a000025900141, 0x258a00002209c181,
+	0x258a0000187d4201, 0x258a000018cf4201, 0x258a000018d54201, 0x258a000018e34201,
+	0x258a000022894101, 0x258a0000035940c1, 0x258a0000181c4101, 0x258a000004624181,
+	0x258a000023ac8101, 0x258a00001b7c01c1, 0x258a0000112ac241, 0x258a000024a8c141,
+	0x258a000016bb4181, 0x258a000002a24181, 0x258a00000bb302c1, 0x258a0000218d4181,
+	0x258a000021cdc181, 0x258a0000185b4201, 0x258a000022084181, 0x258a0000085100c1,
+	0x258a0000278040c1, 0x258a00000bb20101, 0x258a000023cb8141, 0x258a00001ad241c1,
+	0x258a000003a84201, 0x258a000003a843c1, 0x258a00000a9242c1, 0x258a000015094201,
+	0x258a00001f93c181, 0x258a0000050d8381, 0x258a00000aac40c1, 0x258a000026708101,
+	0x258a0000246cc141, 0x258a00002002c181, 0x258a00000b1bc2c1, 0x258a000020794181,
+	0x258a000011c3c241, 0x258a000024bf4141, 0x258a000016d14201, 0x258a000016eb4201,
+	0x258a000008898301, 0x258a000004fe0181, 0x258a0000210ac181, 0x258a0000255f4141,
+	0x258a000018194201, 0x258a000009288141, 0x258a0000188b4201, 0x258a00000f17c281,
+	0x258a0000134b4241, 0x258a0000115d8104, 0x258a000013e54208, 0x258a000025ba8148,
+	0x258a0000037b40c8, 0x258a000005d18148, 0x258a000011630248, 0x258a000000260108,
+	0x258a000013e54208, 0x258a00001f24c188, 0x258a00001f24c188, 0x258a00040602c100,
+	0x258b0000002a8048, 0x258b000000370048, 0x258b00000fce0248, 0x258b0000147b4208,
+	0x258b00001a1fc1c8, 0x258b00000f0dc288, 0x258b00001e1a41c8, 0x258b000000090048,
+	0x258b000000094048, 0x258b00001a7041c8, 0x258b000018c34208, 0x258b0000156d4208,
+	0x258b0000156f4208, 0x258b000015714208, 0x258b00001a7201c8, 0x258b00002114c0c8,
+	0x258b00080602c100, 0x258d000c0fcd40c0, 0x259000002114c0c8, 0x259000181e178100,
+	0x2596000003ca01d0, 0x259600001e16c1d0, 0x2596000015674208, 0x2596000008958308,
+	0x2596000002b60048, 0x25960000007000c8, 0x25960000006a4108, 0x259600000c4f4108,
+	0x25960000014f4148, 0x2596000015674208, 0x2596000c15674200, 0x259900001d7941c8,
+	0x25990000002541c8, 0x2599000013e54208, 0x2599000007074081, 0x2599000007074081,
+	0x25990000039180c1, 0x2599000007074081, 0x25990000039180c1, 0x2599000007074081,
+	0x2599000000118081, 0x259900001fa00141, 0x259900002257c181, 0x2599000007074081,
+	0x2599000007074081, 0x2599000000118081, 0x2599000007074081, 0x2599000024258141,
+	0x259900000e704141, 0x2599000007074081, 0x259900001f834181, 0x259900000e704141,
+	0x2599000007074081, 0x25990000011dc081, 0x259900001eb2c0c1, 0x2599000024258141,
+	0x259900001f834181, 0x2599000007074081, 0x2599000007074081, 0x2599000007074081,
+	0x2599000007074081, 0x259900001fa00141, 0x2599000007074081, 0x2599000007074081,
+	0x2599000007074081, 0x2599000007074081, 0x25990000011dc081, 0x259900001eb2c0c1,
+	0x2599000007074081, 0x2599000007074081, 0x2599000007074081, 0x259900000e704141,
+	0x2599000007074081, 0x259900002257c181, 0x2599000013e54208, 0x25990000008a81c8,
+	0x259900000012c108, 0x2599000000084048, 0x25990000015380c8, 0x259900040088c180,
+	0x259a000000750088, 0x259a000003c64108, 0x259a000000210088, 0x259a0000006f8108,
+	0x259a0000001cc088, 0x259a0000025fc088, 0x259a000001298088, 0x259a00001f4b8088,
+	0x259a000000154088, 0x259a000006078088, 0x259a000000084088, 0x259a000004bd0088,
+	0x259a000007a30088, 0x259a00000c558088, 0x259a0000000b4088, 0x259a000008444088,
+	0x259a0000075840c8, 0x259a00000150c088, 0x259a0000000d0088, 0x259a0000005e8088,
+	0x259a0000000e8088, 0x259a000001f04088, 0x259a000004b64088, 0x259a000001cc8088,
+	0x259a0000000a0088, 0x259a000000988088, 0x259a0000003b0088, 0x259a0000013d40c8,
+	0x259a0000000c4088, 0x259a000000264088, 0x259a0000052fc088, 0x259a000008418088,
+	0x259a0000003a4088, 0x259a000001400088, 0x259a0000018e0088, 0x259a000005ba8088,
+	0x259a000000200088, 0x259a000014280088, 0x259a000007190088, 0x259a000001b80088,
+	0x259a0000021f00c8, 0x259a0000006e4088, 0x259a0000053ac088, 0x259a000000280088,
+	0x259a000000630088, 0x259a000025040148, 0x259a000002030088, 0x259a0000012e0088,
+	0x259a0000275c40c8, 0x259a0000001f4088, 0x259a000000090088, 0x259a0000001e0088,
+	0x259a000000260088, 0x259a000000cb0088, 0x259a0000004c4088, 0x259a00000536c088,
+	0x259a0000006a4088, 0x259a000001b98148, 0x259a000400118080, 0x259b00040bbb40c0,
+	0x259c000000248088, 0x259c000000274081, 0x259c000026498101, 0x259c000000150081,
+	0x259c0000000c4081, 0x259c000024604141, 0x259c000015e14201, 0x259c0000246f4141,
+	0x259c0000045c00c1, 0x259c000024730141, 0x259c0000274b00c1, 0x259c000022650081,
+	0x259c000026a58101, 0x259c000002d340c1, 0x259c0000006d0081, 0x259c000026ad8101,
+	0x259c0000275580c1, 0x259c000000174081, 0x259c000000e0c0c1, 0x259c000008f20101,
+	0x259c0000251280c1, 0x259c000026bb8101, 0x259c000000b70081, 0x259c0000146d00c1,
+	0x259c0000034000c1, 0x259c0000002e4081, 0x259c000008b94081, 0x259c000012b48241,
+	0x259c000009298081, 0x259c000020800101, 0x259c000000aec081, 0x259c00000aeb0101,
+	0x259c000026e88101, 0x259c00001d808101, 0x259c0000006a4081, 0x259c000005c00081,
+	0x259c0000124a40c1, 0x259c000011f680c1, 0x259c000026f88101, 0x259c000026f98101,
+	0x259c000026fa8101, 0x259c000026fb8101, 0x259c0000277980c1, 0x259c00000f5f40c1,
+	0x259c000026fc8101, 0x259c000026fd8101, 0x259c00002269c181, 0x259c00001a430081,
+	0x259c0000265600c8, 0x259c000004e28108, 0x259c000015040088, 0x259c000004e800c8,
+	0x259c000003ca01d0, 0x259c00001be881d0, 0x259c000017f14210, 0x259c000004e800d0,
+	0x259c000015674208, 0x259c000017af41c8, 0x259c0000003b8088, 0x259c000022644088,
+	0x259c0000000b80c8, 0x259c0000006a0088, 0x259c0000006a4088, 0x259c000001fc8188,
+	0x259c000013e54208, 0x259c0000007500c1, 0x259c000016af4208, 0x259c0000235ec148,
+	0x259c000009e7c148, 0x259c000009ea82c8, 0x259c000013e54208, 0x259c000814054200,
+	0x259e00040d994280, 0x259f000008718308, 0x259f00001c7f01c8, 0x259f000024d48148,
+	0x259f0004122fc240, 0x25a0000007420348, 0x25a00000052840c8, 0x25a00000140f4208,
+	0x25a000000d28c288, 0x25a0000017af41c8, 0x25a000000337c3c8, 0x25a0000026428108,
+	0x25a000000ff900c8, 0x25a0000026828108, 0x25a000000b8442c8, 0x25a00000073ec348,
+	0x25a00000003d8081, 0x25a00000274440c1, 0x25a0000002c100c1, 0x25a00000003d8081,
+	0x25a00000274440c1, 0x25a0000002c100c1, 0x25a00000003d8081, 0x25a00000274440c1,
+	0x25a0000002c100c1, 0x25a00000003d8081, 0x25a00000274440c1, 0x25a0000002c100c1,
+	0x25a00000003d8081, 0x25a00000274440c1, 0x25a0000002c100c1, 0x25a00000003d8081,
+	0x25a00000274440c1, 0x25a0000002c100c1, 0x25a00000003d8081, 0x25a00000274440c1,
+	0x25a0000002c100c1, 0x25a00000003d8081, 0x25a00000274440c1, 0x25a0000002c100c1,
+	0x25a00000003d8081, 0x25a00000274440c1, 0x25a0000002c100c1, 0x25a00000003d8081,
+	0x25a00000274440c1, 0x25a0000002c100c8, 0x25a000002754c0c8, 0x25a00000003d8081,
+	0x25a00000274440c1, 0x25a0000002c100c1, 0x25a00000003d8081, 0x25a00000274440c1,
+	0x25a0000002c100c1, 0x25a00000003d8081, 0x25a00000274440c1, 0x25a0000002c100c1,
+	0x25a00000003d8081, 0x25a0000002c100c1, 0x25a00000003d8081, 0x25a00000274440c1,
+	0x25a0000002c100c1, 0x25a00000003d8081, 0x25a00000274440c1, 0x25a0000002c100c1,
+	0x25a00000003d8081, 0x25a00000274440c1, 0x25a0000002c100c1, 0x25a00000003d8081,
+	0x25a00000274440c1, 0x25a0000002c100c1, 0x25a00000003d8081, 0x25a00000274440c1,
+	0x25a0000002c100c1, 0x25a00000003d8081, 0x25a00000274440c1, 0x25a0000002c100c1,
+	0x25a00000003d8081, 0x25a00000274440c1, 0x25a0000002c100c1, 0x25a00000003d8081,
+	0x25a0000c274440c1, 0x25a3000002c100c1, 0x25a30000003d8081, 0x25a30000274440c1,
+	0x25a3000002c100c1, 0x25a30000003d8081, 0x25a30000274440c1, 0x25a3000002c100c1,
+	0x25a300000f6b0241, 0x25a30000003d8081, 0x25a30000271f80c1, 0x25a300000bbb00c1,
+	0x25a3000023d58141, 0x25a30000018fc0c1, 0x25a30000274440c1, 0x25a3000002c100c1,
+	0x25a3000000adc0c1, 0x25a300000752c0c1, 0x25a3000013394241, 0x25a30000003d8081,
+	0x25a30000274440c1, 0x25a3000002c100c1, 0x25a30000003d8081, 0x25a30000274440c1,
+	0x25a3000002c100c1, 0x25a30000003d8081, 0x25a30000274440c1, 0x25a3000002c100c1,
+	0x25a30000003d8081, 0x25a30000274440c1, 0x25a3000002c100c1, 0x25a30000003d8081,
+	0x25a30000274440c1, 0x25a3000002c100c1, 0x25a30000003d8081, 0x25a3000002c100c1,
+	0x25a30000003d8081, 0x25a30000274440c1, 0x25a3000002c100c1, 0x25a30000003d8081,
+	0x25a30000274440c1, 0x25a3000002c100c1, 0x25a30000003d8081, 0x25a30000274440c1,
+	0x25a3000002c100c1, 0x25a30000003d8081, 0x25a30000274440c1, 0x25a3000002c100c1,
+	0x25a30000003d8081, 0x25a30000274440c1, 0x25a3000002c100c1, 0x25a30000003d8081,
+	0x25a30000274440c1, 0x25a3000002c100c1, 0x25a30000003d8081, 0x25a30000274440c1,
+	0x25a3000002c100c1, 0x25a30000003d8081, 0x25a30000274440c1, 0x25a3000002c100c1,
+	0x25a30000003d8081, 0x25a30000274440c1, 0x25a3000002c100c1, 0x25a30000003d8081,
+	0x25a30000274440c1, 0x25a3000002c100c1, 0x25a30000003d8081, 0x25a30000274440c1,
+	0x25a3000002c100c1, 0x25a30000003d8081, 0x25a3000002c100c1, 0x25a30000003d8081,
+	0x25a30000274440c1, 0x25a3000002c100c1, 0x25a30000003d8081, 0x25a3000002c100c1,
+	0x25a30000003d8081, 0x25a30000274440c1, 0x25a3000002c100c1, 0x25a30000003d8081,
+	0x25a30000274440c1, 0x25a3000002c100c1, 0x25a30000003d8081, 0x25a30000274440c1,
+	0x25a3000002c100c1, 0x25a30000003d8081, 0x25a30000274440c1, 0x25a3000002c100c1,
+	0x25a30000003d8081, 0x25a30000274440c1, 0x25a3000002c100c1, 0x25a30000003d8081,
+	0x25a30000274440c1, 0x25a3000002c100c1, 0x25a30000003d8081, 0x25a30000274440c1,
+	0x25a3000002c100c1, 0x25a30000003d8081, 0x25a30000274440c1, 0x25a3000002c100c1,
+	0x25a30000003d8081, 0x25a30000003d8081, 0x25a30000274440c1, 0x25a3000002c100c1,
+	0x25a3000013e54208, 0x25a30000000b4048, 0x25a3000013e54208, 0x25a30000083600c8,
+	0x25a300001d3181c1, 0x25a300040e7a4140, 0x25a4000025fd8108, 0x25a40004028b00c0,
+	0x25a5000014b94208, 0x25a5000014bb4208, 0x25a5000016f34208, 0x25a50004272b80c8,
+	0x25a60004274500c8, 0x25a7000000248088, 0x25a7000000248288, 0x25a700002778c0c8,
+	0x25a7000000374108, 0x25a7000003e240c8, 0x25a70004274500c8, 0x25a8000000248088,
+	0x25a8000000248288, 0x25a800002778c0c8, 0x25a80004274500c8, 0x25a9000000248088,
+	0x25a9000000248288, 0x25a900040c92c280, 0x25aa00000373c1d0, 0x25aa000800258080,
+	0x25ac0000118980d0, 0x25ac00081ecf4180, 0x25ae00081ecf4180, 0x25b000081ecf4180,
+	0x25b200040fff8240, 0x25b300081ecf4180, 0x25b500040fff8240, 0x25b6000000248088,
+	0x25b6000000248288, 0x25b600081ecf4180, 0x25b800081ecf4180, 0x25ba00040fff8240,
+	0x25bb000000248088, 0x25bb000000248288, 0x25bb00081ecf4180, 0x25bd00040fff8240,
+	0x25be00081ecf4180, 0x25c000040fff8240, 0x25c100081ecf4180, 0x25c300040fff8240,
+	0x25c4000000248088, 0x25c4000000248288, 0x25c400081ecf4180, 0x25c600040fff8240,
+	0x25c7000000248088, 0x25c7000000248288, 0x25c700081ecf4180, 0x25c900081ecf4180,
+	0x25cb00081ecf4180, 0x25cd00040fff8240, 0x25ce00081ecf4180, 0x25d000040fff8240,
+	0x25d1000000248088, 0x25d1000000248288, 0x25d100081ecf4180, 0x25d300040fff8240,
+	0x25d4000000248088, 0x25d4000000248288, 0x25d400081ecf4180, 0x25d600081ecf4180,
+	0x25d800040fff8240, 0x25d900081ecf4180, 0x25db00040fff8240, 0x25dc00081ecf4180,
+	0x25de00040fff8240, 0x25df000000248088, 0x25df000000248288, 0x25df00081ecf4180,
+	0x25e100081ecf4180, 0x25e3000002b60048, 0x25e30000272ac0c8, 0x25e300002772c0c8,
+	0x25e30000014f4148, 0x25e3000007938108, 0x25e300001d0081c8, 0x25e3000007938108,
+	0x25e300001d0081c8, 0x25e3000007938108, 0x25e3000007938108, 0x25e300001d0081c8,
+	0x25e3000415674200, 0x25e40000006a4108, 0x25e40000060780c8, 0x25e40000000f0048,
+	0x25e40000008d0188, 0x25e40000000f0048, 0x25e400000025c048, 0x25e40000008d0188,
+	0x25e40000263c8108, 0x25e40000248c0148, 0x25e40000248d4148, 0x25e40000269d8108,
+	0x25e40000269e8108, 0x25e4000026c48108, 0x25e4000005cc40c8, 0x25e40000274ec0c8,
+	0x25e4000001de00c8, 0x25e400000fcd40c8, 0x25e4000000e040c8, 0x25e4000400118080,
+	0x25e50000021a8088, 0x25e500000dd28088, 0x25e5000002b60048, 0x25e5000007938108,
+	0x25e5000025278101, 0x25e5000021154181, 0x25e50000275ac0c1, 0x25e5000025bf8148,
+	0x25e50000273cc0c8, 0x25e5000004bac248, 0x25e5000024d5c148, 0x25e5000024d5c148,
+	0x25e5000024d5c148, 0x25e5000000248088, 0x25e500000c92c288, 0x25e5000004bd0388,
+	0x25e50000277500c8, 0x25e5000005880388, 0x25e50000277500c8, 0x25e5000005880388,
+	0x25e50000277500c8, 0x25e5000005880388, 0x25e5000000248088, 0x25e50000277500c8,
+	0x25e5000005880388, 0x25e5000000248088, 0x25e50000277500c8, 0x25e5000005880388,
+	0x25e50000277500c8, 0x25e5000005880388, 0x25e5000000248088, 0x25e50000277500c8,
+	0x25e5000005880388, 0x25e5000000248088, 0x25e50000277500c8, 0x25e5000005880388,
+	0x25e5000000248088, 0x25e50000277500c8, 0x25e5000005880388, 0x25e5000000248088,
+	0x25e50000277500c8, 0x25e5000005880388, 0x25e5000000248088, 0x25e50000277500c8,
+	0x25e5000005880388, 0x25e50000277500c8, 0x25e5000005880388, 0x25e50000277500c8,
+	0x25e5000005880388, 0x25e5000000248088, 0x25e50000277500c8, 0x25e5000005880388,
+	0x25e5000000248088, 0x25e50000277500c8, 0x25e5000005880388, 0x25e5000000248088,
+	0x25e50000277500c8, 0x25e5000005880388, 0x25e50000277500c8, 0x25e5000005880388,
+	0x25e5000000248088, 0x25e50000277500c8, 0x25e5000005880388, 0x25e5000000248088,
+	0x25e50000277500c8, 0x25e5000005880388, 0x25e5000000248088, 0x25e50000277500c8,
+	0x25e5000005880388, 0x25e50000277500c8, 0x25e5000005880388, 0x25e5000001b98148,
+	0x25e5000000e040c8,
+}
@@ -0,0 +1,86 @@
+// Package table reads the suffix table, a trie of the rules of tlds.TLDs, tlds.WildcardTLDs,
+// tlds.ExceptionTLDs and the private TLDs, precomputed by generate/tlds into constant data, so
+// using it needs no construction at runtime. Its layout is internal to the hqgourl module.
+package table
+
+// Node is a node of the suffix table, whose rules are keyed by their labels, in their Unicode form,
+// in reverse order: "co.uk" is the child "co" of the child "uk" of Root.
+//
+// A node is the index of its entry in nodes, a uint64 holding, from its least significant bit:
+// 3 bits of the rules of the ICANN section, 3 bits of the rules of the private section, 8 bits of
+// the length of its label, 20 bits of the offset of its label in text, 14 bits of its number of
+// children and 16 bits of the index of its first child. The children of a node are contiguous and
+// sorted by label.
+type Node uint32
+
+// Root is the root of the suffix table, the parent of the TLDs' last labels.
+const Root Node = 0
+
+// Rule bits, as returned by Node.Rules.
+const (
+	// RuleNormal marks a suffix declared by a normal rule, e.g. "co.uk".
+	RuleNormal uint8 = 1 << iota
+	// RuleWildcard marks a suffix declared by a wildcard rule, e.g. "ck" for "*.ck".
+	RuleWildcard
+	// RuleException marks a suffix declared by an exception rule, e.g. "www.ck" for "!www.ck".
+	RuleException
+)
+
+const (
+	tableRulesMask       = 1<<3 - 1
+	tablePrivateShift    = 3
+	tableLabelLengthMask = 1<<8 - 1
+	tableLabelLength     = 6
+	tableLabelOffsetMask = 1<<20 - 1
+	tableLabelOffset     = 14
+	tableChildrenMask    = 1<<14 - 1
+	tableChildren        = 34
+	tableFirstChild      = 48
+)
+
+// Rules returns the rules declared for the node's suffix, in the ICANN and in the private section.
+func (n Node) Rules() (ICANN, private uint8) {
+	node := nodes[n]
+
+	ICANN = uint8(node & tableRulesMask)
+	private = uint8(node >> tablePrivateShift & tableRulesMask)
+
+	return
+}
+
+// Label returns the node's label, "" for Root.
+func (n Node) Label() (label string) {
+	node := nodes[n]
+
+	offset := node >> tableLabelOffset & tableLabelOffsetMask
+	length := node >> tableLabelLength & tableLabelLengthMask
+
+	return text[offset : offset+length]
+}
+
+// Child returns the child of the node with label, in its Unicode form, lowercased, and whether
+// there is one.
+func (n Node) Child(label string) (child Node, ok bool) {
+	node := nodes[n]
+
+	first := Node(node >> tableFirstChild)
+	count := Node(node >> tableChildren & tableChildrenMask)
+
+	// Binary search of the children, sorted by label.
+	low, high := first, first+count
+
+	for low < high {
+		middle := low + (high-low)/2
+
+		switch childLabel := middle.Label(); {
+		case childLabel < label:
+			low = middle + 1
+		case childLabel > label:
+			high = middle
+		default:
+			return middle, true
+		}
+	}
+
+	return
+}
@@ -0,0 +1,144 @@
+package hqgourl
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"encoding/base32"
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/sha3"
+)
+
+// OnionAddress is a Tor onion service address, as decoded from a ".onion" domain. Version 3
+// addresses (https://spec.torproject.org/rend-spec/encoding-onion-addresses.html) embed the
+// ed25519 public key of the onion service, with a checksum and a version byte.
+type OnionAddress struct {
+	Version   int               // Version of the address, 2 or 3.
+	PublicKey ed25519.PublicKey // Public key of the onion service, version 3 only.
+	Label     string            // Lowercase base32 label of the address, version 2 only.
+}
+
+// String returns the ".onion" domain of the address: for version 3, encoding its public key,
+// checksum and version byte, for version 2, its label. It returns "" for addresses of neither
+// version, or without their public key or label.
+func (a *OnionAddress) String() (domain string) {
+	switch {
+	case a.Version == 2 && a.Label != "":
+		return a.Label + ".onion"
+	case a.Version != 3 || len(a.PublicKey) != ed25519.PublicKeySize:
+		return
+	}
+
+	data := make([]byte, 0, onionV3Length)
+
+	data = append(data, a.PublicKey...)
+	data = append(data, onionChecksum(a.PublicKey, byte(a.Version))...)
+	data = append(data, byte(a.Version))
+
+	domain = strings.ToLower(onionEncoding.EncodeToString(data)) + ".onion"
+
+	return
+}
+
+const (
+	// onionV3Length is the length of a decoded version 3 address: public key, checksum and version.
+	onionV3Length = ed25519.PublicKeySize + 2 + 1
+	// onionV3LabelLength is the length of the base32 label of a version 3 address.
+	onionV3LabelLength = 56
+	// onionV2LabelLength is the length of the base32 label of a version 2 address.
+	onionV2LabelLength = 16
+)
+
+var (
+	// ErrOnionNotOnion is returned for domains not under ".onion".
+	ErrOnionNotOnion = errors.New("not an .onion domain")
+	// ErrOnionInvalidLength is returned for onion labels neither of version 3 nor of version 2 length.
+	ErrOnionInvalidLength = errors.New("onion label of invalid length")
+	// ErrOnionInvalidEncoding is returned for onion labels that are not valid base32.
+	ErrOnionInvalidEncoding = errors.New("onion label not in base32")
+	// ErrOnionInvalidVersion is returned for version 3 length addresses with a version byte other than 3.
+	ErrOnionInvalidVersion = errors.New("onion address of invalid version")
+	// ErrOnionInvalidChecksum is returned for version 3 addresses with a checksum not matching their
+	// public key, e.g. because of a typo.
+	ErrOnionInvalidChecksum = errors.New("onion address with invalid checksum")
+	// ErrOnionV2Deprecated is returned, along with the address, for version 2 addresses. They are no
+	// longer supported by Tor.
+	ErrOnionV2Deprecated = errors.New("deprecated version 2 onion address")
+
+	onionEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+)
+
+// ParseOnionAddress decodes and validates the onion address of domain, e.g.
+// "duckduckgogg42xjoc72x3sjasowoarfbgcmvfimaftt6twagswzczad.onion", or a subdomain of it. For version 3
+// addresses it checks the base32 encoding, the checksum and the version byte, and returns the embedded
+// public key. Version 2 addresses are returned with ErrOnionV2Deprecated. Other errors wrap one of the
+// ErrOnion* sentinel errors.
+func ParseOnionAddress(domain string) (address *OnionAddress, err error) {
+	labels := strings.Split(strings.TrimSuffix(domain, "."), ".")
+
+	if len(labels) < 2 || !strings.EqualFold(labels[len(labels)-1], "onion") {
+		err = fmt.Errorf("invalid onion address %q: %w", domain, ErrOnionNotOnion)
+
+		return
+	}
+
+	label := strings.ToUpper(labels[len(labels)-2])
+
+	if len(label) != onionV3LabelLength && len(label) != onionV2LabelLength {
+		err = fmt.Errorf("invalid onion address %q: %w", domain, ErrOnionInvalidLength)
+
+		return
+	}
+
+	data, err := onionEncoding.DecodeString(label)
+	if err != nil {
+		err = fmt.Errorf("invalid onion address %q: %w", domain, ErrOnionInvalidEncoding)
+
+		return
+	}
+
+	if len(label) == onionV2LabelLength {
+		address = &OnionAddress{Version: 2, Label: strings.ToLower(label)}
+
+		err = fmt.Errorf("invalid onion address %q: %w", domain, ErrOnionV2Deprecated)
+
+		return
+	}
+
+	publicKey, checksum, version := data[:ed25519.PublicKeySize], data[ed25519.PublicKeySize:onionV3Length-1], data[onionV3Length-1]
+
+	if version != 3 {
+		err = fmt.Errorf("invalid onion address %q: %w", domain, ErrOnionInvalidVersion)
+
+		return
+	}
+
+	if !bytes.Equal(checksum, onionChecksum(publicKey, version)) {
+		err = fmt.Errorf("invalid onion address %q: %w", domain, ErrOnionInvalidChecksum)
+
+		return
+	}
+
+	address = &OnionAddress{
+		Version:   int(version),
+		PublicKey: ed25519.PublicKey(publicKey),
+	}
+
+	return
+}
+
+// onionChecksum returns the checksum of a version 3 address: the first two bytes of
+// SHA3-256(".onion checksum" | public key | version).
+func onionChecksum(publicKey []byte, version byte) (checksum []byte) {
+	h := sha3.New256()
+
+	h.Write([]byte(".onion checksum"))
+	h.Write(publicKey)
+	h.Write([]byte{version})
+
+	checksum = h.Sum(nil)[:2]
+
+	return
+}
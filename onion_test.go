@@ -0,0 +1,99 @@
+package hqgourl_test
+
+import (
+	"crypto/ed25519"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/hueristiq/hqgourl"
+)
+
+func TestParseOnionAddress(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		domain          string
+		expectedVersion int
+		expectedErr     error
+	}{
+		{"duckduckgogg42xjoc72x3sjasowoarfbgcmvfimaftt6twagswzczad.onion", 3, nil},
+		{"www.DUCKDUCKGOGG42XJOC72X3SJASOWOARFBGCMVFIMAFTT6TWAGSWZCZAD.onion.", 3, nil},
+		{"euckduckgogg42xjoc72x3sjasowoarfbgcmvfimaftt6twagswzczad.onion", 0, hqgourl.ErrOnionInvalidChecksum},
+		{"duckduckgogg42xjoc72x3sjasowoarfbgcmvfimaftt6twagswzczaa.onion", 0, hqgourl.ErrOnionInvalidVersion},
+		{"duckduckgogg42xjoc72x3sjasowoarfbgcmvfimaftt6twagswzcza.onion", 0, hqgourl.ErrOnionInvalidLength},
+		{"duckduckgogg42xjoc72x3sjasowoarfbgcmvfimaftt6twagswzcza1.onion", 0, hqgourl.ErrOnionInvalidEncoding},
+		{"3g2upl4pq6kufc4m.onion", 2, hqgourl.ErrOnionV2Deprecated},
+		{"duckduckgo.com", 0, hqgourl.ErrOnionNotOnion},
+		{"onion", 0, hqgourl.ErrOnionNotOnion},
+	}
+
+	for _, c := range cases {
+		c := c
+
+		t.Run(fmt.Sprintf("ParseOnionAddress(%q)", c.domain), func(t *testing.T) {
+			t.Parallel()
+
+			address, err := hqgourl.ParseOnionAddress(c.domain)
+
+			if !errors.Is(err, c.expectedErr) {
+				t.Fatalf("ParseOnionAddress(%q) error = %v, want %v", c.domain, err, c.expectedErr)
+			}
+
+			var version int
+
+			if address != nil {
+				version = address.Version
+			}
+
+			if version != c.expectedVersion {
+				t.Errorf("ParseOnionAddress(%q).Version = %d, want %d", c.domain, version, c.expectedVersion)
+			}
+		})
+	}
+}
+
+func TestOnionAddress_String(t *testing.T) {
+	t.Parallel()
+
+	publicKey, _, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	domain := (&hqgourl.OnionAddress{Version: 3, PublicKey: publicKey}).String()
+
+	address, err := hqgourl.ParseOnionAddress(domain)
+	if err != nil {
+		t.Fatalf("ParseOnionAddress(%q) error = %v", domain, err)
+	}
+
+	if !publicKey.Equal(address.PublicKey) {
+		t.Errorf("ParseOnionAddress(%q).PublicKey = %x, want %x", domain, address.PublicKey, publicKey)
+	}
+}
+
+func TestOnionAddress_StringOfV2AndInvalidAddresses(t *testing.T) {
+	t.Parallel()
+
+	address, err := hqgourl.ParseOnionAddress("www.3G2UPL4PQ6KUFC4M.onion")
+	if !errors.Is(err, hqgourl.ErrOnionV2Deprecated) {
+		t.Fatalf("ParseOnionAddress(%q) error = %v, want %v", "www.3G2UPL4PQ6KUFC4M.onion", err, hqgourl.ErrOnionV2Deprecated)
+	}
+
+	cases := []struct {
+		address        *hqgourl.OnionAddress
+		expectedDomain string
+	}{
+		{address, "3g2upl4pq6kufc4m.onion"},
+		{&hqgourl.OnionAddress{Version: 2}, ""},
+		{&hqgourl.OnionAddress{Version: 3}, ""},
+		{&hqgourl.OnionAddress{}, ""},
+	}
+
+	for _, c := range cases {
+		if domain := c.address.String(); domain != c.expectedDomain {
+			t.Errorf("%+v.String() = %q, want %q", c.address, domain, c.expectedDomain)
+		}
+	}
+}
@@ -0,0 +1,318 @@
+package hqgourl
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/hueristiq/hqgourl/tlds"
+)
+
+// PublicSuffixList is a set of public suffix rules, read from a list in the format of
+// https://publicsuffix.org/list/public_suffix_list.dat. It supports the full rule syntax:
+// normal rules ("co.uk"), wildcard rules ("*.ck") and exception rules ("!www.ck"), with
+// rules in the "===BEGIN ICANN DOMAINS===" and "===BEGIN PRIVATE DOMAINS===" sections
+// recorded as such. A PublicSuffixList is read-only, so it is safe to share between
+// DomainParsers and goroutines. It is the built-in SuffixMatcher.
+type PublicSuffixList struct {
+	trie     *suffixTrie
+	table    *suffixTable
+	snapshot *tlds.Snapshot
+}
+
+// Snapshot returns the snapshot of the public suffix list the PublicSuffixList is built from, and
+// whether it is built from one at all: lists of custom TLDs, or of pseudo-TLDs only, are not.
+func (list *PublicSuffixList) Snapshot() (snapshot tlds.Snapshot, ok bool) {
+	if list.snapshot == nil {
+		return
+	}
+
+	return *list.snapshot, true
+}
+
+// MatchSuffix runs the public suffix list algorithm over labels: the longest matching rule wins,
+// a wildcard rule ("*.ck") matches any single label under its suffix, and an exception rule
+// ("!www.ck") overrides wildcards and makes its parent the TLD.
+func (list *PublicSuffixList) MatchSuffix(labels []string) (length int, section TLDSection) {
+	if list.table != nil {
+		return list.table.MatchSuffix(labels)
+	}
+
+	labelsLength := len(labels)
+	labelsLastIndex := labelsLength - 1
+
+	node := list.trie
+
+	// Walk down the trie, label by label, from the last label of the domain.
+	for i := labelsLastIndex; i >= 0; i-- {
+		child, ok := node.children[labels[i]]
+		if !ok {
+			break
+		}
+
+		node = child
+
+		// An exception rule always prevails, its TLD being the rule minus its leftmost label.
+		if node.rule&suffixRuleException != 0 {
+			length = labelsLastIndex - i
+			section = node.section
+
+			break
+		}
+
+		if node.rule&suffixRuleNormal != 0 {
+			length = labelsLength - i
+			section = node.section
+		}
+
+		// A wildcard rule matches the label preceding the current TLD, if any.
+		if i > 0 && node.rule&suffixRuleWildcard != 0 {
+			length = labelsLength - i + 1
+			section = node.section
+		}
+	}
+
+	return
+}
+
+// NewPublicSuffixList creates a PublicSuffixList of the TLDs built into the package, from the
+// given sections: TLDSectionICANN for tlds.TLDs with their wildcard and exception rules,
+// TLDSectionPrivate for the private domains section, and TLDSectionNone for tlds.PseudoTLDs. Its
+// snapshot is tlds.PublicSuffixListSnapshot, if built from either section of the list. It matches
+// against the suffix table precomputed into the tlds package, so it needs no construction. A suffix
+// in several of the sections is reported in the last of TLDSectionNone, TLDSectionICANN and
+// TLDSectionPrivate.
+func NewPublicSuffixList(sections ...TLDSection) (list *PublicSuffixList) {
+	table := &suffixTable{}
+
+	for _, section := range sections {
+		switch section {
+		case TLDSectionNone:
+			table.withPseudoTLDs = true
+		case TLDSectionICANN:
+			table.withICANNTLDs = true
+		case TLDSectionPrivate:
+			table.withPrivateTLDs = true
+		}
+	}
+
+	list = &PublicSuffixList{
+		table:    table,
+		snapshot: builtInSnapshot(sections...),
+	}
+
+	return
+}
+
+// sharedPublicSuffixLists are the PublicSuffixLists of the built-in TLDs of DomainParsers with the
+// default options, without and with the private TLDs. Being read-only, they are built once, on
+// first use, and shared by all these DomainParsers.
+var (
+	sharedPublicSuffixListsOnce sync.Once
+	sharedPublicSuffixLists     [2]*PublicSuffixList
+)
+
+// sharedPublicSuffixList returns the shared PublicSuffixList of the pseudo and ICANN TLDs, and of
+// the private TLDs too if withPrivateTLDs.
+func sharedPublicSuffixList(withPrivateTLDs bool) (list *PublicSuffixList) {
+	sharedPublicSuffixListsOnce.Do(func() {
+		sharedPublicSuffixLists[0] = NewPublicSuffixList(TLDSectionNone, TLDSectionICANN)
+		sharedPublicSuffixLists[1] = NewPublicSuffixList(TLDSectionNone, TLDSectionICANN, TLDSectionPrivate)
+	})
+
+	if withPrivateTLDs {
+		return sharedPublicSuffixLists[1]
+	}
+
+	return sharedPublicSuffixLists[0]
+}
+
+// newPublicSuffixListTrie creates a PublicSuffixList of the TLDs built into the package, from the
+// given sections, as NewPublicSuffixList, but in a trie of its own that can be modified.
+func newPublicSuffixListTrie(sections ...TLDSection) (list *PublicSuffixList) {
+	list = &PublicSuffixList{
+		trie:     &suffixTrie{},
+		snapshot: builtInSnapshot(sections...),
+	}
+
+	table := NewPublicSuffixList(sections...).table
+
+	// Sections are added in order of precedence, as in the suffix table.
+	if table.withPseudoTLDs {
+		list.trie.addRules(TLDSectionNone, tlds.PseudoTLDs, nil, nil)
+	}
+
+	if table.withICANNTLDs {
+		list.trie.addRules(TLDSectionICANN, tlds.TLDs, tlds.WildcardTLDs, tlds.ExceptionTLDs)
+	}
+
+	if table.withPrivateTLDs {
+		list.trie.addRules(TLDSectionPrivate, tlds.PrivateTLDs, tlds.PrivateWildcardTLDs, tlds.PrivateExceptionTLDs)
+	}
+
+	return
+}
+
+// builtInSnapshot returns the snapshot of a PublicSuffixList of the TLDs built into the package,
+// from the given sections: tlds.PublicSuffixListSnapshot, unless built from pseudo-TLDs only.
+func builtInSnapshot(sections ...TLDSection) (snapshot *tlds.Snapshot) {
+	for _, section := range sections {
+		if section != TLDSectionNone {
+			builtIn := tlds.PublicSuffixListSnapshot
+
+			return &builtIn
+		}
+	}
+
+	return
+}
+
+// ReadPublicSuffixList reads a public suffix list from r. Rules of both the ICANN and the
+// private domains sections are read. Rules outside of any section are read as TLDSectionNone.
+// Its snapshot has the SHA-256 hash of the list read and the version the list declares in its
+// "// VERSION:" header, if any.
+func ReadPublicSuffixList(r io.Reader) (list *PublicSuffixList, err error) {
+	trie := &suffixTrie{}
+
+	section := TLDSectionNone
+
+	snapshot := &tlds.Snapshot{}
+
+	hash := sha256.New()
+
+	scanner := bufio.NewScanner(io.TeeReader(r, hash))
+
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+
+		if version, ok := strings.CutPrefix(text, "// VERSION:"); ok && snapshot.Version == "" {
+			snapshot.Version = strings.TrimSpace(version)
+
+			continue
+		}
+
+		switch {
+		case strings.HasPrefix(text, "// ===BEGIN ICANN DOMAINS==="):
+			section = TLDSectionICANN
+
+			continue
+		case strings.HasPrefix(text, "// ===BEGIN PRIVATE DOMAINS==="):
+			section = TLDSectionPrivate
+
+			continue
+		case strings.HasPrefix(text, "// ===END "):
+			section = TLDSectionNone
+
+			continue
+		case text == "", strings.HasPrefix(text, "//"):
+			continue
+		}
+
+		// Rules are terminated by the first whitespace.
+		rule := strings.Fields(text)[0]
+
+		if err = addPublicSuffixRule(trie, rule, section); err != nil {
+			err = fmt.Errorf("error reading public suffix list, line %d: %w", line, err)
+
+			return
+		}
+	}
+
+	if err = scanner.Err(); err != nil {
+		err = fmt.Errorf("error reading public suffix list: %w", err)
+
+		return
+	}
+
+	snapshot.SHA256 = hex.EncodeToString(hash.Sum(nil))
+
+	list = &PublicSuffixList{
+		trie:     trie,
+		snapshot: snapshot,
+	}
+
+	return
+}
+
+// ReadPublicSuffixListFile reads a public suffix list from the file at path. Its snapshot has path
+// as source and the file's modification date as date.
+func ReadPublicSuffixListFile(path string) (list *PublicSuffixList, err error) {
+	var f *os.File
+
+	f, err = os.Open(path)
+	if err != nil {
+		err = fmt.Errorf("error opening public suffix list: %w", err)
+
+		return
+	}
+
+	defer f.Close()
+
+	var info os.FileInfo
+
+	if info, err = f.Stat(); err != nil {
+		err = fmt.Errorf("error opening public suffix list: %w", err)
+
+		return
+	}
+
+	if list, err = ReadPublicSuffixList(f); err != nil {
+		return
+	}
+
+	list.snapshot.Source = path
+	list.snapshot.Date = info.ModTime().UTC().Format("2006-01-02")
+
+	return
+}
+
+// splitPublicSuffixRule splits rule into its suffix and its type, i.e. "*.ck" into "ck" and
+// suffixRuleWildcard, "!www.ck" into "www.ck" and suffixRuleException.
+func splitPublicSuffixRule(rule string) (suffix string, kind suffixRule) {
+	switch {
+	case strings.HasPrefix(rule, "!"):
+		suffix, kind = rule[1:], suffixRuleException
+	case strings.HasPrefix(rule, "*."):
+		suffix, kind = rule[2:], suffixRuleWildcard
+	default:
+		suffix, kind = rule, suffixRuleNormal
+	}
+
+	return
+}
+
+// addPublicSuffixRule parses rule and inserts it into trie.
+func addPublicSuffixRule(trie *suffixTrie, rule string, section TLDSection) (err error) {
+	suffix, kind := splitPublicSuffixRule(rule)
+
+	for _, label := range strings.Split(suffix, ".") {
+		switch {
+		case label == "":
+			err = fmt.Errorf("invalid rule %q: empty label", rule)
+		case strings.Contains(label, "*"):
+			err = fmt.Errorf("invalid rule %q: wildcard not in leftmost label", rule)
+		case strings.Contains(label, "!"):
+			err = fmt.Errorf("invalid rule %q: exception marker not at rule start", rule)
+		}
+
+		if err != nil {
+			return
+		}
+	}
+
+	// An exception rule overrides a wildcard, so it needs at least two labels.
+	if kind == suffixRuleException && !strings.Contains(suffix, ".") {
+		err = fmt.Errorf("invalid rule %q: exception of a single label", rule)
+
+		return
+	}
+
+	trie.add(suffix, kind, section)
+
+	return
+}
@@ -0,0 +1,357 @@
+package hqgourl_test
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hueristiq/hqgourl"
+	"github.com/hueristiq/hqgourl/tlds"
+)
+
+const testPublicSuffixList = `// Comments and blank lines are ignored.
+
+// ===BEGIN ICANN DOMAINS===
+
+// ck : https://www.iana.org/domains/root/db/ck.html
+*.ck
+!www.ck
+
+uk
+co.uk   trailing text is ignored
+
+// ===END ICANN DOMAINS===
+// ===BEGIN PRIVATE DOMAINS===
+
+github.io
+
+// ===END PRIVATE DOMAINS===
+
+corp.internal
+`
+
+func TestReadPublicSuffixList(t *testing.T) {
+	t.Parallel()
+
+	list, err := hqgourl.ReadPublicSuffixList(strings.NewReader(testPublicSuffixList))
+	if err != nil {
+		t.Fatalf("ReadPublicSuffixList() error = %v", err)
+	}
+
+	cases := []struct {
+		rawDomain            string
+		expectedParsedDomain *hqgourl.Domain
+	}{
+		{
+			"www.example.co.uk",
+			&hqgourl.Domain{
+				Sub:             "www",
+				Root:            "example",
+				TopLevel:        "co.uk",
+				TopLevelSection: hqgourl.TLDSectionICANN,
+				TopLevelKnown:   true,
+			},
+		},
+		{
+			"www.example.ck",
+			&hqgourl.Domain{
+				Sub:             "",
+				Root:            "www",
+				TopLevel:        "example.ck",
+				TopLevelSection: hqgourl.TLDSectionICANN,
+				TopLevelKnown:   true,
+			},
+		},
+		{
+			"www.ck",
+			&hqgourl.Domain{
+				Sub:             "",
+				Root:            "www",
+				TopLevel:        "ck",
+				TopLevelSection: hqgourl.TLDSectionICANN,
+				TopLevelKnown:   true,
+			},
+		},
+		{
+			"example.github.io",
+			&hqgourl.Domain{
+				Sub:             "",
+				Root:            "example",
+				TopLevel:        "github.io",
+				TopLevelSection: hqgourl.TLDSectionPrivate,
+				TopLevelKnown:   true,
+			},
+		},
+		{
+			"www.example.corp.internal",
+			&hqgourl.Domain{
+				Sub:             "www",
+				Root:            "example",
+				TopLevel:        "corp.internal",
+				TopLevelSection: hqgourl.TLDSectionNone,
+				TopLevelKnown:   true,
+			},
+		},
+		{
+			"www.example.com",
+			&hqgourl.Domain{
+				Sub:             "www",
+				Root:            "example",
+				TopLevel:        "com",
+				TopLevelSection: hqgourl.TLDSectionNone,
+			},
+		},
+	}
+
+	dp := hqgourl.NewDomainParser(
+		hqgourl.DomainParserWithSuffixMatcher(list),
+	)
+
+	for _, c := range cases {
+		c := c
+
+		t.Run(fmt.Sprintf("Parse(%q)", c.rawDomain), func(t *testing.T) {
+			t.Parallel()
+
+			parsedDomain, err := dp.Parse(c.rawDomain)
+			if err != nil {
+				t.Fatalf("Parse(%q) error = %v", c.rawDomain, err)
+			}
+
+			if !reflect.DeepEqual(parsedDomain, c.expectedParsedDomain) {
+				t.Errorf("Parse(%q) = %+v, want %+v", c.rawDomain, parsedDomain, c.expectedParsedDomain)
+			}
+		})
+	}
+}
+
+func TestReadPublicSuffixListInvalidRules(t *testing.T) {
+	t.Parallel()
+
+	rules := []string{
+		"co..uk",
+		".uk",
+		"foo.*.uk",
+		"*",
+		"www.!ck",
+		"!ck",
+	}
+
+	for _, rule := range rules {
+		rule := rule
+
+		t.Run(fmt.Sprintf("ReadPublicSuffixList(%q)", rule), func(t *testing.T) {
+			t.Parallel()
+
+			if _, err := hqgourl.ReadPublicSuffixList(strings.NewReader(rule)); err == nil {
+				t.Errorf("ReadPublicSuffixList(%q) error = nil, want error", rule)
+			}
+		})
+	}
+}
+
+func TestReadPublicSuffixListFile(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "public_suffix_list.dat")
+
+	if err := os.WriteFile(path, []byte(testPublicSuffixList), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	list, err := hqgourl.ReadPublicSuffixListFile(path)
+	if err != nil {
+		t.Fatalf("ReadPublicSuffixListFile(%q) error = %v", path, err)
+	}
+
+	dp := hqgourl.NewDomainParser(
+		hqgourl.DomainParserWithSuffixMatcher(list),
+	)
+
+	if parsedDomain, _ := dp.Parse("example.co.uk"); parsedDomain.TopLevel != "co.uk" {
+		t.Errorf("Parse(%q).TopLevel = %q, want %q", "example.co.uk", parsedDomain.TopLevel, "co.uk")
+	}
+
+	if _, err := hqgourl.ReadPublicSuffixListFile(filepath.Join(t.TempDir(), "missing.dat")); err == nil {
+		t.Error("ReadPublicSuffixListFile() of a missing file error = nil, want error")
+	}
+}
+
+func TestDomainParser_SetSuffixMatcher(t *testing.T) {
+	t.Parallel()
+
+	dp := hqgourl.NewDomainParser()
+
+	if parsedDomain, _ := dp.Parse("www.example.corp.internal"); parsedDomain.TopLevel != "internal" {
+		t.Errorf("Parse(%q).TopLevel = %q, want %q", "www.example.corp.internal", parsedDomain.TopLevel, "internal")
+	}
+
+	list, err := hqgourl.ReadPublicSuffixList(strings.NewReader(testPublicSuffixList))
+	if err != nil {
+		t.Fatalf("ReadPublicSuffixList() error = %v", err)
+	}
+
+	done := make(chan struct{})
+
+	// Parse concurrently with the swap, the race detector checks it is safe.
+	go func() {
+		defer close(done)
+
+		for i := 0; i < 100; i++ {
+			_, _ = dp.Parse("www.example.corp.internal")
+		}
+	}()
+
+	dp.SetSuffixMatcher(list)
+
+	<-done
+
+	if parsedDomain, _ := dp.Parse("www.example.corp.internal"); parsedDomain.TopLevel != "corp.internal" {
+		t.Errorf("Parse(%q).TopLevel = %q, want %q", "www.example.corp.internal", parsedDomain.TopLevel, "corp.internal")
+	}
+}
+
+func TestDomainParser_Snapshot(t *testing.T) {
+	t.Parallel()
+
+	versionedPublicSuffixList := "// VERSION: 2024-06-01_09-14-25_UTC\n" + testPublicSuffixList
+
+	hash := sha256.Sum256([]byte(versionedPublicSuffixList))
+
+	list, err := hqgourl.ReadPublicSuffixList(strings.NewReader(versionedPublicSuffixList))
+	if err != nil {
+		t.Fatalf("ReadPublicSuffixList() error = %v", err)
+	}
+
+	path := filepath.Join(t.TempDir(), "public_suffix_list.dat")
+
+	if err = os.WriteFile(path, []byte(versionedPublicSuffixList), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	file, err := hqgourl.ReadPublicSuffixListFile(path)
+	if err != nil {
+		t.Fatalf("ReadPublicSuffixListFile(%q) error = %v", path, err)
+	}
+
+	cases := []struct {
+		name             string
+		dp               *hqgourl.DomainParser
+		expectedSnapshot tlds.Snapshot
+		expectedOK       bool
+	}{
+		{
+			name:             "Built-in TLDs",
+			dp:               hqgourl.NewDomainParser(),
+			expectedSnapshot: tlds.PublicSuffixListSnapshot,
+			expectedOK:       true,
+		},
+		{
+			name: "Built-in TLDs with additional TLDs",
+			dp:   hqgourl.NewDomainParser(hqgourl.DomainParserWithAdditionalTLDs("corp.internal")),
+		},
+		{
+			name: "Built-in TLDs without TLDs",
+			dp:   hqgourl.NewDomainParser(hqgourl.DomainParserWithoutTLDs("com")),
+		},
+		{
+			name:             "Built-in TLDs with private TLDs",
+			dp:               hqgourl.NewDomainParser(hqgourl.DomainParserWithPrivateTLDs()),
+			expectedSnapshot: tlds.PublicSuffixListSnapshot,
+			expectedOK:       true,
+		},
+		{
+			name: "Custom TLDs",
+			dp:   hqgourl.NewDomainParser(hqgourl.DomainParserWithTLDs("com")),
+		},
+		{
+			name: "Read list",
+			dp:   hqgourl.NewDomainParser(hqgourl.DomainParserWithSuffixMatcher(list)),
+			expectedSnapshot: tlds.Snapshot{
+				Version: "2024-06-01_09-14-25_UTC",
+				SHA256:  hex.EncodeToString(hash[:]),
+			},
+			expectedOK: true,
+		},
+		{
+			name: "Read list file",
+			dp:   hqgourl.NewDomainParser(hqgourl.DomainParserWithSuffixMatcher(file)),
+			expectedSnapshot: tlds.Snapshot{
+				Source:  path,
+				Version: "2024-06-01_09-14-25_UTC",
+				Date:    info.ModTime().UTC().Format("2006-01-02"),
+				SHA256:  hex.EncodeToString(hash[:]),
+			},
+			expectedOK: true,
+		},
+		{
+			name: "Composite matcher",
+			dp:   hqgourl.NewDomainParser(hqgourl.DomainParserWithSuffixMatcher(hqgourl.NewCompositeSuffixMatcher(list))),
+		},
+	}
+
+	for _, c := range cases {
+		c := c
+
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			snapshot, ok := c.dp.Snapshot()
+
+			if snapshot != c.expectedSnapshot || ok != c.expectedOK {
+				t.Errorf("Snapshot() = %+v, %v, want %+v, %v", snapshot, ok, c.expectedSnapshot, c.expectedOK)
+			}
+		})
+	}
+}
+
+func TestEmbeddedPublicSuffixList(t *testing.T) {
+	t.Parallel()
+
+	if tlds.PublicSuffixListSnapshot.SHA256 == "" {
+		t.Fatal("tlds.PublicSuffixListSnapshot.SHA256 is empty, run go generate")
+	}
+
+	hash := sha256.Sum256([]byte(tlds.PublicSuffixListData))
+
+	if got := hex.EncodeToString(hash[:]); got != tlds.PublicSuffixListSnapshot.SHA256 {
+		t.Errorf("SHA-256 of tlds.PublicSuffixListData = %s, want %s", got, tlds.PublicSuffixListSnapshot.SHA256)
+	}
+
+	list, err := hqgourl.ReadPublicSuffixList(strings.NewReader(tlds.PublicSuffixListData))
+	if err != nil {
+		t.Fatalf("ReadPublicSuffixList(tlds.PublicSuffixListData) error = %v", err)
+	}
+
+	if snapshot, _ := list.Snapshot(); snapshot.SHA256 != tlds.PublicSuffixListSnapshot.SHA256 {
+		t.Errorf("SHA-256 of the list read from tlds.PublicSuffixListData = %s, want %s", snapshot.SHA256, tlds.PublicSuffixListSnapshot.SHA256)
+	}
+
+	// The built-in TLDs are generated from the embedded list. They also hold the TLDs of its rules,
+	// e.g. "ck" of "*.ck", which the list leaves to the implicit "*" rule, so only suffixes the list
+	// matches are compared.
+	builtIn := hqgourl.NewPublicSuffixList(hqgourl.TLDSectionICANN, hqgourl.TLDSectionPrivate)
+
+	for _, domain := range suffixTableDomains() {
+		labels := strings.Split(domain, ".")
+
+		length, section := list.MatchSuffix(labels)
+		if length == 0 {
+			continue
+		}
+
+		if builtInLength, builtInSection := builtIn.MatchSuffix(labels); builtInLength != length || builtInSection != section {
+			t.Errorf("MatchSuffix(%q) = %d, %v with the built-in TLDs, want %d, %v", domain, builtInLength, builtInSection, length, section)
+		}
+	}
+}
@@ -0,0 +1,154 @@
+package hqgourl
+
+import (
+	"net/url"
+	"strings"
+)
+
+// QueryParameter is a parameter of a query, e.g. "q=a%20b" of "?q=a%20b&flag", in both its raw
+// form, as it is in the URL, and its decoded form.
+type QueryParameter struct {
+	RawKey   string // Key as it is in the URL, e.g. "q".
+	RawValue string // Value as it is in the URL, e.g. "a%20b".
+	Key      string // Decoded key, the raw key if it cannot be decoded.
+	Value    string // Decoded value, e.g. "a b", the raw value if it cannot be decoded.
+	HasValue bool   // Whether the parameter has a value, i.e. "=", e.g. true for "flag=" but not "flag".
+}
+
+// SetValue sets the value of the parameter, encoding it, e.g. "a b" as "a+b".
+func (p *QueryParameter) SetValue(value string) {
+	p.RawValue = url.QueryEscape(value)
+	p.Value = value
+	p.HasValue = true
+}
+
+// String returns the parameter as it is in the URL, e.g. "q=a%20b" or "flag".
+func (p QueryParameter) String() (parameter string) {
+	if !p.HasValue {
+		return p.RawKey
+	}
+
+	return p.RawKey + "=" + p.RawValue
+}
+
+// Query is an ordered, lossless model of a query, unlike the url.Values of net/url: it keeps the
+// order of the parameters, duplicate keys, empty values, keys without values, e.g. "?flag", and
+// their raw forms, so that String returns the query it is parsed from, byte for byte. Parameters
+// edited with its methods, or with QueryParameter.SetValue, are encoded, the others are kept as
+// they are.
+type Query []QueryParameter
+
+// ParseQuery parses rawQuery, e.g. "q=a%20b&flag&q=", into a Query. Each "&"-separated part is a
+// parameter, even empty ones, e.g. of "a=1&&b=2", for the query to be lossless.
+func ParseQuery(rawQuery string) (query Query) {
+	if rawQuery == "" {
+		return
+	}
+
+	for _, part := range strings.Split(rawQuery, "&") {
+		parameter := QueryParameter{}
+
+		parameter.RawKey, parameter.RawValue, parameter.HasValue = strings.Cut(part, "=")
+
+		parameter.Key = queryUnescape(parameter.RawKey)
+		parameter.Value = queryUnescape(parameter.RawValue)
+
+		query = append(query, parameter)
+	}
+
+	return
+}
+
+// Get returns the decoded value of the first parameter of the decoded key, and whether there is one.
+func (q Query) Get(key string) (value string, ok bool) {
+	for _, parameter := range q {
+		if parameter.Key == key {
+			return parameter.Value, true
+		}
+	}
+
+	return
+}
+
+// GetAll returns the decoded values of the parameters of the decoded key, in order.
+func (q Query) GetAll(key string) (values []string) {
+	for _, parameter := range q {
+		if parameter.Key == key {
+			values = append(values, parameter.Value)
+		}
+	}
+
+	return
+}
+
+// Has tells whether there is a parameter of the decoded key.
+func (q Query) Has(key string) (ok bool) {
+	_, ok = q.Get(key)
+
+	return
+}
+
+// Set sets the value of the first parameter of the decoded key, in place, keeping its raw key, and
+// removes the other parameters of the key. If there is none, the parameter is added.
+func (q *Query) Set(key, value string) {
+	for i := range *q {
+		if (*q)[i].Key != key {
+			continue
+		}
+
+		(*q)[i].SetValue(value)
+
+		rest := (*q)[i+1:]
+		rest.Del(key)
+
+		*q = (*q)[:i+1+len(rest)]
+
+		return
+	}
+
+	q.Add(key, value)
+}
+
+// Add adds a parameter of key and value, encoding them, after the other parameters.
+func (q *Query) Add(key, value string) {
+	parameter := QueryParameter{RawKey: url.QueryEscape(key), Key: key}
+
+	parameter.SetValue(value)
+
+	*q = append(*q, parameter)
+}
+
+// Del removes the parameters of the decoded key.
+func (q *Query) Del(key string) {
+	query := (*q)[:0]
+
+	for _, parameter := range *q {
+		if parameter.Key != key {
+			query = append(query, parameter)
+		}
+	}
+
+	*q = query
+}
+
+// String returns the query as it is in the URL, without the leading "?", e.g. "q=a%20b&flag".
+func (q Query) String() (rawQuery string) {
+	parameters := make([]string, len(q))
+
+	for i, parameter := range q {
+		parameters[i] = parameter.String()
+	}
+
+	return strings.Join(parameters, "&")
+}
+
+// queryUnescape decodes s as net/url decodes queries, e.g. "+" as a space, and returns it as it is
+// if it cannot be decoded.
+func queryUnescape(s string) (unescaped string) {
+	unescaped, err := url.QueryUnescape(s)
+	if err != nil {
+		return s
+	}
+
+	return
+}
@@ -0,0 +1,138 @@
+package hqgourl_test
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hueristiq/hqgourl"
+)
+
+func TestParseQuery(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		rawQuery      string
+		expectedQuery hqgourl.Query
+	}{
+		{"", nil},
+		{
+			"q=a%20b+c&flag&empty=&q=2",
+			hqgourl.Query{
+				{RawKey: "q", RawValue: "a%20b+c", Key: "q", Value: "a b c", HasValue: true},
+				{RawKey: "flag", Key: "flag"},
+				{RawKey: "empty", Key: "empty", HasValue: true},
+				{RawKey: "q", RawValue: "2", Key: "q", Value: "2", HasValue: true},
+			},
+		},
+		{
+			"a=1&&b=%zz",
+			hqgourl.Query{
+				{RawKey: "a", RawValue: "1", Key: "a", Value: "1", HasValue: true},
+				{},
+				{RawKey: "b", RawValue: "%zz", Key: "b", Value: "%zz", HasValue: true},
+			},
+		},
+		{
+			"k%5B%5D=x=y",
+			hqgourl.Query{
+				{RawKey: "k%5B%5D", RawValue: "x=y", Key: "k[]", Value: "x=y", HasValue: true},
+			},
+		},
+	}
+
+	for _, c := range cases {
+		c := c
+
+		t.Run(fmt.Sprintf("ParseQuery(%q)", c.rawQuery), func(t *testing.T) {
+			t.Parallel()
+
+			query := hqgourl.ParseQuery(c.rawQuery)
+
+			if !reflect.DeepEqual(query, c.expectedQuery) {
+				t.Errorf("ParseQuery(%q) = %+v, want %+v", c.rawQuery, query, c.expectedQuery)
+			}
+
+			if query.String() != c.rawQuery {
+				t.Errorf("ParseQuery(%q).String() = %q, want %q", c.rawQuery, query.String(), c.rawQuery)
+			}
+		})
+	}
+}
+
+func TestQuery_Edit(t *testing.T) {
+	t.Parallel()
+
+	rawQuery := "b=%7e&a=1&flag&a=2&c=x+y&&a=3"
+
+	cases := []struct {
+		name             string
+		edit             func(query *hqgourl.Query)
+		expectedRawQuery string
+	}{
+		{"Set", func(query *hqgourl.Query) { query.Set("a", "new value") }, "b=%7e&a=new+value&flag&c=x+y&"},
+		{"SetNew", func(query *hqgourl.Query) { query.Set("d", "&") }, rawQuery + "&d=%26"},
+		{"SetValue", func(query *hqgourl.Query) { (*query)[3].SetValue("two") }, "b=%7e&a=1&flag&a=two&c=x+y&&a=3"},
+		{"SetValueFlag", func(query *hqgourl.Query) { (*query)[2].SetValue("") }, "b=%7e&a=1&flag=&a=2&c=x+y&&a=3"},
+		{"Add", func(query *hqgourl.Query) { query.Add("a b", "4") }, rawQuery + "&a+b=4"},
+		{"Del", func(query *hqgourl.Query) { query.Del("a") }, "b=%7e&flag&c=x+y&"},
+	}
+
+	for _, c := range cases {
+		c := c
+
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			query := hqgourl.ParseQuery(rawQuery)
+
+			c.edit(&query)
+
+			if query.String() != c.expectedRawQuery {
+				t.Errorf("String() = %q, want %q", query.String(), c.expectedRawQuery)
+			}
+		})
+	}
+}
+
+func TestQuery_Get(t *testing.T) {
+	t.Parallel()
+
+	query := hqgourl.ParseQuery("q=a+b&flag&q=%2B")
+
+	if value, ok := query.Get("q"); !ok || value != "a b" {
+		t.Errorf("Get(%q) = %q, %v, want %q, true", "q", value, ok, "a b")
+	}
+
+	if values := query.GetAll("q"); !reflect.DeepEqual(values, []string{"a b", "+"}) {
+		t.Errorf("GetAll(%q) = %q, want %q", "q", values, []string{"a b", "+"})
+	}
+
+	if !query.Has("flag") || query.Has("missing") {
+		t.Errorf("Has() = %v, %v, want true, false", query.Has("flag"), query.Has("missing"))
+	}
+}
+
+func TestURLParser_ParseParameters(t *testing.T) {
+	t.Parallel()
+
+	up := hqgourl.NewURLParser()
+
+	parsedURL, err := up.Parse("https://example.com/search?q=go&page=2&q=&debug#top")
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	expectedParameters := hqgourl.ParseQuery("q=go&page=2&q=&debug")
+
+	if !reflect.DeepEqual(parsedURL.Parameters, expectedParameters) {
+		t.Errorf("Parameters = %+v, want %+v", parsedURL.Parameters, expectedParameters)
+	}
+
+	parsedURL.Parameters.Set("page", "3")
+	parsedURL.RawQuery = parsedURL.Parameters.String()
+
+	if parsedURL.String() != "https://example.com/search?q=go&page=3&q=&debug#top" {
+		t.Errorf("String() = %q, want %q", parsedURL.String(), "https://example.com/search?q=go&page=3&q=&debug#top")
+	}
+}
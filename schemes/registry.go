@@ -0,0 +1,166 @@
+package schemes
+
+import (
+	"strings"
+	"sync"
+)
+
+// Status is the status of a scheme in the IANA URI schemes registry.
+type Status int
+
+const (
+	// StatusUnknown is for schemes not registered with IANA, or of unknown status.
+	StatusUnknown Status = iota
+	// StatusPermanent is for permanent schemes, e.g. "http", with a stable specification.
+	StatusPermanent
+	// StatusProvisional is for provisional schemes, e.g. "ssh", registered with fewer requirements.
+	StatusProvisional
+	// StatusHistorical is for historical schemes, e.g. "prospero", no longer in common use.
+	StatusHistorical
+)
+
+// String returns the name of the status, as used by the IANA URI schemes registry.
+func (s Status) String() (status string) {
+	switch s {
+	case StatusUnknown:
+		status = "Unknown"
+	case StatusPermanent:
+		status = "Permanent"
+	case StatusProvisional:
+		status = "Provisional"
+	case StatusHistorical:
+		status = "Historical"
+	}
+
+	return
+}
+
+// Scheme describes a URL scheme.
+type Scheme struct {
+	Name         string // Name of the scheme, lowercase, e.g. "https".
+	DefaultPort  int    // Port implied by URLs of the scheme with no port, 0 if none.
+	Hierarchical bool   // Whether URLs of the scheme have a hierarchical path, e.g. "https:" but not "mailto:".
+	Authority    bool   // Whether URLs of the scheme need an authority, "//" and a host, e.g. "https:" but not "file:".
+	Secure       bool   // Whether the scheme's transport is encrypted, e.g. "https:" but not "http:".
+	Status       Status // Status of the scheme in the IANA URI schemes registry.
+}
+
+// Registry is a set of Schemes, looked up by name. It is safe for concurrent use by multiple
+// goroutines, including registering schemes while looking others up.
+type Registry struct {
+	mutex   sync.RWMutex
+	schemes map[string]Scheme
+}
+
+// Register adds scheme to the registry, replacing any scheme of the same name. Names are case-insensitive.
+func (r *Registry) Register(scheme Scheme) {
+	scheme.Name = strings.ToLower(scheme.Name)
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.schemes[scheme.Name] = scheme
+}
+
+// Lookup returns the scheme of the given name, case-insensitively, and whether it is registered.
+func (r *Registry) Lookup(name string) (scheme Scheme, ok bool) {
+	name = strings.ToLower(name)
+
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	scheme, ok = r.schemes[name]
+
+	return
+}
+
+// NewRegistry creates a Registry of schemes.
+func NewRegistry(schemes ...Scheme) (r *Registry) {
+	r = &Registry{
+		schemes: map[string]Scheme{},
+	}
+
+	for _, scheme := range schemes {
+		r.Register(scheme)
+	}
+
+	return
+}
+
+// wellKnown is a hand-maintained list of the default ports, flags and statuses of well-known
+// schemes. The default ports and statuses are from:
+//   - https://www.iana.org/assignments/uri-schemes/uri-schemes.xhtml
+//   - https://www.iana.org/assignments/service-names-port-numbers/service-names-port-numbers.xhtml
+var wellKnown = []Scheme{
+	{Name: "data", Status: StatusPermanent},
+	{Name: "file", Hierarchical: true, Status: StatusPermanent},
+	{Name: "ftp", DefaultPort: 21, Hierarchical: true, Authority: true, Status: StatusPermanent},
+	{Name: "git", DefaultPort: 9418, Hierarchical: true, Authority: true, Status: StatusProvisional},
+	{Name: "gopher", DefaultPort: 70, Hierarchical: true, Authority: true, Status: StatusPermanent},
+	{Name: "http", DefaultPort: 80, Hierarchical: true, Authority: true, Status: StatusPermanent},
+	{Name: "https", DefaultPort: 443, Hierarchical: true, Authority: true, Secure: true, Status: StatusPermanent},
+	{Name: "imap", DefaultPort: 143, Hierarchical: true, Authority: true, Status: StatusPermanent},
+	{Name: "ldap", DefaultPort: 389, Hierarchical: true, Authority: true, Status: StatusPermanent},
+	{Name: "mailto", Status: StatusPermanent},
+	{Name: "nntp", DefaultPort: 119, Hierarchical: true, Authority: true, Status: StatusPermanent},
+	{Name: "pop", DefaultPort: 110, Hierarchical: true, Authority: true, Status: StatusPermanent},
+	{Name: "rtsp", DefaultPort: 554, Hierarchical: true, Authority: true, Status: StatusPermanent},
+	{Name: "sftp", DefaultPort: 22, Hierarchical: true, Authority: true, Secure: true, Status: StatusProvisional},
+	{Name: "sip", DefaultPort: 5060, Status: StatusPermanent},
+	{Name: "sips", DefaultPort: 5061, Secure: true, Status: StatusPermanent},
+	{Name: "ssh", DefaultPort: 22, Hierarchical: true, Authority: true, Secure: true, Status: StatusProvisional},
+	{Name: "tel", Status: StatusPermanent},
+	{Name: "telnet", DefaultPort: 23, Hierarchical: true, Authority: true, Status: StatusPermanent},
+	{Name: "urn", Status: StatusPermanent},
+	{Name: "ws", DefaultPort: 80, Hierarchical: true, Authority: true, Status: StatusPermanent},
+	{Name: "wss", DefaultPort: 443, Hierarchical: true, Authority: true, Secure: true, Status: StatusPermanent},
+}
+
+// BuiltIn is a list of the Schemes of all the schemes registered with IANA, in Schemes, and of the
+// well-known schemes, with their default ports, flags and statuses, in DefaultRegistry. The other
+// registered schemes are of unknown status.
+var BuiltIn = builtIn()
+
+// builtIn merges the generated Schemes with the hand-maintained wellKnown schemes.
+func builtIn() (builtIn []Scheme) {
+	known := map[string]Scheme{}
+
+	for _, scheme := range wellKnown {
+		known[scheme.Name] = scheme
+	}
+
+	builtIn = make([]Scheme, 0, len(Schemes)+len(wellKnown))
+
+	for _, name := range Schemes {
+		scheme, ok := known[strings.ToLower(name)]
+		if !ok {
+			scheme = Scheme{Name: strings.ToLower(name)}
+		}
+
+		delete(known, scheme.Name)
+
+		builtIn = append(builtIn, scheme)
+	}
+
+	// Well-known schemes not in Schemes, if any, are added too.
+	for _, scheme := range wellKnown {
+		if _, ok := known[scheme.Name]; ok {
+			builtIn = append(builtIn, scheme)
+		}
+	}
+
+	return
+}
+
+// DefaultRegistry is the Registry of the BuiltIn schemes, used by default by the URL parser.
+var DefaultRegistry = NewRegistry(BuiltIn...)
+
+// Register adds scheme to DefaultRegistry, see Registry.Register.
+func Register(scheme Scheme) {
+	DefaultRegistry.Register(scheme)
+}
+
+// Lookup returns the scheme of the given name from DefaultRegistry, see Registry.Lookup.
+func Lookup(name string) (scheme Scheme, ok bool) {
+	return DefaultRegistry.Lookup(name)
+}
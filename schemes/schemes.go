@@ -2,6 +2,14 @@
 
 package schemes
 
+// Provenance of Schemes: the source it is generated from, the date the source was fetched, and
+// the hex-encoded SHA-256 hash of the source.
+const (
+	Source       = ``
+	SourceDate   = ``
+	SourceSHA256 = ``
+)
+
 // Schemes is a sorted list of all IANA assigned schemes.
 // This list is fetched from:
 //   - https://www.iana.org/assignments/uri-schemes/uri-schemes-1.csv
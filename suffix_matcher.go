@@ -0,0 +1,47 @@
+package hqgourl
+
+// SuffixMatcher is the interface implemented by sources of TLDs a DomainParser can use to find
+// the TLD of a domain, such as the PublicSuffixList or a CompositeSuffixMatcher layering several
+// sources. A DomainParser calls MatchSuffix from as many goroutines as parse with it, so
+// implementations must be safe for concurrent use.
+type SuffixMatcher interface {
+	// MatchSuffix returns the length, in labels, of the TLD of the domain split into labels, and
+	// the section of the public suffix list the prevailing rule comes from. Labels are given in
+	// their Unicode form, lowercased. A length of 0 means no rule matches, the DomainParser then
+	// applies the implicit "*" rule. A length of len(labels) means the whole domain is a TLD.
+	MatchSuffix(labels []string) (length int, section TLDSection)
+}
+
+// CompositeSuffixMatcher combines several SuffixMatchers by priority: the first one with a rule
+// matching a domain decides its TLD, the following ones are only consulted if it has none. For
+// example, an internal corporate zone list layered on the public suffix list. It is safe for
+// concurrent use by multiple goroutines if its SuffixMatchers are.
+type CompositeSuffixMatcher struct {
+	matchers []SuffixMatcher
+}
+
+// MatchSuffix returns the match of the highest priority SuffixMatcher having a rule matching labels.
+func (m *CompositeSuffixMatcher) MatchSuffix(labels []string) (length int, section TLDSection) {
+	for _, matcher := range m.matchers {
+		if length, section = matcher.MatchSuffix(labels); length > 0 {
+			return
+		}
+	}
+
+	return
+}
+
+// Ensure type compatibility with interfaces.
+var (
+	_ SuffixMatcher = &PublicSuffixList{}
+	_ SuffixMatcher = &CompositeSuffixMatcher{}
+)
+
+// NewCompositeSuffixMatcher creates a CompositeSuffixMatcher of matchers, in decreasing order of priority.
+func NewCompositeSuffixMatcher(matchers ...SuffixMatcher) (m *CompositeSuffixMatcher) {
+	m = &CompositeSuffixMatcher{
+		matchers: matchers,
+	}
+
+	return
+}
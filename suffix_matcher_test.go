@@ -0,0 +1,145 @@
+package hqgourl_test
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hueristiq/hqgourl"
+	"github.com/hueristiq/hqgourl/tlds"
+)
+
+// zoneSuffixMatcher is a SuffixMatcher implemented outside of the package, matching the
+// zones of an internal corporate network.
+type zoneSuffixMatcher struct {
+	zones []string
+}
+
+func (m *zoneSuffixMatcher) MatchSuffix(labels []string) (length int, section hqgourl.TLDSection) {
+	domain := strings.Join(labels, ".")
+
+	for _, zone := range m.zones {
+		if domain != zone && strings.HasSuffix(domain, "."+zone) {
+			length = strings.Count(zone, ".") + 1
+		}
+	}
+
+	return
+}
+
+func TestCompositeSuffixMatcher(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		rawDomain            string
+		expectedParsedDomain *hqgourl.Domain
+	}{
+		{
+			"www.example.corp.internal",
+			&hqgourl.Domain{
+				Sub:             "www",
+				Root:            "example",
+				TopLevel:        "corp.internal",
+				TopLevelSection: hqgourl.TLDSectionNone,
+				TopLevelKnown:   true,
+			},
+		},
+		{
+			"www.example.co.uk",
+			&hqgourl.Domain{
+				Sub:             "www",
+				Root:            "example",
+				TopLevel:        "co.uk",
+				TopLevelSection: hqgourl.TLDSectionICANN,
+				TopLevelKnown:   true,
+			},
+		},
+		{
+			"www.example.com",
+			&hqgourl.Domain{
+				Sub:             "",
+				Root:            "www",
+				TopLevel:        "example.com",
+				TopLevelSection: hqgourl.TLDSectionNone,
+				TopLevelKnown:   true,
+			},
+		},
+	}
+
+	dp := hqgourl.NewDomainParser(
+		hqgourl.DomainParserWithSuffixMatcher(
+			hqgourl.NewCompositeSuffixMatcher(
+				&zoneSuffixMatcher{zones: []string{"corp.internal", "example.com"}},
+				hqgourl.NewPublicSuffixList(hqgourl.TLDSectionICANN),
+			),
+		),
+	)
+
+	for _, c := range cases {
+		c := c
+
+		t.Run(fmt.Sprintf("Parse(%q)", c.rawDomain), func(t *testing.T) {
+			t.Parallel()
+
+			parsedDomain, err := dp.Parse(c.rawDomain)
+			if err != nil {
+				t.Fatalf("Parse(%q) error = %v", c.rawDomain, err)
+			}
+
+			if !reflect.DeepEqual(parsedDomain, c.expectedParsedDomain) {
+				t.Errorf("Parse(%q) = %+v, want %+v", c.rawDomain, parsedDomain, c.expectedParsedDomain)
+			}
+		})
+	}
+}
+
+func TestNewPublicSuffixList(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		sections        []hqgourl.TLDSection
+		labels          []string
+		expectedLength  int
+		expectedSection hqgourl.TLDSection
+	}{
+		{
+			[]hqgourl.TLDSection{hqgourl.TLDSectionICANN},
+			[]string{"example", "github", "io"},
+			1,
+			hqgourl.TLDSectionICANN,
+		},
+		{
+			[]hqgourl.TLDSection{hqgourl.TLDSectionICANN, hqgourl.TLDSectionPrivate},
+			[]string{"example", "github", "io"},
+			2,
+			hqgourl.TLDSectionPrivate,
+		},
+		{
+			[]hqgourl.TLDSection{hqgourl.TLDSectionICANN},
+			[]string{"example", tlds.PseudoTLDs[0]},
+			0,
+			hqgourl.TLDSectionNone,
+		},
+		{
+			[]hqgourl.TLDSection{hqgourl.TLDSectionNone},
+			[]string{"example", tlds.PseudoTLDs[0]},
+			1,
+			hqgourl.TLDSectionNone,
+		},
+	}
+
+	for _, c := range cases {
+		c := c
+
+		t.Run(fmt.Sprintf("NewPublicSuffixList(%v).MatchSuffix(%q)", c.sections, c.labels), func(t *testing.T) {
+			t.Parallel()
+
+			length, section := hqgourl.NewPublicSuffixList(c.sections...).MatchSuffix(c.labels)
+
+			if length != c.expectedLength || section != c.expectedSection {
+				t.Errorf("MatchSuffix(%q) = %d, %v, want %d, %v", c.labels, length, section, c.expectedLength, c.expectedSection)
+			}
+		})
+	}
+}
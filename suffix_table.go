@@ -0,0 +1,92 @@
+package hqgourl
+
+import (
+	"github.com/hueristiq/hqgourl/internal/table"
+	"github.com/hueristiq/hqgourl/tlds"
+)
+
+// suffixTable matches suffixes against the suffix table precomputed into the internal/table
+// package, and tlds.PseudoTLDs, restricted to the enabled sections. It is built with no
+// allocations: the built-in PublicSuffixList needs no construction at runtime. A suffix with rules
+// in several enabled sections is reported in the last of TLDSectionNone, TLDSectionICANN and
+// TLDSectionPrivate.
+type suffixTable struct {
+	withPseudoTLDs  bool
+	withICANNTLDs   bool
+	withPrivateTLDs bool
+}
+
+// MatchSuffix runs the public suffix list algorithm over labels, as PublicSuffixList.MatchSuffix.
+func (t *suffixTable) MatchSuffix(labels []string) (length int, section TLDSection) {
+	labelsLength := len(labels)
+	labelsLastIndex := labelsLength - 1
+
+	if labelsLength == 0 {
+		return
+	}
+
+	node := table.Root
+
+	// Walk down the table, label by label, from the last label of the domain.
+	for i := labelsLastIndex; i >= 0; i-- {
+		var rule suffixRule
+
+		var ruleSection TLDSection
+
+		// Pseudo-TLDs are single labels, not in the table.
+		if i == labelsLastIndex && t.withPseudoTLDs && pseudoTLDs[labels[i]] {
+			rule, ruleSection = suffixRuleNormal, TLDSectionNone
+		}
+
+		child, ok := node.Child(labels[i])
+		if ok {
+			node = child
+
+			ICANN, private := node.Rules()
+
+			if t.withICANNTLDs && ICANN != 0 {
+				rule, ruleSection = rule|suffixRule(ICANN), TLDSectionICANN
+			}
+
+			if t.withPrivateTLDs && private != 0 {
+				rule, ruleSection = rule|suffixRule(private), TLDSectionPrivate
+			}
+		}
+
+		// An exception rule always prevails, its TLD being the rule minus its leftmost label.
+		if rule&suffixRuleException != 0 {
+			length = labelsLastIndex - i
+			section = ruleSection
+
+			break
+		}
+
+		if rule&suffixRuleNormal != 0 {
+			length = labelsLength - i
+			section = ruleSection
+		}
+
+		// A wildcard rule matches the label preceding the current TLD, if any.
+		if i > 0 && rule&suffixRuleWildcard != 0 {
+			length = labelsLength - i + 1
+			section = ruleSection
+		}
+
+		if !ok {
+			break
+		}
+	}
+
+	return
+}
+
+// pseudoTLDs is the set of tlds.PseudoTLDs.
+var pseudoTLDs = func() (set map[string]bool) {
+	set = make(map[string]bool, len(tlds.PseudoTLDs))
+
+	for _, TLD := range tlds.PseudoTLDs {
+		set[TLD] = true
+	}
+
+	return
+}()
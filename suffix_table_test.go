@@ -0,0 +1,128 @@
+package hqgourl_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hueristiq/hqgourl"
+	"github.com/hueristiq/hqgourl/tlds"
+)
+
+// suffixTableDomains returns domains exercising every rule built into the package.
+func suffixTableDomains() (domains []string) {
+	for _, TLD := range tlds.PseudoTLDs {
+		domains = append(domains, "www.example."+TLD)
+	}
+
+	for _, TLDs := range [][]string{tlds.TLDs, tlds.PrivateTLDs} {
+		for _, TLD := range TLDs {
+			domains = append(domains, "www.example."+TLD, TLD)
+		}
+	}
+
+	for _, TLDs := range [][]string{tlds.WildcardTLDs, tlds.PrivateWildcardTLDs} {
+		for _, TLD := range TLDs {
+			domains = append(domains, "www.example.any."+TLD, "any."+TLD)
+		}
+	}
+
+	for _, exceptions := range [][]string{tlds.ExceptionTLDs, tlds.PrivateExceptionTLDs} {
+		for _, domain := range exceptions {
+			domains = append(domains, "www."+domain, domain)
+		}
+	}
+
+	return
+}
+
+func TestNewPublicSuffixListMatchesTrie(t *testing.T) {
+	t.Parallel()
+
+	domains := suffixTableDomains()
+
+	cases := []struct {
+		name string
+		opts []hqgourl.DomainParserOptionsFunc
+	}{
+		{
+			name: "Standard and pseudo-TLDs",
+		},
+		{
+			name: "Standard, private and pseudo-TLDs",
+			opts: []hqgourl.DomainParserOptionsFunc{hqgourl.DomainParserWithPrivateTLDs()},
+		},
+	}
+
+	for _, c := range cases {
+		c := c
+
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			table := hqgourl.NewDomainParser(c.opts...)
+			// Additional TLDs need a modifiable trie of the built-in TLDs, rather than the table.
+			trie := hqgourl.NewDomainParser(append(c.opts, hqgourl.DomainParserWithAdditionalTLDs("corp.internal"))...)
+
+			for _, domain := range domains {
+				tableDomain, tableErr := table.Parse(domain)
+				trieDomain, trieErr := trie.Parse(domain)
+
+				if !reflect.DeepEqual(tableDomain, trieDomain) || (tableErr == nil) != (trieErr == nil) {
+					t.Errorf("Parse(%q) = %+v, %v with the suffix table, want %+v, %v", domain, tableDomain, tableErr, trieDomain, trieErr)
+				}
+			}
+		})
+	}
+}
+
+// BenchmarkSuffixTable compares the DomainParser on the suffix table precomputed into the tlds
+// package with the DomainParser on a trie of the same TLDs built at runtime, as for additional
+// TLDs: startup cost and memory use as the time and allocations of NewDomainParser, and lookup
+// speed as the time of Parse.
+func BenchmarkSuffixTable(b *testing.B) {
+	parsers := []struct {
+		name string
+		opts []hqgourl.DomainParserOptionsFunc
+	}{
+		{
+			name: "Table",
+			opts: []hqgourl.DomainParserOptionsFunc{hqgourl.DomainParserWithPrivateTLDs()},
+		},
+		{
+			name: "Trie",
+			opts: []hqgourl.DomainParserOptionsFunc{hqgourl.DomainParserWithPrivateTLDs(), hqgourl.DomainParserWithAdditionalTLDs("corp.internal")},
+		},
+	}
+
+	domains := []string{
+		"www.example.com",
+		"a.b.c.example.co.uk",
+		"example.github.io",
+		"www.example.any.ck",
+		"例子.公司.cn",
+		"www.example.unknown",
+	}
+
+	for _, parser := range parsers {
+		parser := parser
+
+		b.Run("NewDomainParser/"+parser.name, func(b *testing.B) {
+			b.ReportAllocs()
+
+			for i := 0; i < b.N; i++ {
+				_ = hqgourl.NewDomainParser(parser.opts...)
+			}
+		})
+
+		b.Run("Parse/"+parser.name, func(b *testing.B) {
+			dp := hqgourl.NewDomainParser(parser.opts...)
+
+			b.ReportAllocs()
+			b.ResetTimer()
+
+			for i := 0; i < b.N; i++ {
+				_, _ = dp.Parse(domains[i%len(domains)])
+			}
+		})
+	}
+}
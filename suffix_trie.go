@@ -0,0 +1,86 @@
+package hqgourl
+
+import "strings"
+
+// suffixRule is a bit set of the public suffix list rule types declared for a suffix.
+type suffixRule uint8
+
+const (
+	// suffixRuleNormal marks a suffix declared by a normal rule, e.g. "co.uk".
+	suffixRuleNormal suffixRule = 1 << iota
+	// suffixRuleWildcard marks a suffix declared by a wildcard rule, e.g. "ck" for "*.ck".
+	suffixRuleWildcard
+	// suffixRuleException marks a suffix declared by an exception rule, e.g. "www.ck" for "!www.ck".
+	suffixRuleException
+)
+
+// suffixTrie is a trie of suffixes keyed by their labels in reverse order, i.e. "co.uk" is
+// stored as "uk" -> "co", each label in its Unicode form. Walking a domain's labels from right to left only ever matches
+// whole suffix entries, never a substring of one. A suffixTrie is not modified once the
+// DomainParser it belongs to is built.
+type suffixTrie struct {
+	children map[string]*suffixTrie
+	rule     suffixRule
+	section  TLDSection
+}
+
+// add inserts suffix into the trie, marking it with rule and section.
+func (t *suffixTrie) add(suffix string, rule suffixRule, section TLDSection) {
+	node := t
+
+	labels := strings.Split(suffix, ".")
+
+	for i := len(labels) - 1; i >= 0; i-- {
+		label := toUnicodeLabel(labels[i])
+
+		child, ok := node.children[label]
+		if !ok {
+			child = &suffixTrie{}
+
+			if node.children == nil {
+				node.children = map[string]*suffixTrie{}
+			}
+
+			node.children[label] = child
+		}
+
+		node = child
+	}
+
+	node.rule |= rule
+	node.section = section
+}
+
+// remove clears rule from suffix in the trie, if present.
+func (t *suffixTrie) remove(suffix string, rule suffixRule) {
+	node := t
+
+	labels := strings.Split(suffix, ".")
+
+	for i := len(labels) - 1; i >= 0; i-- {
+		child, ok := node.children[toUnicodeLabel(labels[i])]
+		if !ok {
+			return
+		}
+
+		node = child
+	}
+
+	node.rule &^= rule
+}
+
+// addRules inserts normal, wildcard and exception rules of a section into the trie. Wildcard
+// and exception rules are given without their "*." and "!" prefixes.
+func (t *suffixTrie) addRules(section TLDSection, normal, wildcard, exception []string) {
+	for _, suffix := range normal {
+		t.add(suffix, suffixRuleNormal, section)
+	}
+
+	for _, suffix := range wildcard {
+		t.add(suffix, suffixRuleWildcard, section)
+	}
+
+	for _, suffix := range exception {
+		t.add(suffix, suffixRuleException, section)
+	}
+}
@@ -0,0 +1,80 @@
+package tlds
+
+import (
+	"strings"
+
+	"golang.org/x/net/idna"
+)
+
+// TLDType is the type of a TLD, as listed in the IANA root zone database.
+type TLDType int
+
+const (
+	// TLDTypeUnknown is for TLDs without metadata, see LookupMetadata.
+	TLDTypeUnknown TLDType = iota
+	// TLDTypeGeneric is for generic TLDs, e.g. "com" or "shop".
+	TLDTypeGeneric
+	// TLDTypeCountryCode is for country-code TLDs, e.g. "de" or "中国".
+	TLDTypeCountryCode
+	// TLDTypeSponsored is for sponsored TLDs, run for a specific community, e.g. "edu" or "gov".
+	TLDTypeSponsored
+	// TLDTypeInfrastructure is for the infrastructure TLD "arpa".
+	TLDTypeInfrastructure
+	// TLDTypeGenericRestricted is for generic TLDs restricted to eligible registrants, e.g. "biz".
+	TLDTypeGenericRestricted
+	// TLDTypeTest is for the IDN test TLDs.
+	TLDTypeTest
+	// TLDTypeBrand is for generic TLDs run by a company for its own brand, e.g. "google". The
+	// IANA root zone database lists them as generic TLDs.
+	TLDTypeBrand
+)
+
+// String returns the name of the type, as used by the IANA root zone database.
+func (t TLDType) String() (TLDType string) {
+	switch t {
+	case TLDTypeUnknown:
+		TLDType = "unknown"
+	case TLDTypeGeneric:
+		TLDType = "generic"
+	case TLDTypeCountryCode:
+		TLDType = "country-code"
+	case TLDTypeSponsored:
+		TLDType = "sponsored"
+	case TLDTypeInfrastructure:
+		TLDType = "infrastructure"
+	case TLDTypeGenericRestricted:
+		TLDType = "generic-restricted"
+	case TLDTypeTest:
+		TLDType = "test"
+	case TLDTypeBrand:
+		TLDType = "brand"
+	}
+
+	return
+}
+
+// TLDMetadata is the metadata of a TLD.
+type TLDMetadata struct {
+	Type        TLDType // Type of the TLD.
+	CountryCode string  // Two-letter, upper-case code of the country or territory of a ccTLD, e.g. "CN" for "中国".
+}
+
+// LookupMetadata returns the metadata of TLD, e.g. "com", ".de" or "xn--fiqs8s", and whether it
+// has any. TLDs are single labels, in either their Unicode or ASCII form. The metadata is that of
+// the IANA root zone database if it is generated from it, see RootZoneDatabaseSource. Otherwise
+// it is derived from the public suffix list, and only covers the ccTLDs and the TLDs that predate
+// the new gTLD program, e.g. "com" or "museum": the list does not tell generic and brand new gTLDs
+// apart.
+func LookupMetadata(TLD string) (TLDMetadata TLDMetadata, ok bool) {
+	TLD = strings.ToLower(strings.TrimPrefix(TLD, "."))
+
+	if strings.HasPrefix(TLD, "xn--") {
+		if unicodeTLD, err := idna.ToUnicode(TLD); err == nil {
+			TLD = unicodeTLD
+		}
+	}
+
+	TLDMetadata, ok = metadata[TLD]
+
+	return
+}
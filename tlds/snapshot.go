@@ -0,0 +1,41 @@
+package tlds
+
+import _ "embed"
+
+// Snapshot identifies the snapshot of a data source the package's data is generated from.
+type Snapshot struct {
+	Source  string // URL or file path of the source, empty if unknown.
+	Version string // Version the source declares, e.g. "2024-06-01_09-14-25_UTC", empty if none.
+	Date    string // Date the source was fetched, as YYYY-MM-DD, empty if unknown.
+	SHA256  string // Hex-encoded SHA-256 hash of the source, empty if unknown.
+}
+
+// PublicSuffixListData is the raw public suffix list TLDs, WildcardTLDs, ExceptionTLDs and the
+// private TLDs are generated from, for consumers that need the list itself, e.g. to audit it or
+// to read it with another implementation.
+//
+//go:embed public_suffix_list.dat
+var PublicSuffixListData string
+
+var (
+	// PublicSuffixListSnapshot identifies the public suffix list embedded as PublicSuffixListData.
+	PublicSuffixListSnapshot = Snapshot{
+		Source:  PublicSuffixListSource,
+		Version: PublicSuffixListVersion,
+		Date:    PublicSuffixListSourceDate,
+		SHA256:  PublicSuffixListSourceSHA256,
+	}
+	// IANASnapshot identifies the IANA list of TLDs merged into TLDs.
+	IANASnapshot = Snapshot{
+		Source: IANASource,
+		Date:   IANASourceDate,
+		SHA256: IANASourceSHA256,
+	}
+	// RootZoneDatabaseSnapshot identifies the IANA root zone database the TLDs metadata is
+	// generated from. It is empty when the metadata is derived from the public suffix list.
+	RootZoneDatabaseSnapshot = Snapshot{
+		Source: RootZoneDatabaseSource,
+		Date:   RootZoneDatabaseSourceDate,
+		SHA256: RootZoneDatabaseSourceSHA256,
+	}
+)
@@ -2,9 +2,23 @@
 
 package tlds
 
+// Provenance of the TLDs: the sources they are generated from, the dates the sources were fetched,
+// the hex-encoded SHA-256 hashes of the sources, and the version of the public suffix list, as
+// declared in its "// VERSION:" header or given to the generator. The IANA constants are empty if
+// the TLDs are generated from the public suffix list alone.
+const (
+	IANASource       = ``
+	IANASourceDate   = ``
+	IANASourceSHA256 = ``
+
+	PublicSuffixListSource       = `/usr/share/publicsuffix/public_suffix_list.dat`
+	PublicSuffixListSourceDate   = `2023-02-09`
+	PublicSuffixListSourceSHA256 = `87d2e11f3602b504fc5dbea9218429a4ce3c0f62aa6ce7a1371024add024baed`
+	PublicSuffixListVersion      = `20230209.2326`
+)
+
 // TLDs is a sorted list of public TLDs and eTLDs.
 // The list is fetched from:
-//   - https://data.iana.org/TLD/tlds-alpha-by-domain.txt
 //   - https://publicsuffix.org/list/public_suffix_list.dat
 var TLDs = []string{
 	`0.bg`,
@@ -29,6 +43,7 @@ var TLDs = []string{
 	`aarborte.no`,
 	`aarp`,
 	`ab.ca`,
+	`abarth`,
 	`abashiri.hokkaido.jp`,
 	`abb`,
 	`abbott`,
@@ -91,6 +106,7 @@ var TLDs = []string{
 	`aca.pro`,
 	`academia.bo`,
 	`academy`,
+	`academy.museum`,
 	`accenture`,
 	`accident-investigation.aero`,
 	`accident-prevention.aero`,
@@ -120,6 +136,7 @@ var TLDs = []string{
 	`aerobatic.aero`,
 	`aeroclub.aero`,
 	`aerodrome.aero`,
+	`aeroport.fr`,
 	`aetna`,
 	`af`,
 	`afjord.no`,
@@ -138,6 +155,7 @@ var TLDs = []string{
 	`agr.br`,
 	`agrar.hu`,
 	`agric.za`,
+	`agriculture.museum`,
 	`agrigento.it`,
 	`agro.bj`,
 	`agro.bo`,
@@ -147,7 +165,6 @@ var TLDs = []string{
 	`ah.no`,
 	`ai`,
 	`ai.in`,
-	`ai.vn`,
 	`aibetsu.hokkaido.jp`,
 	`aichi.jp`,
 	`aid.pl`,
@@ -158,9 +175,11 @@ var TLDs = []string{
 	`aip.ee`,
 	`air-surveillance.aero`,
 	`air-traffic-control.aero`,
+	`air.museum`,
 	`airbus`,
 	`aircraft.aero`,
 	`airforce`,
+	`airguard.museum`,
 	`airline.aero`,
 	`airport.aero`,
 	`airtel`,
@@ -193,10 +212,13 @@ var TLDs = []string{
 	`al.it`,
 	`al.no`,
 	`al.us`,
+	`alabama.museum`,
 	`alaheadju.no`,
 	`aland.fi`,
+	`alaska.museum`,
 	`alessandria.it`,
 	`alesund.no`,
+	`alfaromeo`,
 	`algard.no`,
 	`alibaba`,
 	`alipay`,
@@ -221,7 +243,13 @@ var TLDs = []string{
 	`amakusa.kumamoto.jp`,
 	`amami.kagoshima.jp`,
 	`amazon`,
+	`amber.museum`,
 	`ambulance.aero`,
+	`ambulance.museum`,
+	`american.museum`,
+	`americana.museum`,
+	`americanantiques.museum`,
+	`americanart.museum`,
 	`americanexpress`,
 	`americanfamily`,
 	`amex`,
@@ -231,6 +259,7 @@ var TLDs = []string{
 	`amli.no`,
 	`amot.no`,
 	`amsterdam`,
+	`amsterdam.museum`,
 	`amusement.aero`,
 	`an.it`,
 	`analytics`,
@@ -239,6 +268,7 @@ var TLDs = []string{
 	`anan.tokushima.jp`,
 	`anani.br`,
 	`ancona.it`,
+	`and.museum`,
 	`andasuolo.no`,
 	`andebu.no`,
 	`ando.nara.jp`,
@@ -249,12 +279,15 @@ var TLDs = []string{
 	`andriatranibarletta.it`,
 	`android`,
 	`andøy.no`,
-	`angiang.vn`,
 	`anjo.aichi.jp`,
 	`ann-arbor.mi.us`,
 	`annaka.gunma.jp`,
+	`annefrank.museum`,
 	`anpachi.gifu.jp`,
 	`anquan`,
+	`anthro.museum`,
+	`anthropology.museum`,
+	`antiques.museum`,
 	`anz`,
 	`ao`,
 	`ao.it`,
@@ -279,6 +312,7 @@ var TLDs = []string{
 	`aq`,
 	`aq.it`,
 	`aquarelle`,
+	`aquarium.museum`,
 	`aquila.it`,
 	`ar`,
 	`ar.it`,
@@ -289,8 +323,12 @@ var TLDs = []string{
 	`arakawa.tokyo.jp`,
 	`aramco`,
 	`arao.kumamoto.jp`,
+	`arboretum.museum`,
+	`archaeological.museum`,
+	`archaeology.museum`,
 	`archi`,
 	`architectes.bj`,
+	`architecture.museum`,
 	`ardal.no`,
 	`aremark.no`,
 	`arendal.no`,
@@ -308,13 +346,21 @@ var TLDs = []string{
 	`art.do`,
 	`art.dz`,
 	`art.ht`,
+	`art.museum`,
 	`art.sn`,
+	`artanddesign.museum`,
+	`artcenter.museum`,
+	`artdeco.museum`,
 	`arte`,
 	`arte.bo`,
+	`arteducation.museum`,
+	`artgallery.museum`,
 	`arts.co`,
+	`arts.museum`,
 	`arts.nf`,
 	`arts.ro`,
 	`arts.ve`,
+	`artsandcrafts.museum`,
 	`as`,
 	`as.us`,
 	`asago.hyogo.jp`,
@@ -344,12 +390,15 @@ var TLDs = []string{
 	`askoy.no`,
 	`askvoll.no`,
 	`askøy.no`,
+	`asmatart.museum`,
 	`asn.au`,
 	`asn.lv`,
 	`asnes.no`,
 	`aso.kumamoto.jp`,
 	`ass.km`,
 	`assabu.hokkaido.jp`,
+	`assassination.museum`,
+	`assisi.museum`,
 	`assn.lk`,
 	`asso.ci`,
 	`asso.dz`,
@@ -362,13 +411,16 @@ var TLDs = []string{
 	`asso.re`,
 	`associates`,
 	`association.aero`,
+	`association.museum`,
 	`assur.bj`,
 	`asti.it`,
+	`astronomy.museum`,
 	`asuke.aichi.jp`,
 	`at`,
 	`at.it`,
 	`atami.shizuoka.jp`,
 	`athleta`,
+	`atlanta.museum`,
 	`atm.pl`,
 	`ato.br`,
 	`atsugi.kanagawa.jp`,
@@ -388,11 +440,14 @@ var TLDs = []string{
 	`aurskog-høland.no`,
 	`auspost`,
 	`austevoll.no`,
+	`austin.museum`,
+	`australia.museum`,
 	`austrheim.no`,
 	`author`,
 	`author.aero`,
 	`auto`,
 	`auto.pl`,
+	`automotive.museum`,
 	`autos`,
 	`av.it`,
 	`av.tr`,
@@ -400,6 +455,8 @@ var TLDs = []string{
 	`averoy.no`,
 	`averøy.no`,
 	`avianca`,
+	`aviation.museum`,
+	`avocat.fr`,
 	`avocat.pro`,
 	`avocats.bj`,
 	`avoues.fr`,
@@ -408,6 +465,7 @@ var TLDs = []string{
 	`aws`,
 	`ax`,
 	`axa`,
+	`axis.museum`,
 	`aya.miyazaki.jp`,
 	`ayabe.kyoto.jp`,
 	`ayagawa.kagawa.jp`,
@@ -425,17 +483,17 @@ var TLDs = []string{
 	`ba.it`,
 	`babia-gora.pl`,
 	`baby`,
-	`bacgiang.vn`,
-	`backan.vn`,
-	`baclieu.vn`,
-	`bacninh.vn`,
 	`badaddja.no`,
+	`badajoz.museum`,
+	`baghdad.museum`,
 	`bahcavuotna.no`,
 	`bahccavuotna.no`,
+	`bahn.museum`,
 	`baidar.no`,
 	`baidu`,
 	`bajddar.no`,
 	`balat.no`,
+	`bale.museum`,
 	`balestrand.no`,
 	`ballangen.no`,
 	`ballooning.aero`,
@@ -444,8 +502,10 @@ var TLDs = []string{
 	`balsan-südtirol.it`,
 	`balsan.it`,
 	`balsfjord.no`,
+	`baltimore.museum`,
 	`bamble.no`,
 	`banamex`,
+	`bananarepublic`,
 	`band`,
 	`bandai.fukushima.jp`,
 	`bando.ibaraki.jp`,
@@ -453,23 +513,27 @@ var TLDs = []string{
 	`bar`,
 	`bar.pro`,
 	`barcelona`,
+	`barcelona.museum`,
 	`barclaycard`,
 	`barclays`,
 	`bardu.no`,
 	`barefoot`,
 	`bargains`,
 	`bari.it`,
-	`baria-vungtau.vn`,
 	`barletta-trani-andria.it`,
 	`barlettatraniandria.it`,
 	`barueri.br`,
 	`barum.no`,
 	`bas.it`,
 	`baseball`,
+	`baseball.museum`,
+	`basel.museum`,
 	`basilicata.it`,
 	`basketball`,
+	`baths.museum`,
 	`bato.tochigi.jp`,
 	`batsfjord.no`,
+	`bauern.museum`,
 	`bauhaus`,
 	`bayern`,
 	`bb`,
@@ -488,23 +552,29 @@ var TLDs = []string{
 	`beardu.no`,
 	`beats`,
 	`beauty`,
+	`beauxarts.museum`,
 	`bedzin.pl`,
+	`beeldengeluid.museum`,
 	`beer`,
 	`beiarn.no`,
 	`bel.tr`,
 	`belau.pw`,
 	`belem.br`,
+	`bellevue.museum`,
 	`belluno.it`,
 	`benevento.it`,
 	`bentley`,
-	`bentre.vn`,
 	`beppu.oita.jp`,
 	`berg.no`,
 	`bergamo.it`,
+	`bergbau.museum`,
 	`bergen.no`,
+	`berkeley.museum`,
 	`berlevag.no`,
 	`berlevåg.no`,
 	`berlin`,
+	`berlin.museum`,
+	`bern.museum`,
 	`beskidy.pl`,
 	`best`,
 	`bestbuy`,
@@ -524,6 +594,7 @@ var TLDs = []string{
 	`bib.ve`,
 	`bibai.hokkaido.jp`,
 	`bible`,
+	`bible.museum`,
 	`bid`,
 	`biei.hokkaido.jp`,
 	`bielawa.pl`,
@@ -535,17 +606,17 @@ var TLDs = []string{
 	`bihar.in`,
 	`bihoro.hokkaido.jp`,
 	`bike`,
+	`bilbao.museum`,
+	`bill.museum`,
 	`bindal.no`,
 	`bing`,
 	`bingo`,
-	`binhdinh.vn`,
-	`binhduong.vn`,
-	`binhphuoc.vn`,
-	`binhthuan.vn`,
 	`bio`,
 	`bio.br`,
 	`biratori.hokkaido.jp`,
+	`birdart.museum`,
 	`birkenes.no`,
+	`birthplace.museum`,
 	`biz`,
 	`biz.az`,
 	`biz.bb`,
@@ -613,13 +684,19 @@ var TLDs = []string{
 	`bom`,
 	`bomlo.no`,
 	`bond`,
+	`bonn.museum`,
 	`boo`,
 	`book`,
 	`booking`,
 	`bosch`,
 	`bostik`,
 	`boston`,
+	`boston.museum`,
 	`bot`,
+	`botanical.museum`,
+	`botanicalgarden.museum`,
+	`botanicgarden.museum`,
+	`botany.museum`,
 	`boutique`,
 	`box`,
 	`bozen-sudtirol.it`,
@@ -630,10 +707,16 @@ var TLDs = []string{
 	`br.it`,
 	`bradesco`,
 	`brand.se`,
+	`brandywinevalley.museum`,
+	`brasil.museum`,
 	`bremanger.no`,
 	`brescia.it`,
 	`bridgestone`,
 	`brindisi.it`,
+	`bristol.museum`,
+	`british.museum`,
+	`britishcolumbia.museum`,
+	`broadcast.museum`,
 	`broadway`,
 	`broker`,
 	`broker.aero`,
@@ -641,7 +724,11 @@ var TLDs = []string{
 	`bronnoysund.no`,
 	`brother`,
 	`brumunddal.no`,
+	`brunel.museum`,
+	`brussel.museum`,
 	`brussels`,
+	`brussels.museum`,
+	`bruxelles.museum`,
 	`bryne.no`,
 	`brønnøy.no`,
 	`brønnøysund.no`,
@@ -654,6 +741,7 @@ var TLDs = []string{
 	`budejju.no`,
 	`build`,
 	`builders`,
+	`building.museum`,
 	`bulsan-sudtirol.it`,
 	`bulsan-suedtirol.it`,
 	`bulsan-südtirol.it`,
@@ -661,7 +749,10 @@ var TLDs = []string{
 	`bungoono.oita.jp`,
 	`bungotakada.oita.jp`,
 	`bunkyo.tokyo.jp`,
+	`burghof.museum`,
+	`bus.museum`,
 	`busan.kr`,
+	`bushey.museum`,
 	`business`,
 	`business.in`,
 	`buy`,
@@ -697,18 +788,20 @@ var TLDs = []string{
 	`ca.us`,
 	`caa.aero`,
 	`cab`,
+	`cadaques.museum`,
 	`cafe`,
 	`cagliari.it`,
 	`cahcesuolo.no`,
 	`cal`,
 	`cal.it`,
 	`calabria.it`,
+	`california.museum`,
 	`call`,
 	`caltanissetta.it`,
 	`calvinklein`,
 	`cam`,
 	`cam.it`,
-	`camau.vn`,
+	`cambridge.museum`,
 	`camera`,
 	`camp`,
 	`campania.it`,
@@ -717,9 +810,10 @@ var TLDs = []string{
 	`campinagrande.br`,
 	`campinas.br`,
 	`campobasso.it`,
+	`can.museum`,
+	`canada.museum`,
 	`canon`,
-	`cantho.vn`,
-	`caobang.vn`,
+	`capebreton.museum`,
 	`capetown`,
 	`capital`,
 	`capitalone`,
@@ -734,13 +828,18 @@ var TLDs = []string{
 	`cargo.aero`,
 	`carrara-massa.it`,
 	`carraramassa.it`,
+	`carrier.museum`,
 	`cars`,
+	`cartoonart.museum`,
 	`casa`,
+	`casadelamoneda.museum`,
 	`case`,
 	`caserta.it`,
 	`cash`,
 	`casino`,
 	`casino.hu`,
+	`castle.museum`,
+	`castres.museum`,
 	`cat`,
 	`catania.it`,
 	`catanzaro.it`,
@@ -753,6 +852,7 @@ var TLDs = []string{
 	`cba`,
 	`cbn`,
 	`cbre`,
+	`cbs`,
 	`cc`,
 	`cc.ak.us`,
 	`cc.al.us`,
@@ -814,7 +914,9 @@ var TLDs = []string{
 	`cd`,
 	`ce.gov.br`,
 	`ce.it`,
+	`celtic.museum`,
 	`center`,
+	`center.museum`,
 	`ceo`,
 	`cern`,
 	`certification.aero`,
@@ -828,6 +930,7 @@ var TLDs = []string{
 	`cg`,
 	`ch`,
 	`ch.it`,
+	`chambagri.fr`,
 	`championship.aero`,
 	`chanel`,
 	`channel`,
@@ -835,14 +938,18 @@ var TLDs = []string{
 	`charter.aero`,
 	`chase`,
 	`chat`,
+	`chattanooga.museum`,
 	`cheap`,
+	`cheltenham.museum`,
 	`cherkassy.ua`,
 	`cherkasy.ua`,
 	`chernigov.ua`,
 	`chernihiv.ua`,
 	`chernivtsi.ua`,
 	`chernovtsy.ua`,
+	`chesapeakebay.museum`,
 	`chiba.jp`,
+	`chicago.museum`,
 	`chichibu.saitama.jp`,
 	`chieti.it`,
 	`chigasaki.kanagawa.jp`,
@@ -856,20 +963,27 @@ var TLDs = []string{
 	`chikusei.ibaraki.jp`,
 	`chikushino.fukuoka.jp`,
 	`chikuzen.fukuoka.jp`,
+	`children.museum`,
+	`childrens.museum`,
+	`childrensgarden.museum`,
 	`chino.nagano.jp`,
 	`chintai`,
 	`chippubetsu.hokkaido.jp`,
+	`chiropractic.museum`,
+	`chirurgiens-dentistes.fr`,
 	`chiryu.aichi.jp`,
 	`chita.aichi.jp`,
 	`chitose.hokkaido.jp`,
 	`chiyoda.gunma.jp`,
 	`chiyoda.tokyo.jp`,
 	`chizu.tottori.jp`,
+	`chocolate.museum`,
 	`chofu.tokyo.jp`,
 	`chonan.chiba.jp`,
 	`chosei.chiba.jp`,
 	`choshi.chiba.jp`,
 	`choyo.kumamoto.jp`,
+	`christiansburg.museum`,
 	`christmas`,
 	`chrome`,
 	`chtr.k12.ma.us`,
@@ -886,22 +1000,22 @@ var TLDs = []string{
 	`ciencia.bo`,
 	`cieszyn.pl`,
 	`cim.br`,
+	`cincinnati.museum`,
+	`cinema.museum`,
 	`cipriani`,
 	`circle`,
+	`circus.museum`,
 	`cisco`,
 	`citadel`,
 	`citi`,
 	`citic`,
 	`city`,
 	`city.hu`,
-	`city.kawasaki.jp`,
-	`city.kitakyushu.jp`,
-	`city.kobe.jp`,
-	`city.nagoya.jp`,
-	`city.sapporo.jp`,
-	`city.sendai.jp`,
-	`city.yokohama.jp`,
+	`cityeats`,
 	`civilaviation.aero`,
+	`civilisation.museum`,
+	`civilization.museum`,
+	`civilwar.museum`,
 	`ck`,
 	`ck.ua`,
 	`cl`,
@@ -911,6 +1025,8 @@ var TLDs = []string{
 	`click`,
 	`clinic`,
 	`clinique`,
+	`clinton.museum`,
+	`clock.museum`,
 	`clothing`,
 	`cloud`,
 	`club`,
@@ -985,11 +1101,20 @@ var TLDs = []string{
 	`co.zm`,
 	`co.zw`,
 	`coach`,
+	`coal.museum`,
+	`coastaldefence.museum`,
 	`codes`,
+	`cody.museum`,
 	`coffee`,
 	`cog.mi.us`,
+	`coldwar.museum`,
+	`collection.museum`,
 	`college`,
 	`cologne`,
+	`colonialwilliamsburg.museum`,
+	`coloradoplateau.museum`,
+	`columbia.museum`,
+	`columbus.museum`,
 	`com`,
 	`com.ac`,
 	`com.af`,
@@ -1129,14 +1254,21 @@ var TLDs = []string{
 	`com.ws`,
 	`com.ye`,
 	`com.zm`,
+	`comcast`,
 	`commbank`,
 	`commune.am`,
+	`communication.museum`,
+	`communications.museum`,
 	`community`,
+	`community.museum`,
 	`como.it`,
 	`company`,
 	`compare`,
 	`computer`,
+	`computer.museum`,
+	`computerhistory.museum`,
 	`comsec`,
+	`comunicações.museum`,
 	`condos`,
 	`conf.au`,
 	`conf.lv`,
@@ -1148,9 +1280,13 @@ var TLDs = []string{
 	`consulting.aero`,
 	`contact`,
 	`contagem.br`,
+	`contemporary.museum`,
+	`contemporaryart.museum`,
 	`contractors`,
 	`control.aero`,
+	`convent.museum`,
 	`cooking`,
+	`cookingchannel`,
 	`cool`,
 	`coop`,
 	`coop.ar`,
@@ -1164,10 +1300,17 @@ var TLDs = []string{
 	`coop.rw`,
 	`coop.tt`,
 	`cooperativa.bo`,
+	`copenhagen.museum`,
+	`corporation.museum`,
+	`correios-e-telecomunicações.museum`,
 	`corsica`,
+	`corvette.museum`,
 	`cosenza.it`,
+	`costume.museum`,
 	`council.aero`,
 	`country`,
+	`countryestate.museum`,
+	`county.museum`,
 	`coupon`,
 	`coupons`,
 	`courses`,
@@ -1178,6 +1321,9 @@ var TLDs = []string{
 	`cr`,
 	`cr.it`,
 	`cr.ua`,
+	`crafts.museum`,
+	`cranbrook.museum`,
+	`creation.museum`,
 	`credit`,
 	`creditcard`,
 	`creditunion`,
@@ -1199,6 +1345,9 @@ var TLDs = []string{
 	`cu`,
 	`cuiaba.br`,
 	`cuisinella`,
+	`cultural.museum`,
+	`culturalcenter.museum`,
+	`culture.museum`,
 	`cuneo.it`,
 	`curitiba.br`,
 	`cv`,
@@ -1206,7 +1355,9 @@ var TLDs = []string{
 	`cw`,
 	`cx`,
 	`cy`,
+	`cyber.museum`,
 	`cymru`,
+	`cymru.museum`,
 	`cyou`,
 	`cz`,
 	`cz.it`,
@@ -1222,11 +1373,11 @@ var TLDs = []string{
 	`daisen.akita.jp`,
 	`daito.osaka.jp`,
 	`daiwa.hiroshima.jp`,
-	`daklak.vn`,
-	`daknong.vn`,
-	`danang.vn`,
+	`dali.museum`,
+	`dallas.museum`,
 	`dance`,
 	`data`,
+	`database.museum`,
 	`date`,
 	`date.fukushima.jp`,
 	`date.hokkaido.jp`,
@@ -1239,6 +1390,7 @@ var TLDs = []string{
 	`dazaifu.fukuoka.jp`,
 	`dc.us`,
 	`dclk`,
+	`ddr.museum`,
 	`dds`,
 	`de`,
 	`de.us`,
@@ -1246,27 +1398,34 @@ var TLDs = []string{
 	`dealer`,
 	`deals`,
 	`deatnu.no`,
+	`decorativearts.museum`,
 	`def.br`,
 	`degree`,
+	`delaware.museum`,
 	`delhi.in`,
 	`delivery`,
 	`dell`,
 	`dell-ogliastra.it`,
 	`dellogliastra.it`,
+	`delmenhorst.museum`,
 	`deloitte`,
 	`delta`,
 	`democracia.bo`,
 	`democrat`,
+	`denmark.museum`,
 	`dental`,
 	`dentist`,
 	`dep.no`,
 	`deporte.bo`,
+	`depot.museum`,
 	`des.br`,
 	`desa.id`,
 	`desi`,
 	`design`,
 	`design.aero`,
+	`design.museum`,
 	`det.br`,
+	`detroit.museum`,
 	`dev`,
 	`dev.br`,
 	`df.gov.br`,
@@ -1274,13 +1433,14 @@ var TLDs = []string{
 	`dhl`,
 	`diamonds`,
 	`dielddanuorri.no`,
-	`dienbien.vn`,
 	`diet`,
 	`digital`,
+	`dinosaur.museum`,
 	`direct`,
 	`directory`,
 	`discount`,
 	`discover`,
+	`discovery.museum`,
 	`dish`,
 	`divtasvuodna.no`,
 	`divttasvuotna.no`,
@@ -1298,11 +1458,11 @@ var TLDs = []string{
 	`docs`,
 	`doctor`,
 	`dog`,
+	`dolls.museum`,
 	`domains`,
 	`donetsk.ua`,
-	`dongnai.vn`,
-	`dongthap.vn`,
 	`donna.no`,
+	`donostia.museum`,
 	`doshi.yamanashi.jp`,
 	`dot`,
 	`dovre.no`,
@@ -1322,6 +1482,7 @@ var TLDs = []string{
 	`dunlop`,
 	`dupont`,
 	`durban`,
+	`durham.museum`,
 	`dvag`,
 	`dvr`,
 	`dyroy.no`,
@@ -1333,6 +1494,8 @@ var TLDs = []string{
 	`e12.ve`,
 	`e164.arpa`,
 	`earth`,
+	`eastafrica.museum`,
+	`eastcoast.museum`,
 	`eat`,
 	`eaton.mi.us`,
 	`ebetsu.hokkaido.jp`,
@@ -1483,10 +1646,13 @@ var TLDs = []string{
 	`edu.za`,
 	`edu.zm`,
 	`education`,
+	`education.museum`,
+	`educational.museum`,
 	`educator.aero`,
 	`ee`,
 	`eg`,
 	`egersund.no`,
+	`egyptian.museum`,
 	`ehime.jp`,
 	`eid.no`,
 	`eidfjord.no`,
@@ -1495,14 +1661,18 @@ var TLDs = []string{
 	`eidsvoll.no`,
 	`eigersund.no`,
 	`eiheiji.fukui.jp`,
+	`eisenbahn.museum`,
 	`ekloges.cy`,
 	`elblag.pl`,
+	`elburg.museum`,
 	`elk.pl`,
+	`elvendrell.museum`,
 	`elverum.no`,
 	`email`,
 	`emb.kw`,
 	`embaixada.st`,
 	`embetsu.hokkaido.jp`,
+	`embroidery.museum`,
 	`emerck`,
 	`emergency.aero`,
 	`emilia-romagna.it`,
@@ -1512,6 +1682,7 @@ var TLDs = []string{
 	`emr.it`,
 	`en.it`,
 	`ena.gifu.jp`,
+	`encyclopedic.museum`,
 	`enebakk.no`,
 	`energy`,
 	`enf.br`,
@@ -1522,11 +1693,16 @@ var TLDs = []string{
 	`engineer`,
 	`engineer.aero`,
 	`engineering`,
+	`england.museum`,
 	`eniwa.hokkaido.jp`,
 	`enna.it`,
 	`ens.tn`,
 	`enterprises`,
 	`entertainment.aero`,
+	`entomology.museum`,
+	`environment.museum`,
+	`environmentalconservation.museum`,
+	`epilepsy.museum`,
 	`epson`,
 	`equipment`,
 	`equipment.aero`,
@@ -1544,12 +1720,16 @@ var TLDs = []string{
 	`esashi.hokkaido.jp`,
 	`esp.br`,
 	`esq`,
+	`essex.museum`,
 	`est.pr`,
 	`estate`,
+	`estate.museum`,
 	`et`,
 	`etajima.hiroshima.jp`,
 	`etc.br`,
+	`ethnology.museum`,
 	`eti.br`,
+	`etisalat`,
 	`etne.no`,
 	`etnedal.no`,
 	`eu`,
@@ -1564,7 +1744,10 @@ var TLDs = []string{
 	`evje-og-hornnes.no`,
 	`exchange`,
 	`exchange.aero`,
+	`exeter.museum`,
+	`exhibition.museum`,
 	`expert`,
+	`experts-comptables.fr`,
 	`exposed`,
 	`express`,
 	`express.aero`,
@@ -1577,11 +1760,16 @@ var TLDs = []string{
 	`faith`,
 	`fam.pk`,
 	`family`,
+	`family.museum`,
 	`fan`,
 	`fans`,
 	`far.br`,
 	`farm`,
+	`farm.museum`,
+	`farmequipment.museum`,
 	`farmers`,
+	`farmers.museum`,
+	`farmstead.museum`,
 	`farsund.no`,
 	`fashion`,
 	`fast`,
@@ -1608,16 +1796,24 @@ var TLDs = []string{
 	`fi`,
 	`fi.cr`,
 	`fi.it`,
+	`fiat`,
 	`fidelity`,
 	`fido`,
 	`fie.ee`,
+	`field.museum`,
+	`figueres.museum`,
+	`filatelia.museum`,
 	`film`,
 	`film.hu`,
+	`film.museum`,
 	`fin.ec`,
 	`fin.tn`,
 	`final`,
 	`finance`,
 	`financial`,
+	`fineart.museum`,
+	`finearts.museum`,
+	`finland.museum`,
 	`finnoy.no`,
 	`finnøy.no`,
 	`fire`,
@@ -1643,6 +1839,7 @@ var TLDs = []string{
 	`fl.us`,
 	`fla.no`,
 	`flakstad.no`,
+	`flanders.museum`,
 	`flatanger.no`,
 	`flekkefjord.no`,
 	`flesberg.no`,
@@ -1653,6 +1850,7 @@ var TLDs = []string{
 	`flog.br`,
 	`flora.no`,
 	`florence.it`,
+	`florida.museum`,
 	`floripa.br`,
 	`florist`,
 	`floro.no`,
@@ -1671,7 +1869,9 @@ var TLDs = []string{
 	`folldal.no`,
 	`foo`,
 	`food`,
+	`foodnetwork`,
 	`football`,
+	`force.museum`,
 	`ford`,
 	`forde.no`,
 	`forex`,
@@ -1682,20 +1882,29 @@ var TLDs = []string{
 	`forsale`,
 	`forsand.no`,
 	`fortal.br`,
+	`fortmissoula.museum`,
+	`fortworth.museum`,
 	`forum`,
 	`forum.hu`,
 	`fosnes.no`,
 	`fot.br`,
 	`foundation`,
+	`foundation.museum`,
 	`fox`,
 	`foz.br`,
 	`fr`,
 	`fr.it`,
 	`frana.no`,
+	`francaise.museum`,
+	`frankfurt.museum`,
+	`franziskaner.museum`,
 	`fredrikstad.no`,
 	`free`,
+	`freemasonry.museum`,
 	`frei.no`,
+	`freiburg.museum`,
 	`fresenius`,
+	`fribourg.museum`,
 	`friuli-v-giulia.it`,
 	`friuli-ve-giulia.it`,
 	`friuli-vegiulia.it`,
@@ -1709,10 +1918,12 @@ var TLDs = []string{
 	`friuliveneziagiulia.it`,
 	`friulivgiulia.it`,
 	`frl`,
+	`frog.museum`,
 	`frogans`,
 	`frogn.no`,
 	`froland.no`,
 	`from.hr`,
+	`frontdoor`,
 	`frontier`,
 	`frosinone.it`,
 	`frosta.no`,
@@ -1764,10 +1975,12 @@ var TLDs = []string{
 	`funagata.yamagata.jp`,
 	`funahashi.toyama.jp`,
 	`fund`,
+	`fundacio.museum`,
 	`fuoisku.no`,
 	`fuossko.no`,
 	`furano.hokkaido.jp`,
 	`furniture`,
+	`furniture.museum`,
 	`furubira.hokkaido.jp`,
 	`furudono.fukushima.jp`,
 	`furukawa.miyagi.jp`,
@@ -1791,6 +2004,7 @@ var TLDs = []string{
 	`gaivuotna.no`,
 	`gal`,
 	`gallery`,
+	`gallery.museum`,
 	`gallo`,
 	`gallup`,
 	`galsa.no`,
@@ -1805,6 +2019,8 @@ var TLDs = []string{
 	`gangwon.kr`,
 	`gap`,
 	`garden`,
+	`garden.museum`,
+	`gateway.museum`,
 	`gaular.no`,
 	`gausdal.no`,
 	`gay`,
@@ -1818,7 +2034,9 @@ var TLDs = []string{
 	`ge.it`,
 	`gea`,
 	`geek.nz`,
+	`geelvinck.museum`,
 	`geisei.kochi.jp`,
+	`gemological.museum`,
 	`gen.in`,
 	`gen.mi.us`,
 	`gen.nz`,
@@ -1829,15 +2047,18 @@ var TLDs = []string{
 	`gent`,
 	`genting`,
 	`geo.br`,
+	`geology.museum`,
+	`geometre-expert.fr`,
 	`george`,
+	`georgia.museum`,
 	`gf`,
 	`gg`,
 	`ggee`,
 	`ggf.br`,
 	`gh`,
 	`gi`,
-	`gialai.vn`,
 	`giehtavuoatna.no`,
+	`giessen.museum`,
 	`gift`,
 	`gifts`,
 	`gifu.gifu.jp`,
@@ -1857,7 +2078,9 @@ var TLDs = []string{
 	`gjovik.no`,
 	`gjøvik.no`,
 	`gl`,
+	`glas.museum`,
 	`glass`,
+	`glass.museum`,
 	`gle`,
 	`gliding.aero`,
 	`global`,
@@ -1919,6 +2142,7 @@ var TLDs = []string{
 	`google`,
 	`gop`,
 	`gop.pk`,
+	`gorge.museum`,
 	`gorizia.it`,
 	`gorlice.pl`,
 	`gos.pk`,
@@ -2072,11 +2296,13 @@ var TLDs = []string{
 	`grainger`,
 	`grajewo.pl`,
 	`gran.no`,
+	`grandrapids.museum`,
 	`grane.no`,
 	`granvin.no`,
 	`graphics`,
 	`gratangen.no`,
 	`gratis`,
+	`graz.museum`,
 	`green`,
 	`greta.fr`,
 	`grimstad.no`,
@@ -2123,6 +2349,7 @@ var TLDs = []string{
 	`guardian`,
 	`gub.uy`,
 	`gucci`,
+	`guernsey.museum`,
 	`guge`,
 	`guide`,
 	`guitars`,
@@ -2164,10 +2391,7 @@ var TLDs = []string{
 	`haga.tochigi.jp`,
 	`hagebostad.no`,
 	`hagi.yamaguchi.jp`,
-	`hagiang.vn`,
 	`haibara.shizuoka.jp`,
-	`haiduong.vn`,
-	`haiphong.vn`,
 	`hair`,
 	`hakata.fukuoka.jp`,
 	`hakodate.hokkaido.jp`,
@@ -2176,6 +2400,7 @@ var TLDs = []string{
 	`hakui.ishikawa.jp`,
 	`hakusan.ishikawa.jp`,
 	`halden.no`,
+	`halloffame.museum`,
 	`halsa.no`,
 	`hamada.shimane.jp`,
 	`hamamatsu.shizuoka.jp`,
@@ -2184,19 +2409,19 @@ var TLDs = []string{
 	`hamatama.saga.jp`,
 	`hamatonbetsu.hokkaido.jp`,
 	`hamburg`,
+	`hamburg.museum`,
 	`hammarfeasta.no`,
 	`hammerfest.no`,
 	`hamura.tokyo.jp`,
-	`hanam.vn`,
 	`hanamaki.iwate.jp`,
 	`hanamigawa.chiba.jp`,
 	`hanawa.fukushima.jp`,
 	`handa.aichi.jp`,
+	`handson.museum`,
 	`hanggliding.aero`,
 	`hangout`,
 	`hannan.osaka.jp`,
 	`hanno.saitama.jp`,
-	`hanoi.vn`,
 	`hanyu.saitama.jp`,
 	`hapmir.no`,
 	`happou.akita.jp`,
@@ -2205,6 +2430,7 @@ var TLDs = []string{
 	`hareid.no`,
 	`harima.hyogo.jp`,
 	`harstad.no`,
+	`harvestcelebration.museum`,
 	`hasama.oita.jp`,
 	`hasami.nagasaki.jp`,
 	`hashikami.aomori.jp`,
@@ -2212,14 +2438,13 @@ var TLDs = []string{
 	`hashimoto.wakayama.jp`,
 	`hasuda.saitama.jp`,
 	`hasvik.no`,
-	`hatinh.vn`,
 	`hatogaya.saitama.jp`,
 	`hatoyama.saitama.jp`,
 	`hatsukaichi.hiroshima.jp`,
 	`hattfjelldal.no`,
 	`haugesund.no`,
-	`haugiang.vn`,
 	`haus`,
+	`hawaii.museum`,
 	`hayakawa.yamanashi.jp`,
 	`hayashima.okayama.jp`,
 	`hazu.aichi.jp`,
@@ -2229,23 +2454,30 @@ var TLDs = []string{
 	`hdfcbank`,
 	`he.cn`,
 	`health`,
+	`health.museum`,
 	`health.nz`,
 	`health.vn`,
 	`healthcare`,
 	`heguri.nara.jp`,
+	`heimatunduhren.museum`,
 	`hekinan.aichi.jp`,
+	`hellas.museum`,
 	`help`,
 	`helsinki`,
+	`helsinki.museum`,
+	`hembygdsforbund.museum`,
 	`hemne.no`,
 	`hemnes.no`,
 	`hemsedal.no`,
 	`herad.no`,
 	`here`,
+	`heritage.museum`,
 	`hermes`,
 	`heroy.more-og-romsdal.no`,
 	`heroy.nordland.no`,
 	`herøy.møre-og-romsdal.no`,
 	`herøy.nordland.no`,
+	`hgtv`,
 	`hi.cn`,
 	`hi.us`,
 	`hichiso.gifu.jp`,
@@ -2312,6 +2544,14 @@ var TLDs = []string{
 	`hiroshima.jp`,
 	`hisamitsu`,
 	`hisayama.fukuoka.jp`,
+	`histoire.museum`,
+	`historical.museum`,
+	`historicalsociety.museum`,
+	`historichouses.museum`,
+	`historisch.museum`,
+	`historisches.museum`,
+	`history.museum`,
+	`historyofscience.museum`,
 	`hita.oita.jp`,
 	`hitachi`,
 	`hitachi.ibaraki.jp`,
@@ -2332,7 +2572,6 @@ var TLDs = []string{
 	`hm.no`,
 	`hn`,
 	`hn.cn`,
-	`hoabinh.vn`,
 	`hobol.no`,
 	`hobøl.no`,
 	`hockey`,
@@ -2365,6 +2604,7 @@ var TLDs = []string{
 	`honjyo.akita.jp`,
 	`hornindal.no`,
 	`horokanai.hokkaido.jp`,
+	`horology.museum`,
 	`horonobe.hokkaido.jp`,
 	`horse`,
 	`horten.no`,
@@ -2375,9 +2615,11 @@ var TLDs = []string{
 	`hotel.hu`,
 	`hotel.lk`,
 	`hotel.tz`,
+	`hoteles`,
 	`hotels`,
 	`hotmail`,
 	`house`,
+	`house.museum`,
 	`how`,
 	`hoyanger.no`,
 	`hoylandet.no`,
@@ -2388,7 +2630,7 @@ var TLDs = []string{
 	`hu`,
 	`hughes`,
 	`huissier-justice.fr`,
-	`hungyen.vn`,
+	`humanities.museum`,
 	`hurdal.no`,
 	`hurum.no`,
 	`hvaler.no`,
@@ -2437,7 +2679,6 @@ var TLDs = []string{
 	`id.lv`,
 	`id.ly`,
 	`id.us`,
-	`id.vn`,
 	`ide.kyoto.jp`,
 	`idf.il`,
 	`idrett.no`,
@@ -2472,9 +2713,11 @@ var TLDs = []string{
 	`il`,
 	`il.us`,
 	`ilawa.pl`,
+	`illustration.museum`,
 	`im`,
 	`im.it`,
 	`imabari.ehime.jp`,
+	`imageandsound.museum`,
 	`imakane.hokkaido.jp`,
 	`imamat`,
 	`imari.saga.jp`,
@@ -2513,6 +2756,10 @@ var TLDs = []string{
 	`ind.tn`,
 	`inderoy.no`,
 	`inderøy.no`,
+	`indian.museum`,
+	`indiana.museum`,
+	`indianapolis.museum`,
+	`indianmarket.museum`,
 	`indigena.bo`,
 	`industria.bo`,
 	`industries`,
@@ -2584,6 +2831,8 @@ var TLDs = []string{
 	`int.tt`,
 	`int.ve`,
 	`int.vn`,
+	`intelligence.museum`,
+	`interactive.museum`,
 	`international`,
 	`internet.in`,
 	`intl.tn`,
@@ -2593,13 +2842,14 @@ var TLDs = []string{
 	`inzai.chiba.jp`,
 	`io`,
 	`io.in`,
-	`io.vn`,
 	`ip6.arpa`,
 	`ipiranga`,
 	`iq`,
 	`ir`,
+	`iraq.museum`,
 	`iris.arpa`,
 	`irish`,
+	`iron.museum`,
 	`iruma.saitama.jp`,
 	`is`,
 	`is.gov.pl`,
@@ -2619,6 +2869,7 @@ var TLDs = []string{
 	`ishikawa.okinawa.jp`,
 	`ishinomaki.miyagi.jp`,
 	`isla.pr`,
+	`isleofman.museum`,
 	`ismaili`,
 	`isshiki.aichi.jp`,
 	`ist`,
@@ -2671,6 +2922,7 @@ var TLDs = []string{
 	`j.bg`,
 	`jab.br`,
 	`jaguar`,
+	`jamison.museum`,
 	`jampa.br`,
 	`jan-mayen.no`,
 	`java`,
@@ -2679,14 +2931,20 @@ var TLDs = []string{
 	`jdf.br`,
 	`je`,
 	`jeep`,
+	`jefferson.museum`,
 	`jeju.kr`,
 	`jelenia-gora.pl`,
 	`jeonbuk.kr`,
 	`jeonnam.kr`,
+	`jerusalem.museum`,
 	`jessheim.no`,
 	`jetzt`,
 	`jevnaker.no`,
 	`jewelry`,
+	`jewelry.museum`,
+	`jewish.museum`,
+	`jewishart.museum`,
+	`jfk.museum`,
 	`jgora.pl`,
 	`jinsekikogen.hiroshima.jp`,
 	`jio`,
@@ -2711,6 +2969,7 @@ var TLDs = []string{
 	`joso.ibaraki.jp`,
 	`jot`,
 	`journal.aero`,
+	`journalism.museum`,
 	`journalist.aero`,
 	`joy`,
 	`joyo.kyoto.jp`,
@@ -2718,7 +2977,11 @@ var TLDs = []string{
 	`jpmorgan`,
 	`jprs`,
 	`js.cn`,
+	`judaica.museum`,
+	`judygarland.museum`,
+	`juedisches.museum`,
 	`juegos`,
+	`juif.museum`,
 	`juniper`,
 	`jur.pro`,
 	`jus.br`,
@@ -2736,6 +2999,7 @@ var TLDs = []string{
 	`k12.co.us`,
 	`k12.ct.us`,
 	`k12.dc.us`,
+	`k12.de.us`,
 	`k12.ec`,
 	`k12.fl.us`,
 	`k12.ga.us`,
@@ -2857,7 +3121,9 @@ var TLDs = []string{
 	`karasjohka.no`,
 	`karasjok.no`,
 	`karasuyama.tochigi.jp`,
+	`karate.museum`,
 	`karatsu.saga.jp`,
+	`karikatur.museum`,
 	`kariwa.niigata.jp`,
 	`kariya.aichi.jp`,
 	`karlsoy.no`,
@@ -2918,7 +3184,6 @@ var TLDs = []string{
 	`kawanishi.nara.jp`,
 	`kawanishi.yamagata.jp`,
 	`kawara.fukuoka.jp`,
-	`kawasaki.jp`,
 	`kawasaki.miyagi.jp`,
 	`kawatana.nagasaki.jp`,
 	`kawaue.gifu.jp`,
@@ -2942,7 +3207,6 @@ var TLDs = []string{
 	`kg.kr`,
 	`kh`,
 	`kh.ua`,
-	`khanhhoa.vn`,
 	`kharkiv.ua`,
 	`kharkov.ua`,
 	`kherson.ua`,
@@ -2952,8 +3216,8 @@ var TLDs = []string{
 	`kia`,
 	`kibichuo.okayama.jp`,
 	`kids`,
+	`kids.museum`,
 	`kids.us`,
-	`kiengiang.vn`,
 	`kiev.ua`,
 	`kiho.mie.jp`,
 	`kihoku.ehime.jp`,
@@ -2966,6 +3230,7 @@ var TLDs = []string{
 	`kimitsu.chiba.jp`,
 	`kimobetsu.hokkaido.jp`,
 	`kin.okinawa.jp`,
+	`kinder`,
 	`kindle`,
 	`kinko.kagoshima.jp`,
 	`kinokawa.wakayama.jp`,
@@ -2993,7 +3258,6 @@ var TLDs = []string{
 	`kitakami.iwate.jp`,
 	`kitakata.fukushima.jp`,
 	`kitakata.miyazaki.jp`,
-	`kitakyushu.jp`,
 	`kitami.hokkaido.jp`,
 	`kitamoto.saitama.jp`,
 	`kitanakagusuku.okinawa.jp`,
@@ -3019,12 +3283,13 @@ var TLDs = []string{
 	`kmpsp.gov.pl`,
 	`kn`,
 	`kobayashi.miyazaki.jp`,
-	`kobe.jp`,
 	`kobierzyce.pl`,
 	`kochi.jp`,
 	`kochi.kochi.jp`,
 	`kodaira.tokyo.jp`,
+	`koebenhavn.museum`,
 	`koeln`,
+	`koeln.museum`,
 	`kofu.yamanashi.jp`,
 	`koga.fukuoka.jp`,
 	`koga.ibaraki.jp`,
@@ -3053,7 +3318,6 @@ var TLDs = []string{
 	`konin.pl`,
 	`konskowola.pl`,
 	`konsulat.gov.pl`,
-	`kontum.vn`,
 	`konyvelo.hu`,
 	`koori.fukushima.jp`,
 	`kopervik.no`,
@@ -3096,7 +3360,6 @@ var TLDs = []string{
 	`kristiansund.no`,
 	`krodsherad.no`,
 	`krokstadelva.no`,
-	`kropyvnytskyi.ua`,
 	`krym.ua`,
 	`kråanghke.no`,
 	`krødsherad.no`,
@@ -3127,6 +3390,9 @@ var TLDs = []string{
 	`kunitomi.miyazaki.jp`,
 	`kunneppu.hokkaido.jp`,
 	`kunohe.iwate.jp`,
+	`kunst.museum`,
+	`kunstsammlung.museum`,
+	`kunstunddesign.museum`,
 	`kuokgroup`,
 	`kurashiki.okayama.jp`,
 	`kurate.fukuoka.jp`,
@@ -3185,25 +3451,29 @@ var TLDs = []string{
 	`la-spezia.it`,
 	`la.us`,
 	`laakesvuemie.no`,
+	`labor.museum`,
+	`labour.museum`,
 	`lacaixa`,
 	`lahppi.no`,
-	`laichau.vn`,
+	`lajolla.museum`,
 	`lakas.hu`,
 	`lamborghini`,
-	`lamdong.vn`,
 	`lamer`,
 	`lanbib.se`,
+	`lancashire.museum`,
 	`lancaster`,
+	`lancia`,
 	`land`,
+	`landes.museum`,
 	`landrover`,
 	`langevag.no`,
 	`langevåg.no`,
-	`langson.vn`,
+	`lans.museum`,
 	`lanxess`,
-	`laocai.vn`,
 	`lapy.pl`,
 	`laquila.it`,
 	`lardal.no`,
+	`larsson.museum`,
 	`larvik.no`,
 	`lasalle`,
 	`laspezia.it`,
@@ -3248,6 +3518,7 @@ var TLDs = []string{
 	`lerdal.no`,
 	`lesja.no`,
 	`levanger.no`,
+	`lewismiller.museum`,
 	`lexus`,
 	`lezajsk.pl`,
 	`lg.jp`,
@@ -3326,13 +3597,18 @@ var TLDs = []string{
 	`limited`,
 	`limo`,
 	`lincoln`,
+	`lincoln.museum`,
 	`lindas.no`,
+	`linde`,
 	`lindesnes.no`,
 	`lindås.no`,
 	`link`,
+	`linz.museum`,
 	`lipsy`,
 	`live`,
 	`living`,
+	`living.museum`,
+	`livinghistory.museum`,
 	`livorno.it`,
 	`lk`,
 	`llc`,
@@ -3343,6 +3619,7 @@ var TLDs = []string{
 	`loabát.no`,
 	`loan`,
 	`loans`,
+	`localhistory.museum`,
 	`locker`,
 	`locus`,
 	`lodi.it`,
@@ -3357,15 +3634,18 @@ var TLDs = []string{
 	`lombardy.it`,
 	`lomza.pl`,
 	`london`,
+	`london.museum`,
 	`londrina.br`,
-	`longan.vn`,
 	`loppa.no`,
 	`lorenskog.no`,
+	`losangeles.museum`,
 	`loten.no`,
 	`lotte`,
 	`lotto`,
+	`louvre.museum`,
 	`love`,
 	`lowicz.pl`,
+	`loyalist.museum`,
 	`lpl`,
 	`lplfinancial`,
 	`lr`,
@@ -3385,8 +3665,8 @@ var TLDs = []string{
 	`lubin.pl`,
 	`lucania.it`,
 	`lucca.it`,
+	`lucerne.museum`,
 	`lugansk.ua`,
-	`luhansk.ua`,
 	`lukow.pl`,
 	`lund.no`,
 	`lundbeck`,
@@ -3396,7 +3676,9 @@ var TLDs = []string{
 	`luster.no`,
 	`lutsk.ua`,
 	`luxe`,
+	`luxembourg.museum`,
 	`luxury`,
+	`luzern.museum`,
 	`lv`,
 	`lv.ua`,
 	`lviv.ua`,
@@ -3404,6 +3686,7 @@ var TLDs = []string{
 	`lyngdal.no`,
 	`lyngen.no`,
 	`láhppi.no`,
+	`läns.museum`,
 	`lærdal.no`,
 	`lødingen.no`,
 	`lørenskog.no`,
@@ -3417,7 +3700,10 @@ var TLDs = []string{
 	`maceio.br`,
 	`macerata.it`,
 	`machida.tokyo.jp`,
+	`macys`,
+	`mad.museum`,
 	`madrid`,
+	`madrid.museum`,
 	`maebashi.gunma.jp`,
 	`magazine.aero`,
 	`maibara.shiga.jp`,
@@ -3431,6 +3717,7 @@ var TLDs = []string{
 	`makurazaki.kagoshima.jp`,
 	`malatvuopmi.no`,
 	`malbork.pl`,
+	`mallorca.museum`,
 	`malopolska.pl`,
 	`malselv.no`,
 	`malvik.no`,
@@ -3438,16 +3725,23 @@ var TLDs = []string{
 	`man`,
 	`management`,
 	`manaus.br`,
+	`manchester.museum`,
 	`mandal.no`,
 	`mango`,
 	`maniwa.okayama.jp`,
 	`manno.kagawa.jp`,
+	`mansion.museum`,
+	`mansions.museum`,
 	`mantova.it`,
+	`manx.museum`,
 	`maori.nz`,
 	`map`,
 	`mar.it`,
+	`marburg.museum`,
 	`marche.it`,
 	`maringa.br`,
+	`maritime.museum`,
+	`maritimo.museum`,
 	`marker.no`,
 	`market`,
 	`marketing`,
@@ -3457,7 +3751,10 @@ var TLDs = []string{
 	`marshalls`,
 	`marugame.kagawa.jp`,
 	`marumori.miyagi.jp`,
+	`maryland.museum`,
+	`marylhurst.museum`,
 	`masaki.ehime.jp`,
+	`maserati`,
 	`masfjorden.no`,
 	`mashike.hokkaido.jp`,
 	`mashiki.kumamoto.jp`,
@@ -3517,14 +3814,19 @@ var TLDs = []string{
 	`med.pro`,
 	`med.sa`,
 	`med.sd`,
+	`medecin.fr`,
 	`medecin.km`,
 	`media`,
 	`media.aero`,
 	`media.hu`,
+	`media.museum`,
 	`media.pl`,
+	`medical.museum`,
 	`medicina.bo`,
 	`medio-campidano.it`,
 	`mediocampidano.it`,
+	`medizinhistorisches.museum`,
+	`meeres.museum`,
 	`meet`,
 	`meguro.tokyo.jp`,
 	`meiwa.gunma.jp`,
@@ -3537,11 +3839,13 @@ var TLDs = []string{
 	`meløy.no`,
 	`meme`,
 	`memorial`,
+	`memorial.museum`,
 	`men`,
 	`menu`,
 	`meraker.no`,
 	`merckmsd`,
 	`meråker.no`,
+	`mesaverde.museum`,
 	`messina.it`,
 	`mg`,
 	`mg.gov.br`,
@@ -3553,8 +3857,10 @@ var TLDs = []string{
 	`miasa.nagano.jp`,
 	`miasta.pl`,
 	`mibu.tochigi.jp`,
+	`michigan.museum`,
 	`microlight.aero`,
 	`microsoft`,
+	`midatlantic.museum`,
 	`midori.chiba.jp`,
 	`midori.gunma.jp`,
 	`midsund.no`,
@@ -3638,6 +3944,8 @@ var TLDs = []string{
 	`mil.zw`,
 	`milan.it`,
 	`milano.it`,
+	`military.museum`,
+	`mill.museum`,
 	`mima.tokushima.jp`,
 	`mimata.miyazaki.jp`,
 	`minakami.gunma.jp`,
@@ -3666,7 +3974,10 @@ var TLDs = []string{
 	`minato.osaka.jp`,
 	`minato.tokyo.jp`,
 	`mincom.tn`,
+	`miners.museum`,
 	`mini`,
+	`mining.museum`,
+	`minnesota.museum`,
 	`mino.gifu.jp`,
 	`minobu.yamanashi.jp`,
 	`minoh.osaka.jp`,
@@ -3684,6 +3995,8 @@ var TLDs = []string{
 	`misawa.aomori.jp`,
 	`mishima.fukushima.jp`,
 	`mishima.shizuoka.jp`,
+	`missile.museum`,
+	`missoula.museum`,
 	`misugi.mie.jp`,
 	`mit`,
 	`mitaka.tokyo.jp`,
@@ -3751,6 +4064,7 @@ var TLDs = []string{
 	`modalen.no`,
 	`modelling.aero`,
 	`modena.it`,
+	`modern.museum`,
 	`modum.no`,
 	`moe`,
 	`moi`,
@@ -3759,11 +4073,16 @@ var TLDs = []string{
 	`molde.no`,
 	`molise.it`,
 	`mom`,
+	`moma.museum`,
 	`mombetsu.hokkaido.jp`,
 	`monash`,
 	`money`,
 	`money.bj`,
+	`money.museum`,
+	`monmouth.museum`,
 	`monster`,
+	`monticello.museum`,
+	`montreal.museum`,
 	`monza-brianza.it`,
 	`monza-e-della-brianza.it`,
 	`monza.it`,
@@ -3782,6 +4101,7 @@ var TLDs = []string{
 	`moroyama.saitama.jp`,
 	`mortgage`,
 	`moscow`,
+	`moscow.museum`,
 	`moseushi.hokkaido.jp`,
 	`mosjoen.no`,
 	`mosjøen.no`,
@@ -3791,6 +4111,7 @@ var TLDs = []string{
 	`motegi.tochigi.jp`,
 	`moto`,
 	`motobu.okinawa.jp`,
+	`motorcycle.museum`,
 	`motorcycles`,
 	`motosu.gifu.jp`,
 	`motoyama.kochi.jp`,
@@ -3817,11 +4138,15 @@ var TLDs = []string{
 	`mtn`,
 	`mtr`,
 	`mu`,
+	`muenchen.museum`,
+	`muenster.museum`,
 	`mugi.tokushima.jp`,
 	`muika.niigata.jp`,
 	`mukawa.hokkaido.jp`,
 	`muko.kyoto.jp`,
+	`mulhouse.museum`,
 	`munakata.fukuoka.jp`,
+	`muncie.museum`,
 	`muni.il`,
 	`muosat.no`,
 	`muosát.no`,
@@ -3835,17 +4160,22 @@ var TLDs = []string{
 	`mus.mi.us`,
 	`musashimurayama.tokyo.jp`,
 	`musashino.tokyo.jp`,
+	`museet.museum`,
 	`museum`,
 	`museum.mv`,
 	`museum.mw`,
 	`museum.no`,
 	`museum.om`,
 	`museum.tt`,
+	`museumcenter.museum`,
+	`museumvereniging.museum`,
 	`music`,
+	`music.museum`,
 	`musica.ar`,
 	`musica.bo`,
 	`mutsu.aomori.jp`,
 	`mutsuzawa.chiba.jp`,
+	`mutual`,
 	`mutual.ar`,
 	`mv`,
 	`mw`,
@@ -3889,7 +4219,6 @@ var TLDs = []string{
 	`nagiso.nagano.jp`,
 	`nago.okinawa.jp`,
 	`nagoya`,
-	`nagoya.jp`,
 	`naha.okinawa.jp`,
 	`nahari.kochi.jp`,
 	`naie.hokkaido.jp`,
@@ -3919,7 +4248,6 @@ var TLDs = []string{
 	`nakijin.okinawa.jp`,
 	`naklo.pl`,
 	`namdalseid.no`,
-	`namdinh.vn`,
 	`name`,
 	`name.az`,
 	`name.eg`,
@@ -3974,11 +4302,23 @@ var TLDs = []string{
 	`nasushiobara.tochigi.jp`,
 	`nat.tn`,
 	`natal.br`,
+	`national.museum`,
+	`nationalfirearms.museum`,
+	`nationalheritage.museum`,
+	`nativeamerican.museum`,
 	`natori.miyagi.jp`,
 	`natura`,
 	`natural.bo`,
+	`naturalhistory.museum`,
+	`naturalhistorymuseum.museum`,
+	`naturalsciences.museum`,
 	`naturbruksgymn.se`,
+	`nature.museum`,
+	`naturhistorisches.museum`,
+	`natuurwetenschappen.museum`,
+	`naumburg.museum`,
 	`naustdal.no`,
+	`naval.museum`,
 	`navigation.aero`,
 	`navuotna.no`,
 	`navy`,
@@ -3997,6 +4337,7 @@ var TLDs = []string{
 	`ne.tz`,
 	`ne.ug`,
 	`ne.us`,
+	`nebraska.museum`,
 	`nec`,
 	`nedre-eiker.no`,
 	`nemuro.hokkaido.jp`,
@@ -4146,10 +4487,17 @@ var TLDs = []string{
 	`netbank`,
 	`netflix`,
 	`network`,
+	`neues.museum`,
 	`neustar`,
 	`new`,
+	`newhampshire.museum`,
+	`newjersey.museum`,
+	`newmexico.museum`,
+	`newport.museum`,
 	`news`,
 	`news.hu`,
+	`newspaper.museum`,
+	`newyork.museum`,
 	`next`,
 	`nextdirect`,
 	`nexus`,
@@ -4158,7 +4506,6 @@ var TLDs = []string{
 	`nf.ca`,
 	`nfl`,
 	`ng`,
-	`nghean.vn`,
 	`ngo`,
 	`ngo.lk`,
 	`ngo.ph`,
@@ -4173,6 +4520,7 @@ var TLDs = []string{
 	`nichinan.miyazaki.jp`,
 	`nichinan.tottori.jp`,
 	`nico`,
+	`niepce.museum`,
 	`nieruchomosci.pl`,
 	`niigata.jp`,
 	`niigata.niigata.jp`,
@@ -4186,8 +4534,6 @@ var TLDs = []string{
 	`nikko.tochigi.jp`,
 	`nikolaev.ua`,
 	`nikon`,
-	`ninhbinh.vn`,
-	`ninhthuan.vn`,
 	`ninja`,
 	`ninohe.iwate.jp`,
 	`ninomiya.kanagawa.jp`,
@@ -4239,7 +4585,6 @@ var TLDs = []string{
 	`nokia`,
 	`nom.ad`,
 	`nom.ag`,
-	`nom.br`,
 	`nom.co`,
 	`nom.es`,
 	`nom.fr`,
@@ -4268,11 +4613,15 @@ var TLDs = []string{
 	`nordre-land.no`,
 	`nordreisa.no`,
 	`nore-og-uvdal.no`,
+	`norfolk.museum`,
+	`north.museum`,
+	`northwesternmutual`,
 	`norton`,
 	`nose.osaka.jp`,
 	`nosegawa.nara.jp`,
 	`noshiro.akita.jp`,
 	`not.br`,
+	`notaires.fr`,
 	`notaires.km`,
 	`noticias.bo`,
 	`noto.ishikawa.jp`,
@@ -4289,6 +4638,7 @@ var TLDs = []string{
 	`nr`,
 	`nra`,
 	`nrw`,
+	`nrw.museum`,
 	`ns.ca`,
 	`nsn.us`,
 	`nsw.au`,
@@ -4311,6 +4661,8 @@ var TLDs = []string{
 	`nx.cn`,
 	`ny.us`,
 	`nyc`,
+	`nyc.museum`,
+	`nyny.museum`,
 	`nysa.pl`,
 	`nyuzen.toyama.jp`,
 	`nz`,
@@ -4331,6 +4683,8 @@ var TLDs = []string{
 	`observer`,
 	`obu.aichi.jp`,
 	`obuse.nagano.jp`,
+	`oceanographic.museum`,
+	`oceanographique.museum`,
 	`ochi.kochi.jp`,
 	`od.ua`,
 	`odate.akita.jp`,
@@ -4373,7 +4727,6 @@ var TLDs = []string{
 	`ohkura.yamagata.jp`,
 	`ohtawara.tochigi.jp`,
 	`oi.kanagawa.jp`,
-	`oia.gov.pl`,
 	`oirase.aomori.jp`,
 	`oirm.gov.pl`,
 	`oishida.yamagata.jp`,
@@ -4391,7 +4744,6 @@ var TLDs = []string{
 	`okayama.jp`,
 	`okayama.okayama.jp`,
 	`okazaki.aichi.jp`,
-	`oke.gov.pl`,
 	`okegawa.saitama.jp`,
 	`oketo.hokkaido.jp`,
 	`oki.fukuoka.jp`,
@@ -4410,6 +4762,7 @@ var TLDs = []string{
 	`olayangroup`,
 	`olbia-tempio.it`,
 	`olbiatempio.it`,
+	`oldnavy`,
 	`olecko.pl`,
 	`olkusz.pl`,
 	`ollo`,
@@ -4418,6 +4771,7 @@ var TLDs = []string{
 	`omachi.nagano.jp`,
 	`omachi.saga.jp`,
 	`omaezaki.shizuoka.jp`,
+	`omaha.museum`,
 	`omasvuotna.no`,
 	`ome.tokyo.jp`,
 	`omega`,
@@ -4440,17 +4794,19 @@ var TLDs = []string{
 	`onjuku.chiba.jp`,
 	`onl`,
 	`online`,
+	`online.museum`,
 	`onna.okinawa.jp`,
 	`ono.fukui.jp`,
 	`ono.fukushima.jp`,
 	`ono.hyogo.jp`,
 	`onojo.fukuoka.jp`,
 	`onomichi.hiroshima.jp`,
+	`ontario.museum`,
 	`ookuwa.nagano.jp`,
 	`ooo`,
 	`ooshika.nagano.jp`,
-	`oow.gov.pl`,
 	`open`,
+	`openair.museum`,
 	`opoczno.pl`,
 	`opole.pl`,
 	`oppdal.no`,
@@ -4475,6 +4831,8 @@ var TLDs = []string{
 	`ora.gunma.jp`,
 	`oracle`,
 	`orange`,
+	`oregon.museum`,
+	`oregontrail.museum`,
 	`org`,
 	`org.ac`,
 	`org.ae`,
@@ -4644,7 +5002,6 @@ var TLDs = []string{
 	`osaki.miyagi.jp`,
 	`osakikamijima.hiroshima.jp`,
 	`osasco.br`,
-	`oschr.gov.pl`,
 	`osen.no`,
 	`oseto.nagasaki.jp`,
 	`oshima.tokyo.jp`,
@@ -4664,6 +5021,7 @@ var TLDs = []string{
 	`ot.it`,
 	`ota.gunma.jp`,
 	`ota.tokyo.jp`,
+	`otago.museum`,
 	`otake.hiroshima.jp`,
 	`otaki.chiba.jp`,
 	`otaki.nagano.jp`,
@@ -4693,6 +5051,7 @@ var TLDs = []string{
 	`ovre-eiker.no`,
 	`owani.aomori.jp`,
 	`owariasahi.aichi.jp`,
+	`oxford.museum`,
 	`oyabe.toyama.jp`,
 	`oyama.tochigi.jp`,
 	`oyamazaki.kyoto.jp`,
@@ -4711,15 +5070,22 @@ var TLDs = []string{
 	`pa.gov.pl`,
 	`pa.it`,
 	`pa.us`,
+	`pacific.museum`,
+	`paderborn.museum`,
 	`padova.it`,
 	`padua.it`,
 	`page`,
+	`palace.museum`,
+	`paleo.museum`,
 	`palermo.it`,
 	`palmas.br`,
+	`palmsprings.museum`,
+	`panama.museum`,
 	`panasonic`,
 	`parachuting.aero`,
 	`paragliding.aero`,
 	`paris`,
+	`paris.museum`,
 	`parliament.nz`,
 	`parma.it`,
 	`paroch.k12.ma.us`,
@@ -4728,6 +5094,8 @@ var TLDs = []string{
 	`partners`,
 	`parts`,
 	`party`,
+	`pasadena.museum`,
+	`passagens`,
 	`passenger-association.aero`,
 	`patria.bo`,
 	`pavia.it`,
@@ -4760,16 +5128,21 @@ var TLDs = []string{
 	`pg.in`,
 	`pg.it`,
 	`ph`,
+	`pharmacien.fr`,
 	`pharmaciens.km`,
 	`pharmacy`,
+	`pharmacy.museum`,
 	`phd`,
+	`philadelphia.museum`,
+	`philadelphiaarea.museum`,
+	`philately.museum`,
 	`philips`,
+	`phoenix.museum`,
 	`phone`,
 	`photo`,
 	`photography`,
+	`photography.museum`,
 	`photos`,
-	`phutho.vn`,
-	`phuyen.vn`,
 	`physio`,
 	`pi.gov.br`,
 	`pi.it`,
@@ -4782,6 +5155,7 @@ var TLDs = []string{
 	`piemonte.it`,
 	`pila.pl`,
 	`pilot.aero`,
+	`pilots.museum`,
 	`pin`,
 	`pinb.gov.pl`,
 	`ping`,
@@ -4791,14 +5165,19 @@ var TLDs = []string{
 	`pisa.it`,
 	`pistoia.it`,
 	`pisz.pl`,
+	`pittsburgh.museum`,
 	`piw.gov.pl`,
 	`pizza`,
 	`pk`,
 	`pl`,
 	`pl.ua`,
 	`place`,
+	`planetarium.museum`,
+	`plantation.museum`,
+	`plants.museum`,
 	`play`,
 	`playstation`,
+	`plaza.museum`,
 	`plc.co.im`,
 	`plc.ly`,
 	`plc.uk`,
@@ -4835,8 +5214,13 @@ var TLDs = []string{
 	`porsangu.no`,
 	`porsgrunn.no`,
 	`porsáŋgu.no`,
+	`port.fr`,
+	`portal.museum`,
+	`portland.museum`,
+	`portlligat.museum`,
 	`post`,
 	`post.in`,
+	`posts-and-telecommunications.museum`,
 	`potenza.it`,
 	`powiat.pl`,
 	`pp.az`,
@@ -4844,7 +5228,6 @@ var TLDs = []string{
 	`ppg.br`,
 	`pr`,
 	`pr.gov.br`,
-	`pr.gov.pl`,
 	`pr.it`,
 	`pr.us`,
 	`pramerica`,
@@ -4853,10 +5236,13 @@ var TLDs = []string{
 	`prd.fr`,
 	`prd.km`,
 	`prd.mg`,
+	`preservation.museum`,
+	`presidio.museum`,
 	`press`,
 	`press.aero`,
 	`press.cy`,
 	`press.ma`,
+	`press.museum`,
 	`press.se`,
 	`presse.ci`,
 	`presse.km`,
@@ -4890,6 +5276,7 @@ var TLDs = []string{
 	`prof.pr`,
 	`profesional.bo`,
 	`progressive`,
+	`project.museum`,
 	`promo`,
 	`properties`,
 	`property`,
@@ -4909,6 +5296,8 @@ var TLDs = []string{
 	`pub`,
 	`pub.sa`,
 	`publ.pt`,
+	`public.museum`,
+	`pubol.museum`,
 	`pueblo.bo`,
 	`pug.it`,
 	`puglia.it`,
@@ -4931,12 +5320,8 @@ var TLDs = []string{
 	`qld.gov.au`,
 	`qpon`,
 	`qsl.br`,
-	`quangbinh.vn`,
-	`quangnam.vn`,
-	`quangngai.vn`,
-	`quangninh.vn`,
-	`quangtri.vn`,
 	`quebec`,
+	`quebec.museum`,
 	`quest`,
 	`r.bg`,
 	`r.se`,
@@ -4951,6 +5336,8 @@ var TLDs = []string{
 	`ragusa.it`,
 	`rahkkeravju.no`,
 	`raholt.no`,
+	`railroad.museum`,
+	`railway.museum`,
 	`raisa.no`,
 	`rakkestad.no`,
 	`ralingen.no`,
@@ -5013,6 +5400,8 @@ var TLDs = []string{
 	`res.aero`,
 	`res.in`,
 	`research.aero`,
+	`research.museum`,
+	`resistance.museum`,
 	`rest`,
 	`restaurant`,
 	`restaurant.bj`,
@@ -5042,6 +5431,7 @@ var TLDs = []string{
 	`rio`,
 	`rio.br`,
 	`riobranco.br`,
+	`riodejaneiro.museum`,
 	`riopreto.br`,
 	`rip`,
 	`rishiri.hokkaido.jp`,
@@ -5060,6 +5450,9 @@ var TLDs = []string{
 	`ro.gov.br`,
 	`ro.it`,
 	`roan.no`,
+	`rocher`,
+	`rochester.museum`,
+	`rockart.museum`,
 	`rocks`,
 	`rodeo`,
 	`rodoy.no`,
@@ -5067,6 +5460,7 @@ var TLDs = []string{
 	`rokunohe.aomori.jp`,
 	`rollag.no`,
 	`roma.it`,
+	`roma.museum`,
 	`rome.it`,
 	`romsa.no`,
 	`romskog.no`,
@@ -5087,6 +5481,7 @@ var TLDs = []string{
 	`ruhr`,
 	`run`,
 	`ruovat.no`,
+	`russia.museum`,
 	`rv.ua`,
 	`rw`,
 	`rwe`,
@@ -5132,6 +5527,7 @@ var TLDs = []string{
 	`saijo.ehime.jp`,
 	`saikai.nagasaki.jp`,
 	`saiki.oita.jp`,
+	`saintlouis.museum`,
 	`saitama.jp`,
 	`saitama.saitama.jp`,
 	`saito.miyazaki.jp`,
@@ -5159,11 +5555,14 @@ var TLDs = []string{
 	`salangen.no`,
 	`salat.no`,
 	`sale`,
+	`salem.museum`,
 	`salerno.it`,
 	`salon`,
 	`saltdal.no`,
 	`salud.bo`,
 	`salvador.br`,
+	`salvadordali.museum`,
+	`salzburg.museum`,
 	`samegawa.fukushima.jp`,
 	`samnanger.no`,
 	`sampa.br`,
@@ -5176,6 +5575,7 @@ var TLDs = []string{
 	`sande.møre-og-romsdal.no`,
 	`sande.vestfold.no`,
 	`sandefjord.no`,
+	`sandiego.museum`,
 	`sandnes.no`,
 	`sandnessjoen.no`,
 	`sandnessjøen.no`,
@@ -5183,6 +5583,7 @@ var TLDs = []string{
 	`sandvik`,
 	`sandvikcoromant`,
 	`sandøy.no`,
+	`sanfrancisco.museum`,
 	`sango.nara.jp`,
 	`sanjo.niigata.jp`,
 	`sannan.hyogo.jp`,
@@ -5190,6 +5591,9 @@ var TLDs = []string{
 	`sano.tochigi.jp`,
 	`sanofi`,
 	`sanok.pl`,
+	`santabarbara.museum`,
+	`santacruz.museum`,
+	`santafe.museum`,
 	`santamaria.br`,
 	`santoandre.br`,
 	`sanuki.kagawa.jp`,
@@ -5197,7 +5601,6 @@ var TLDs = []string{
 	`saogonca.br`,
 	`saotome.st`,
 	`sap`,
-	`sapporo.jp`,
 	`sar.it`,
 	`sardegna.it`,
 	`sardinia.it`,
@@ -5209,12 +5612,15 @@ var TLDs = []string{
 	`sasaguri.fukuoka.jp`,
 	`sasayama.hyogo.jp`,
 	`sasebo.nagasaki.jp`,
+	`saskatchewan.museum`,
 	`sassari.it`,
 	`satosho.okayama.jp`,
 	`satsumasendai.kagoshima.jp`,
 	`satte.saitama.jp`,
+	`satx.museum`,
 	`sauda.no`,
 	`sauherad.no`,
+	`savannahga.museum`,
 	`save`,
 	`savona.it`,
 	`saxo`,
@@ -5234,6 +5640,7 @@ var TLDs = []string{
 	`sc.tz`,
 	`sc.ug`,
 	`sc.us`,
+	`sca`,
 	`scb`,
 	`sch.ae`,
 	`sch.id`,
@@ -5245,28 +5652,43 @@ var TLDs = []string{
 	`sch.qa`,
 	`sch.sa`,
 	`sch.ss`,
-	`sch.uk`,
 	`sch.zm`,
 	`schaeffler`,
+	`schlesisches.museum`,
 	`schmidt`,
+	`schoenbrunn.museum`,
+	`schokoladen.museum`,
 	`scholarships`,
 	`school`,
+	`school.museum`,
 	`school.na`,
 	`school.nz`,
 	`school.za`,
 	`schools.nsw.edu.au`,
 	`schule`,
 	`schwarz`,
+	`schweiz.museum`,
 	`sci.eg`,
 	`science`,
+	`science-fiction.museum`,
+	`science.museum`,
+	`scienceandhistory.museum`,
+	`scienceandindustry.museum`,
+	`sciencecenter.museum`,
+	`sciencecenters.museum`,
+	`sciencehistory.museum`,
+	`sciences.museum`,
+	`sciencesnaturelles.museum`,
 	`scientist.aero`,
 	`scot`,
+	`scotland.museum`,
 	`sd`,
 	`sd.cn`,
 	`sd.us`,
 	`sdn.gov.pl`,
 	`se`,
 	`se.gov.br`,
+	`seaport.museum`,
 	`search`,
 	`seat`,
 	`sebastopol.ua`,
@@ -5292,7 +5714,6 @@ var TLDs = []string{
 	`semboku.akita.jp`,
 	`semine.miyagi.jp`,
 	`senasa.ar`,
-	`sendai.jp`,
 	`sener`,
 	`sennan.osaka.jp`,
 	`seoul.kr`,
@@ -5303,6 +5724,8 @@ var TLDs = []string{
 	`setagaya.tokyo.jp`,
 	`seto.aichi.jp`,
 	`setouchi.okayama.jp`,
+	`settlement.museum`,
+	`settlers.museum`,
 	`settsu.osaka.jp`,
 	`sevastopol.ua`,
 	`seven`,
@@ -5322,6 +5745,8 @@ var TLDs = []string{
 	`sharp`,
 	`shaw`,
 	`shell`,
+	`shell.museum`,
+	`sherbrooke.museum`,
 	`shia`,
 	`shibata.miyagi.jp`,
 	`shibata.niigata.jp`,
@@ -5423,9 +5848,11 @@ var TLDs = []string{
 	`showa.fukushima.jp`,
 	`showa.gunma.jp`,
 	`showa.yamanashi.jp`,
+	`showtime`,
 	`shunan.yamaguchi.jp`,
 	`si`,
 	`si.it`,
+	`sibenik.museum`,
 	`sic.it`,
 	`sicilia.it`,
 	`sicily.it`,
@@ -5434,6 +5861,7 @@ var TLDs = []string{
 	`sigdal.no`,
 	`siljan.no`,
 	`silk`,
+	`silk.museum`,
 	`sina`,
 	`singles`,
 	`siracusa.it`,
@@ -5449,6 +5877,7 @@ var TLDs = []string{
 	`skedsmo.no`,
 	`skedsmokorset.no`,
 	`ski`,
+	`ski.museum`,
 	`ski.no`,
 	`skien.no`,
 	`skierva.no`,
@@ -5463,6 +5892,7 @@ var TLDs = []string{
 	`sko.gov.pl`,
 	`skoczow.pl`,
 	`skodje.no`,
+	`skole.museum`,
 	`sky`,
 	`skydiving.aero`,
 	`skype`,
@@ -5500,7 +5930,7 @@ var TLDs = []string{
 	`soc.lk`,
 	`soccer`,
 	`social`,
-	`soctrang.vn`,
+	`society.museum`,
 	`sodegaura.chiba.jp`,
 	`soeda.fukuoka.jp`,
 	`softbank`,
@@ -5514,6 +5944,7 @@ var TLDs = []string{
 	`sokndal.no`,
 	`sola.no`,
 	`solar`,
+	`sologne.museum`,
 	`solund.no`,
 	`solutions`,
 	`soma.fukushima.jp`,
@@ -5523,7 +5954,6 @@ var TLDs = []string{
 	`song`,
 	`songdalen.no`,
 	`soni.nara.jp`,
-	`sonla.vn`,
 	`sony`,
 	`soo.kagoshima.jp`,
 	`sor-aurdal.no`,
@@ -5538,17 +5968,23 @@ var TLDs = []string{
 	`sos.pl`,
 	`sosa.chiba.jp`,
 	`sosnowiec.pl`,
+	`soundandvision.museum`,
+	`southcarolina.museum`,
+	`southwest.museum`,
 	`sowa.ibaraki.jp`,
 	`soy`,
 	`sp.gov.br`,
 	`sp.it`,
 	`spa`,
 	`space`,
+	`space.museum`,
 	`spjelkavik.no`,
 	`sport`,
 	`sport.hu`,
 	`spot`,
+	`spy.museum`,
 	`spydeberg.no`,
+	`square.museum`,
 	`sr`,
 	`sr.gov.pl`,
 	`sr.it`,
@@ -5559,29 +5995,39 @@ var TLDs = []string{
 	`st`,
 	`st.no`,
 	`stada`,
+	`stadt.museum`,
+	`stalbans.museum`,
 	`stalowa-wola.pl`,
 	`stange.no`,
 	`staples`,
 	`star`,
 	`starachowice.pl`,
 	`stargard.pl`,
+	`starnberg.museum`,
 	`starostwo.gov.pl`,
 	`stat.no`,
+	`state.museum`,
 	`statebank`,
 	`statefarm`,
+	`stateofdelaware.museum`,
 	`stathelle.no`,
+	`station.museum`,
 	`stavanger.no`,
 	`stavern.no`,
 	`stc`,
 	`stcgroup`,
+	`steam.museum`,
+	`steiermark.museum`,
 	`steigen.no`,
 	`steinkjer.no`,
 	`sth.ac.at`,
+	`stjohn.museum`,
 	`stjordal.no`,
 	`stjordalshalsen.no`,
 	`stjørdal.no`,
 	`stjørdalshalsen.no`,
 	`stockholm`,
+	`stockholm.museum`,
 	`stokke.no`,
 	`stor-elvdal.no`,
 	`storage`,
@@ -5594,6 +6040,7 @@ var TLDs = []string{
 	`store.st`,
 	`store.ve`,
 	`storfjord.no`,
+	`stpetersburg.museum`,
 	`strand.no`,
 	`stranda.no`,
 	`stream`,
@@ -5601,6 +6048,7 @@ var TLDs = []string{
 	`student.aero`,
 	`studio`,
 	`study`,
+	`stuttgart.museum`,
 	`style`,
 	`su`,
 	`sucks`,
@@ -5609,6 +6057,7 @@ var TLDs = []string{
 	`suginami.tokyo.jp`,
 	`sugito.saitama.jp`,
 	`suifu.ibaraki.jp`,
+	`suisse.museum`,
 	`suita.osaka.jp`,
 	`sukagawa.fukushima.jp`,
 	`sukumo.kochi.jp`,
@@ -5627,8 +6076,10 @@ var TLDs = []string{
 	`supply`,
 	`support`,
 	`surf`,
+	`surgeonshall.museum`,
 	`surgery`,
 	`surnadal.no`,
+	`surrey.museum`,
 	`susaki.kochi.jp`,
 	`susono.shizuoka.jp`,
 	`suwa.nagano.jp`,
@@ -5642,7 +6093,9 @@ var TLDs = []string{
 	`svalbard.no`,
 	`sveio.no`,
 	`svelvik.no`,
+	`svizzera.museum`,
 	`swatch`,
+	`sweden.museum`,
 	`swidnica.pl`,
 	`swiebodzin.pl`,
 	`swinoujscie.pl`,
@@ -5651,6 +6104,7 @@ var TLDs = []string{
 	`sx.cn`,
 	`sy`,
 	`sydney`,
+	`sydney.museum`,
 	`sykkylven.no`,
 	`systems`,
 	`sz`,
@@ -5752,6 +6206,7 @@ var TLDs = []string{
 	`tanabe.wakayama.jp`,
 	`tanagura.fukushima.jp`,
 	`tananger.no`,
+	`tank.museum`,
 	`tanohata.iwate.jp`,
 	`taobao`,
 	`tara.saga.jp`,
@@ -5778,10 +6233,10 @@ var TLDs = []string{
 	`tax`,
 	`taxi`,
 	`taxi.br`,
-	`tayninh.vn`,
 	`tc`,
 	`tc.br`,
 	`tci`,
+	`tcm.museum`,
 	`td`,
 	`tdk`,
 	`te.it`,
@@ -5792,9 +6247,12 @@ var TLDs = []string{
 	`tec.ve`,
 	`tech`,
 	`technology`,
+	`technology.museum`,
 	`tecnologia.bo`,
 	`tel`,
 	`tel.tr`,
+	`telekommunikation.museum`,
+	`television.museum`,
 	`temasek`,
 	`tempio-olbia.it`,
 	`tempioolbia.it`,
@@ -5810,24 +6268,24 @@ var TLDs = []string{
 	`teshikaga.hokkaido.jp`,
 	`test.tj`,
 	`teva`,
+	`texas.museum`,
+	`textile.museum`,
 	`tf`,
 	`tg`,
 	`tgory.pl`,
 	`th`,
-	`thaibinh.vn`,
-	`thainguyen.vn`,
-	`thanhhoa.vn`,
-	`thanhphohochiminh.vn`,
 	`thd`,
 	`the.br`,
 	`theater`,
+	`theater.museum`,
 	`theatre`,
-	`thuathienhue.vn`,
 	`tiaa`,
 	`tickets`,
 	`tienda`,
-	`tiengiang.vn`,
+	`tiffany`,
+	`time.museum`,
 	`time.no`,
+	`timekeeping.museum`,
 	`tingvoll.no`,
 	`tinn.no`,
 	`tips`,
@@ -5919,10 +6377,12 @@ var TLDs = []string{
 	`tools`,
 	`toon.ehime.jp`,
 	`top`,
+	`topology.museum`,
 	`torahime.shiga.jp`,
 	`toray`,
 	`toride.ibaraki.jp`,
 	`torino.it`,
+	`torino.museum`,
 	`torsken.no`,
 	`tos.it`,
 	`tosa.kochi.jp`,
@@ -5934,12 +6394,14 @@ var TLDs = []string{
 	`total`,
 	`tottori.jp`,
 	`tottori.tottori.jp`,
+	`touch.museum`,
 	`tourism.bj`,
 	`tourism.pl`,
 	`tourism.tn`,
 	`tours`,
 	`towada.aomori.jp`,
 	`town`,
+	`town.museum`,
 	`toya.hokkaido.jp`,
 	`toyako.hokkaido.jp`,
 	`toyama.jp`,
@@ -5980,6 +6442,7 @@ var TLDs = []string{
 	`traniandriabarletta.it`,
 	`tranibarlettaandria.it`,
 	`tranoy.no`,
+	`transport.museum`,
 	`transporte.bo`,
 	`tranøy.no`,
 	`trapani.it`,
@@ -5987,10 +6450,11 @@ var TLDs = []string{
 	`travel.in`,
 	`travel.pl`,
 	`travel.tt`,
+	`travelchannel`,
 	`travelers`,
 	`travelersinsurance`,
-	`travinh.vn`,
 	`trd.br`,
+	`tree.museum`,
 	`trentin-sud-tirol.it`,
 	`trentin-sudtirol.it`,
 	`trentin-sued-tirol.it`,
@@ -6033,11 +6497,14 @@ var TLDs = []string{
 	`trieste.it`,
 	`troandin.no`,
 	`trogstad.no`,
+	`trolley.museum`,
 	`tromsa.no`,
 	`tromso.no`,
 	`tromsø.no`,
 	`trondheim.no`,
 	`trust`,
+	`trust.museum`,
+	`trustee.museum`,
 	`trv`,
 	`trysil.no`,
 	`træna.no`,
@@ -6083,7 +6550,6 @@ var TLDs = []string{
 	`turystyka.pl`,
 	`tuscany.it`,
 	`tushu`,
-	`tuyenquang.vn`,
 	`tv`,
 	`tv.bb`,
 	`tv.bo`,
@@ -6130,6 +6596,7 @@ var TLDs = []string{
 	`ug`,
 	`ug.gov.pl`,
 	`ugim.gov.pl`,
+	`uhren.museum`,
 	`uji.kyoto.jp`,
 	`ujiie.tochigi.jp`,
 	`ujitawara.kyoto.jp`,
@@ -6139,6 +6606,7 @@ var TLDs = []string{
 	`ukiha.fukuoka.jp`,
 	`ullensaker.no`,
 	`ullensvang.no`,
+	`ulm.museum`,
 	`ulsan.kr`,
 	`ulvik.no`,
 	`um.gov.pl`,
@@ -6148,11 +6616,13 @@ var TLDs = []string{
 	`umi.fukuoka.jp`,
 	`umig.gov.pl`,
 	`unazuki.toyama.jp`,
+	`undersea.museum`,
 	`unicom`,
 	`union.aero`,
 	`univ.bj`,
 	`univ.sn`,
 	`university`,
+	`university.museum`,
 	`unjarga.no`,
 	`unjárga.no`,
 	`unnan.shimane.jp`,
@@ -6181,25 +6651,36 @@ var TLDs = []string{
 	`us.gov.pl`,
 	`us.in`,
 	`us.na`,
+	`usa.museum`,
 	`usa.oita.jp`,
+	`usantiques.museum`,
+	`usarts.museum`,
+	`uscountryestate.museum`,
+	`usculture.museum`,
+	`usdecorativearts.museum`,
+	`usgarden.museum`,
 	`ushiku.ibaraki.jp`,
+	`ushistory.museum`,
+	`ushuaia.museum`,
+	`uslivinghistory.museum`,
 	`ustka.pl`,
 	`usui.fukuoka.jp`,
 	`usuki.oita.jp`,
 	`ut.us`,
+	`utah.museum`,
 	`utashinai.hokkaido.jp`,
 	`utazas.hu`,
 	`utazu.kagawa.jp`,
 	`uto.kumamoto.jp`,
 	`utsira.no`,
 	`utsunomiya.tochigi.jp`,
+	`uvic.museum`,
 	`uw.gov.pl`,
 	`uwajima.ehime.jp`,
 	`uy`,
 	`uz`,
 	`uz.ua`,
 	`uzhgorod.ua`,
-	`uzhhorod.ua`,
 	`uzs.gov.pl`,
 	`v.bg`,
 	`va`,
@@ -6231,6 +6712,7 @@ var TLDs = []string{
 	`vallee-d-aoste.it`,
 	`valleeaoste.it`,
 	`valleedaoste.it`,
+	`valley.museum`,
 	`vallée-aoste.it`,
 	`vallée-d-aoste.it`,
 	`valléeaoste.it`,
@@ -6238,6 +6720,7 @@ var TLDs = []string{
 	`vana`,
 	`vang.no`,
 	`vanguard`,
+	`vantaa.museum`,
 	`vanylven.no`,
 	`vao.it`,
 	`vardo.no`,
@@ -6270,6 +6753,7 @@ var TLDs = []string{
 	`vermögensberatung`,
 	`verona.it`,
 	`verran.no`,
+	`versailles.museum`,
 	`versicherung`,
 	`vestby.no`,
 	`vestnes.no`,
@@ -6279,6 +6763,7 @@ var TLDs = []string{
 	`vestvågøy.no`,
 	`vet`,
 	`vet.br`,
+	`veterinaire.fr`,
 	`veterinaire.km`,
 	`vevelstad.no`,
 	`vf.no`,
@@ -6299,16 +6784,19 @@ var TLDs = []string{
 	`vig`,
 	`vik.no`,
 	`viking`,
+	`viking.museum`,
 	`vikna.no`,
+	`village.museum`,
 	`villas`,
 	`vin`,
 	`vindafjord.no`,
-	`vinhlong.vn`,
-	`vinhphuc.vn`,
 	`vinnica.ua`,
 	`vinnytsia.ua`,
 	`vip`,
 	`virgin`,
+	`virginia.museum`,
+	`virtual.museum`,
+	`virtuel.museum`,
 	`visa`,
 	`vision`,
 	`viterbo.it`,
@@ -6316,12 +6804,15 @@ var TLDs = []string{
 	`vivo`,
 	`vix.br`,
 	`vlaanderen`,
+	`vlaanderen.museum`,
 	`vlog.br`,
 	`vn`,
 	`vn.ua`,
 	`voagat.no`,
 	`vodka`,
 	`volda.no`,
+	`volkenkunde.museum`,
+	`volkswagen`,
 	`volvo`,
 	`volyn.ua`,
 	`voss.no`,
@@ -6335,6 +6826,7 @@ var TLDs = []string{
 	`vt.it`,
 	`vt.us`,
 	`vu`,
+	`vuelos`,
 	`vv.it`,
 	`várggát.no`,
 	`vågan.no`,
@@ -6361,19 +6853,25 @@ var TLDs = []string{
 	`wakuya.miyagi.jp`,
 	`walbrzych.pl`,
 	`wales`,
+	`wales.museum`,
+	`wallonie.museum`,
 	`walmart`,
 	`walter`,
 	`wang`,
 	`wanggou`,
 	`wanouchi.gifu.jp`,
+	`war.museum`,
 	`warabi.saitama.jp`,
 	`warmia.pl`,
 	`warszawa.pl`,
+	`washingtondc.museum`,
 	`washtenaw.mi.us`,
 	`wassamu.hokkaido.jp`,
 	`watarai.mie.jp`,
 	`watari.miyagi.jp`,
 	`watch`,
+	`watch-and-clock.museum`,
+	`watchandclock.museum`,
 	`watches`,
 	`waw.pl`,
 	`wazuka.kyoto.jp`,
@@ -6395,12 +6893,14 @@ var TLDs = []string{
 	`webcam`,
 	`weber`,
 	`website`,
-	`wed`,
 	`wedding`,
 	`wegrow.pl`,
 	`weibo`,
 	`weir`,
+	`western.museum`,
+	`westfalen.museum`,
 	`wf`,
+	`whaling.museum`,
 	`whoswho`,
 	`wi.us`,
 	`wielun.pl`,
@@ -6410,16 +6910,18 @@ var TLDs = []string{
 	`wiki`,
 	`wiki.bo`,
 	`wiki.br`,
+	`wildlife.museum`,
 	`williamhill`,
+	`williamsburg.museum`,
 	`win`,
 	`winb.gov.pl`,
+	`windmill.museum`,
 	`windows`,
 	`wine`,
 	`winners`,
 	`wios.gov.pl`,
 	`witd.gov.pl`,
 	`wiw.gov.pl`,
-	`wkz.gov.pl`,
 	`wlocl.pl`,
 	`wloclawek.pl`,
 	`wme`,
@@ -6431,6 +6933,7 @@ var TLDs = []string{
 	`workinggroup.aero`,
 	`works`,
 	`works.aero`,
+	`workshop.museum`,
 	`world`,
 	`wow`,
 	`wroclaw.pl`,
@@ -6438,12 +6941,10 @@ var TLDs = []string{
 	`ws.na`,
 	`wsa.gov.pl`,
 	`wskr.gov.pl`,
-	`wsse.gov.pl`,
 	`wtc`,
 	`wtf`,
 	`wuoz.gov.pl`,
 	`wv.us`,
-	`www.ck`,
 	`www.ro`,
 	`wy.us`,
 	`wzmiuw.gov.pl`,
@@ -6451,6 +6952,7 @@ var TLDs = []string{
 	`x.se`,
 	`xbox`,
 	`xerox`,
+	`xfinity`,
 	`xihuan`,
 	`xin`,
 	`xj.cn`,
@@ -6522,7 +7024,6 @@ var TLDs = []string{
 	`yawatahama.ehime.jp`,
 	`yazu.tottori.jp`,
 	`ye`,
-	`yenbai.vn`,
 	`yk.ca`,
 	`yn.cn`,
 	`yodobashi`,
@@ -6534,7 +7035,6 @@ var TLDs = []string{
 	`yokawa.hyogo.jp`,
 	`yokkaichi.mie.jp`,
 	`yokohama`,
-	`yokohama.jp`,
 	`yokoshibahikari.chiba.jp`,
 	`yokosuka.kanagawa.jp`,
 	`yokote.akita.jp`,
@@ -6546,7 +7046,10 @@ var TLDs = []string{
 	`yonezawa.yamagata.jp`,
 	`yono.saitama.jp`,
 	`yorii.saitama.jp`,
+	`york.museum`,
+	`yorkshire.museum`,
 	`yoro.gifu.jp`,
+	`yosemite.museum`,
 	`yoshida.saitama.jp`,
 	`yoshida.shizuoka.jp`,
 	`yoshikawa.saitama.jp`,
@@ -6556,6 +7059,7 @@ var TLDs = []string{
 	`yoshioka.gunma.jp`,
 	`yotsukaido.chiba.jp`,
 	`you`,
+	`youth.museum`,
 	`youtube`,
 	`yt`,
 	`yuasa.wakayama.jp`,
@@ -6577,7 +7081,6 @@ var TLDs = []string{
 	`za`,
 	`zachpomor.pl`,
 	`zagan.pl`,
-	`zakarpattia.ua`,
 	`zama.kanagawa.jp`,
 	`zamami.okinawa.jp`,
 	`zao.miyagi.jp`,
@@ -6597,9 +7100,10 @@ var TLDs = []string{
 	`zlg.br`,
 	`zm`,
 	`zone`,
+	`zoological.museum`,
+	`zoology.museum`,
 	`zp.gov.pl`,
 	`zp.ua`,
-	`zpisdn.gov.pl`,
 	`zt.ua`,
 	`zuerich`,
 	`zushi.kanagawa.jp`,
@@ -6635,6 +7139,7 @@ var TLDs = []string{
 	`бел`,
 	`дети`,
 	`ею`,
+	`иком.museum`,
 	`католик`,
 	`ком`,
 	`мкд`,
@@ -6655,12 +7160,14 @@ var TLDs = []string{
 	`қаз`,
 	`հայ`,
 	`אקדמיה.ישראל`,
+	`ירושלים.museum`,
 	`ישוב.ישראל`,
 	`ישראל`,
 	`ממשל.ישראל`,
 	`צהל.ישראל`,
 	`קום`,
 	`ابوظبي`,
+	`اتصالات`,
 	`ارامكو`,
 	`الاردن`,
 	`البحرين`,
@@ -6879,3 +7386,2180 @@ var TLDs = []string{
 	`삼성`,
 	`한국`,
 }
+
+// WildcardTLDs is a sorted list of eTLDs declared by wildcard rules ("*.<eTLD>") in the
+// public suffix list. Every label directly under one of these eTLDs is itself an eTLD.
+// The "*." prefix is stripped.
+var WildcardTLDs = []string{
+	`bd`,
+	`ck`,
+	`er`,
+	`fk`,
+	`jm`,
+	`kawasaki.jp`,
+	`kh`,
+	`kitakyushu.jp`,
+	`kobe.jp`,
+	`mm`,
+	`nagoya.jp`,
+	`nom.br`,
+	`np`,
+	`pg`,
+	`sapporo.jp`,
+	`sch.uk`,
+	`sendai.jp`,
+	`yokohama.jp`,
+}
+
+// ExceptionTLDs is a sorted list of exception rules ("!<domain>") in the public suffix
+// list. Each entry overrides a wildcard rule: its parent is the eTLD, not the entry itself.
+// The "!" prefix is stripped.
+var ExceptionTLDs = []string{
+	`city.kawasaki.jp`,
+	`city.kitakyushu.jp`,
+	`city.kobe.jp`,
+	`city.nagoya.jp`,
+	`city.sapporo.jp`,
+	`city.sendai.jp`,
+	`city.yokohama.jp`,
+	`www.ck`,
+}
+
+// PrivateTLDs is a sorted list of the eTLDs in the private domains section of the public
+// suffix list, i.e. suffixes under which a company lets its customers register names,
+// e.g. "github.io" or "herokuapp.com".
+var PrivateTLDs = []string{
+	`001www.com`,
+	`0e.vc`,
+	`1.azurestaticapps.net`,
+	`123hjemmeside.dk`,
+	`123hjemmeside.no`,
+	`123homepage.it`,
+	`123kotisivu.fi`,
+	`123minsida.se`,
+	`123miweb.es`,
+	`123paginaweb.pt`,
+	`123sait.ru`,
+	`123siteweb.fr`,
+	`123webseite.at`,
+	`123webseite.de`,
+	`123website.be`,
+	`123website.ch`,
+	`123website.lu`,
+	`123website.nl`,
+	`12hp.at`,
+	`12hp.ch`,
+	`12hp.de`,
+	`1337.pictures`,
+	`16-b.it`,
+	`1kapp.com`,
+	`2.azurestaticapps.net`,
+	`2038.io`,
+	`2ix.at`,
+	`2ix.ch`,
+	`2ix.de`,
+	`32-b.it`,
+	`3utilities.com`,
+	`4lima.at`,
+	`4lima.ch`,
+	`4lima.de`,
+	`4u.com`,
+	`611.to`,
+	`64-b.it`,
+	`a.prod.fastly.net`,
+	`a.run.app`,
+	`a.ssl.fastly.net`,
+	`abkhazia.su`,
+	`ac.leg.br`,
+	`ac.ru`,
+	`accesscam.org`,
+	`activetrail.biz`,
+	`adimo.co.uk`,
+	`adobeaemcloud.com`,
+	`adobeaemcloud.net`,
+	`adobeio-static.net`,
+	`adobeioruntime.net`,
+	`adygeya.ru`,
+	`adygeya.su`,
+	`ae.org`,
+	`affinitylottery.org.uk`,
+	`africa.com`,
+	`airkitapps-au.com`,
+	`airkitapps.com`,
+	`airkitapps.eu`,
+	`aivencloud.com`,
+	`akadns.net`,
+	`akamai-staging.net`,
+	`akamai.net`,
+	`akamaiedge-staging.net`,
+	`akamaiedge.net`,
+	`akamaihd-staging.net`,
+	`akamaihd.net`,
+	`akamaiorigin-staging.net`,
+	`akamaiorigin.net`,
+	`akamaized-staging.net`,
+	`akamaized.net`,
+	`aktyubinsk.su`,
+	`al.eu.org`,
+	`al.leg.br`,
+	`alp1.ae.flow.ch`,
+	`alpha-myqnapcloud.com`,
+	`alpha.bounty-full.com`,
+	`altervista.org`,
+	`alwaysdata.net`,
+	`am.leg.br`,
+	`amscompute.com`,
+	`angry.jp`,
+	`ap-northeast-1.elasticbeanstalk.com`,
+	`ap-northeast-2.elasticbeanstalk.com`,
+	`ap-northeast-3.elasticbeanstalk.com`,
+	`ap-south-1.elasticbeanstalk.com`,
+	`ap-southeast-1.elasticbeanstalk.com`,
+	`ap-southeast-2.elasticbeanstalk.com`,
+	`ap.leg.br`,
+	`api.gov.uk`,
+	`api.stdlib.com`,
+	`apigee.io`,
+	`app.banzaicloud.io`,
+	`app.gp`,
+	`app.lmpm.com`,
+	`app.os.fedoraproject.org`,
+	`app.os.stg.fedoraproject.org`,
+	`app.render.com`,
+	`appchizi.com`,
+	`appengine.flow.ch`,
+	`applinzi.com`,
+	`apps.fbsbx.com`,
+	`apps.lair.io`,
+	`appspacehosted.com`,
+	`appspaceusercontent.com`,
+	`appspot.com`,
+	`appudo.net`,
+	`ar.com`,
+	`arkhangelsk.su`,
+	`armenia.su`,
+	`art.pl`,
+	`arvo.network`,
+	`ashgabad.su`,
+	`asso.eu.org`,
+	`at-band-camp.net`,
+	`at.eu.org`,
+	`at.md`,
+	`at.vg`,
+	`ath.cx`,
+	`atl.jelastic.vps-host.net`,
+	`au.eu.org`,
+	`aus.basketball`,
+	`authgear-staging.com`,
+	`authgearapps.com`,
+	`autocode.dev`,
+	`awsglobalaccelerator.com`,
+	`awsmppl.com`,
+	`azerbaijan.su`,
+	`azimuth.network`,
+	`azure-mobile.net`,
+	`azurestaticapps.net`,
+	`azurewebsites.net`,
+	`b-data.io`,
+	`b.ssl.fastly.net`,
+	`ba.leg.br`,
+	`babyblue.jp`,
+	`babymilk.jp`,
+	`backdrop.jp`,
+	`backplaneapp.io`,
+	`balashov.su`,
+	`balena-devices.com`,
+	`bambina.jp`,
+	`bar0.net`,
+	`bar1.net`,
+	`bar2.net`,
+	`barrel-of-knowledge.info`,
+	`barrell-of-knowledge.info`,
+	`barsy.bg`,
+	`barsy.ca`,
+	`barsy.club`,
+	`barsy.co.uk`,
+	`barsy.de`,
+	`barsy.eu`,
+	`barsy.in`,
+	`barsy.info`,
+	`barsy.io`,
+	`barsy.me`,
+	`barsy.menu`,
+	`barsy.mobi`,
+	`barsy.net`,
+	`barsy.online`,
+	`barsy.org`,
+	`barsy.pro`,
+	`barsy.pub`,
+	`barsy.ro`,
+	`barsy.shop`,
+	`barsy.site`,
+	`barsy.support`,
+	`barsy.uk`,
+	`barsycenter.com`,
+	`barsyonline.co.uk`,
+	`barsyonline.com`,
+	`base.ec`,
+	`base.shop`,
+	`bashkiria.ru`,
+	`bashkiria.su`,
+	`basicserver.io`,
+	`bc.platform.sh`,
+	`bci.dnstrace.pro`,
+	`be.ax`,
+	`be.eu.org`,
+	`be.gy`,
+	`beagleboard.io`,
+	`beep.pl`,
+	`beta.bounty-full.com`,
+	`beta.tailscale.net`,
+	`betainabox.com`,
+	`better-than.tv`,
+	`bg.eu.org`,
+	`bip.sh`,
+	`bir.ru`,
+	`bitbridge.net`,
+	`bitbucket.io`,
+	`bitter.jp`,
+	`biz.at`,
+	`biz.dk`,
+	`biz.gl`,
+	`biz.ua`,
+	`biz.wf`,
+	`blackbaudcdn.net`,
+	`blog.gt`,
+	`blog.kg`,
+	`blog.vu`,
+	`blogdns.com`,
+	`blogdns.net`,
+	`blogdns.org`,
+	`blogsite.org`,
+	`blogsite.xyz`,
+	`blogspot.ae`,
+	`blogspot.al`,
+	`blogspot.am`,
+	`blogspot.ba`,
+	`blogspot.be`,
+	`blogspot.bg`,
+	`blogspot.bj`,
+	`blogspot.ca`,
+	`blogspot.cf`,
+	`blogspot.ch`,
+	`blogspot.cl`,
+	`blogspot.co.at`,
+	`blogspot.co.id`,
+	`blogspot.co.il`,
+	`blogspot.co.ke`,
+	`blogspot.co.nz`,
+	`blogspot.co.uk`,
+	`blogspot.co.za`,
+	`blogspot.com`,
+	`blogspot.com.ar`,
+	`blogspot.com.au`,
+	`blogspot.com.br`,
+	`blogspot.com.by`,
+	`blogspot.com.co`,
+	`blogspot.com.cy`,
+	`blogspot.com.ee`,
+	`blogspot.com.eg`,
+	`blogspot.com.es`,
+	`blogspot.com.mt`,
+	`blogspot.com.ng`,
+	`blogspot.com.tr`,
+	`blogspot.com.uy`,
+	`blogspot.cv`,
+	`blogspot.cz`,
+	`blogspot.de`,
+	`blogspot.dk`,
+	`blogspot.fi`,
+	`blogspot.fr`,
+	`blogspot.gr`,
+	`blogspot.hk`,
+	`blogspot.hr`,
+	`blogspot.hu`,
+	`blogspot.ie`,
+	`blogspot.in`,
+	`blogspot.is`,
+	`blogspot.it`,
+	`blogspot.jp`,
+	`blogspot.kr`,
+	`blogspot.li`,
+	`blogspot.lt`,
+	`blogspot.lu`,
+	`blogspot.md`,
+	`blogspot.mk`,
+	`blogspot.mr`,
+	`blogspot.mx`,
+	`blogspot.my`,
+	`blogspot.nl`,
+	`blogspot.no`,
+	`blogspot.pe`,
+	`blogspot.pt`,
+	`blogspot.qa`,
+	`blogspot.re`,
+	`blogspot.ro`,
+	`blogspot.rs`,
+	`blogspot.ru`,
+	`blogspot.se`,
+	`blogspot.sg`,
+	`blogspot.si`,
+	`blogspot.sk`,
+	`blogspot.sn`,
+	`blogspot.td`,
+	`blogspot.tw`,
+	`blogspot.ug`,
+	`blogspot.vn`,
+	`blogsyte.com`,
+	`bloxcms.com`,
+	`bluebite.io`,
+	`blush.jp`,
+	`bmoattachments.org`,
+	`bnr.la`,
+	`boldlygoingnowhere.org`,
+	`boo.jp`,
+	`bookonline.app`,
+	`boomla.net`,
+	`bounceme.net`,
+	`bounty-full.com`,
+	`boutir.com`,
+	`boxfuse.io`,
+	`boy.jp`,
+	`boyfriend.jp`,
+	`bplaced.com`,
+	`bplaced.de`,
+	`bplaced.net`,
+	`br.com`,
+	`brasilia.me`,
+	`broke-it.net`,
+	`browsersafetymark.io`,
+	`bryansk.su`,
+	`bss.design`,
+	`builtwithdark.com`,
+	`bukhara.su`,
+	`but.jp`,
+	`buyshop.jp`,
+	`buyshouses.net`,
+	`byen.site`,
+	`c.cdn77.org`,
+	`c.la`,
+	`c66.me`,
+	`ca-central-1.elasticbeanstalk.com`,
+	`ca.eu.org`,
+	`ca.reclaim.cloud`,
+	`caa.li`,
+	`cable-modem.org`,
+	`cafjs.com`,
+	`camdvr.org`,
+	`campaign.gov.uk`,
+	`candypop.jp`,
+	`canva-apps.cn`,
+	`canva-apps.com`,
+	`capoo.jp`,
+	`caracal.mythic-beasts.com`,
+	`carrd.co`,
+	`casacam.net`,
+	`cat.ax`,
+	`catfood.jp`,
+	`cbg.ru`,
+	`cc.hn`,
+	`cc.ua`,
+	`cd.eu.org`,
+	`cdn-edges.net`,
+	`cdn.prod.atlassian-dev.net`,
+	`cdn77-ssl.net`,
+	`ce.leg.br`,
+	`cechire.com`,
+	`centralus.azurestaticapps.net`,
+	`certmgr.org`,
+	`cf-ipfs.com`,
+	`ch.eu.org`,
+	`ch.tc`,
+	`ch.trendhosting.cloud`,
+	`channelsdvr.net`,
+	`cheap.jp`,
+	`chicappa.jp`,
+	`chillout.jp`,
+	`chimkent.su`,
+	`chips.jp`,
+	`chirurgiens-dentistes-en-france.fr`,
+	`chowder.jp`,
+	`chu.jp`,
+	`ciao.jp`,
+	`ciscofreak.com`,
+	`cistron.nl`,
+	`clan.rip`,
+	`clerk.app`,
+	`clerkstage.app`,
+	`cleverapps.io`,
+	`clicketcloud.com`,
+	`clickrising.net`,
+	`cloud-fr1.unispace.io`,
+	`cloud.fedoraproject.org`,
+	`cloud.goog`,
+	`cloud.interhostsolutions.be`,
+	`cloud.jelastic.open.tim.it`,
+	`cloud.nospamproxy.com`,
+	`cloud66.ws`,
+	`cloud66.zone`,
+	`cloudaccess.host`,
+	`cloudaccess.net`,
+	`cloudapp.net`,
+	`cloudapps.digital`,
+	`cloudcontrolapp.com`,
+	`cloudcontrolled.com`,
+	`cloudflare-ipfs.com`,
+	`cloudfront.net`,
+	`cloudfunctions.net`,
+	`cloudjiffy.net`,
+	`cloudns.asia`,
+	`cloudns.biz`,
+	`cloudns.cc`,
+	`cloudns.club`,
+	`cloudns.eu`,
+	`cloudns.in`,
+	`cloudns.info`,
+	`cloudns.org`,
+	`cloudns.pro`,
+	`cloudns.pw`,
+	`cloudns.us`,
+	`cloudsite.builders`,
+	`cloudycluster.net`,
+	`cn-north-1.eb.amazonaws.com.cn`,
+	`cn-northwest-1.eb.amazonaws.com.cn`,
+	`cn.com`,
+	`cn.eu.org`,
+	`cn.vu`,
+	`cnpy.gdn`,
+	`co.bn`,
+	`co.business`,
+	`co.ca`,
+	`co.com`,
+	`co.cz`,
+	`co.dk`,
+	`co.education`,
+	`co.events`,
+	`co.financial`,
+	`co.krd`,
+	`co.network`,
+	`co.nl`,
+	`co.no`,
+	`co.pl`,
+	`co.place`,
+	`co.ro`,
+	`co.technology`,
+	`co.ua`,
+	`cocotte.jp`,
+	`codeberg.page`,
+	`codespot.com`,
+	`col.ng`,
+	`collegefan.org`,
+	`com.de`,
+	`com.ru`,
+	`com.se`,
+	`community-pro.de`,
+	`community-pro.net`,
+	`conf.se`,
+	`conn.uk`,
+	`coolblog.jp`,
+	`copro.uk`,
+	`couchpotatofries.org`,
+	`crafting.xyz`,
+	`cranky.jp`,
+	`crd.co`,
+	`cs.keliweb.cloud`,
+	`csx.cc`,
+	`cupcake.is`,
+	`curv.dev`,
+	`cust.dev.thingdust.io`,
+	`cust.disrec.thingdust.io`,
+	`cust.prod.thingdust.io`,
+	`cust.retrosnub.co.uk`,
+	`cust.testing.thingdust.io`,
+	`custom.metacentrum.cz`,
+	`customer.mythic-beasts.com`,
+	`customer.speedpartner.de`,
+	`cutegirl.jp`,
+	`cx.ua`,
+	`cy.eu.org`,
+	`cya.gg`,
+	`cyon.link`,
+	`cyon.site`,
+	`cz.eu.org`,
+	`d.gv.vc`,
+	`daa.jp`,
+	`daemon.panel.gg`,
+	`dagestan.ru`,
+	`dagestan.su`,
+	`damnserver.com`,
+	`daplie.me`,
+	`dattolocal.com`,
+	`dattolocal.net`,
+	`dattorelay.com`,
+	`dattoweb.com`,
+	`dd-dns.de`,
+	`ddns.me`,
+	`ddns.net`,
+	`ddns5.com`,
+	`ddnsfree.com`,
+	`ddnsgeek.com`,
+	`ddnsking.com`,
+	`ddnslive.com`,
+	`ddnss.de`,
+	`ddnss.org`,
+	`de.com`,
+	`de.cool`,
+	`de.eu.org`,
+	`de.gt`,
+	`de.ls`,
+	`de.md`,
+	`de.trendhosting.cloud`,
+	`debian.net`,
+	`deca.jp`,
+	`deci.jp`,
+	`dedibox.fr`,
+	`dedyn.io`,
+	`definima.io`,
+	`definima.net`,
+	`demo.datacenter.fi`,
+	`demo.datadetect.com`,
+	`demo.jelastic.com`,
+	`demon.nl`,
+	`deno-staging.dev`,
+	`deno.dev`,
+	`deta.app`,
+	`deta.dev`,
+	`dev-myqnapcloud.com`,
+	`dev.static.land`,
+	`dev.vu`,
+	`development.run`,
+	`devices.resinstaging.io`,
+	`df.leg.br`,
+	`dh.bytemark.co.uk`,
+	`diadem.cloud`,
+	`digick.jp`,
+	`direct.quickconnect.cn`,
+	`direct.quickconnect.to`,
+	`discordsays.com`,
+	`discordsez.com`,
+	`discourse.group`,
+	`discourse.team`,
+	`diskstation.eu`,
+	`diskstation.me`,
+	`diskstation.org`,
+	`diskussionsbereich.de`,
+	`ditchyourip.com`,
+	`dk.eu.org`,
+	`dnsalias.com`,
+	`dnsalias.net`,
+	`dnsalias.org`,
+	`dnsdojo.com`,
+	`dnsdojo.net`,
+	`dnsdojo.org`,
+	`dnsfor.me`,
+	`dnshome.de`,
+	`dnsiskinky.com`,
+	`dnsking.ch`,
+	`dnsup.net`,
+	`dnsupdate.info`,
+	`dnsupdater.de`,
+	`does-it.net`,
+	`doesntexist.com`,
+	`doesntexist.org`,
+	`dontexist.com`,
+	`dontexist.net`,
+	`dontexist.org`,
+	`doomdns.com`,
+	`doomdns.org`,
+	`dopaas.com`,
+	`dray-dns.de`,
+	`drayddns.com`,
+	`draydns.de`,
+	`dreamhosters.com`,
+	`drr.ac`,
+	`drud.io`,
+	`drud.us`,
+	`dscloud.biz`,
+	`dscloud.me`,
+	`dscloud.mobi`,
+	`dsmynas.com`,
+	`dsmynas.net`,
+	`dsmynas.org`,
+	`duckdns.org`,
+	`dvrcam.info`,
+	`dvrdns.org`,
+	`dy.fi`,
+	`dyn-berlin.de`,
+	`dyn-ip24.de`,
+	`dyn-o-saur.com`,
+	`dyn-vpn.de`,
+	`dyn.cosidns.de`,
+	`dyn.ddnss.de`,
+	`dyn.home-webserver.de`,
+	`dyn53.io`,
+	`dynalias.com`,
+	`dynalias.net`,
+	`dynalias.org`,
+	`dynamic-dns.info`,
+	`dynamisches-dns.de`,
+	`dynathome.net`,
+	`dyndns-at-home.com`,
+	`dyndns-at-work.com`,
+	`dyndns-blog.com`,
+	`dyndns-free.com`,
+	`dyndns-home.com`,
+	`dyndns-ip.com`,
+	`dyndns-mail.com`,
+	`dyndns-office.com`,
+	`dyndns-pics.com`,
+	`dyndns-remote.com`,
+	`dyndns-server.com`,
+	`dyndns-web.com`,
+	`dyndns-wiki.com`,
+	`dyndns-work.com`,
+	`dyndns.biz`,
+	`dyndns.dappnode.io`,
+	`dyndns.ddnss.de`,
+	`dyndns.info`,
+	`dyndns.org`,
+	`dyndns.tv`,
+	`dyndns.ws`,
+	`dyndns1.de`,
+	`dynns.com`,
+	`dynserv.org`,
+	`dynu.net`,
+	`dynv6.net`,
+	`dynvpn.de`,
+	`e4.cz`,
+	`east-kazakhstan.su`,
+	`eastasia.azurestaticapps.net`,
+	`eastus2.azurestaticapps.net`,
+	`easypanel.app`,
+	`easypanel.host`,
+	`eating-organic.net`,
+	`ecommerce-shop.pl`,
+	`edgeapp.net`,
+	`edgecompute.app`,
+	`edgekey-staging.net`,
+	`edgekey.net`,
+	`edgestack.me`,
+	`edgesuite-staging.net`,
+	`edgesuite.net`,
+	`editorx.io`,
+	`edu.eu.org`,
+	`edu.krd`,
+	`edu.ru`,
+	`edu.scot`,
+	`edugit.io`,
+	`ee.eu.org`,
+	`eero-stage.online`,
+	`eero.online`,
+	`egoism.jp`,
+	`elasticbeanstalk.com`,
+	`elementor.cloud`,
+	`elementor.cool`,
+	`en-root.fr`,
+	`encoreapi.com`,
+	`encr.app`,
+	`endofinternet.net`,
+	`endofinternet.org`,
+	`endoftheinternet.org`,
+	`enscaled.sg`,
+	`ent.platform.sh`,
+	`enterprisecloud.nu`,
+	`es-1.axarnet.cloud`,
+	`es.ax`,
+	`es.eu.org`,
+	`es.leg.br`,
+	`est-a-la-maison.com`,
+	`est-a-la-masion.com`,
+	`est-le-patron.com`,
+	`est-mon-blogueur.com`,
+	`eu-1.evennode.com`,
+	`eu-2.evennode.com`,
+	`eu-3.evennode.com`,
+	`eu-4.evennode.com`,
+	`eu-central-1.elasticbeanstalk.com`,
+	`eu-west-1.elasticbeanstalk.com`,
+	`eu-west-2.elasticbeanstalk.com`,
+	`eu-west-3.elasticbeanstalk.com`,
+	`eu.ax`,
+	`eu.com`,
+	`eu.encoway.cloud`,
+	`eu.meteorapp.com`,
+	`eu.org`,
+	`eu.platform.sh`,
+	`eu.pythonanywhere.com`,
+	`eurodir.ru`,
+	`exnet.su`,
+	`ezproxy.kuleuven.be`,
+	`fakefur.jp`,
+	`familyds.com`,
+	`familyds.net`,
+	`familyds.org`,
+	`fantasyleague.cc`,
+	`fashionstore.jp`,
+	`fastly-edge.com`,
+	`fastly-terrarium.com`,
+	`fastlylb.net`,
+	`faststacks.net`,
+	`fastvps-server.com`,
+	`fastvps.host`,
+	`fastvps.site`,
+	`fbx-os.fr`,
+	`fbxos.fr`,
+	`fedorainfracloud.org`,
+	`fedorapeople.org`,
+	`fem.jp`,
+	`fentiger.mythic-beasts.com`,
+	`feste-ip.net`,
+	`fh-muenster.io`,
+	`fi.cloudplatform.fi`,
+	`fi.eu.org`,
+	`filegear-au.me`,
+	`filegear-de.me`,
+	`filegear-gb.me`,
+	`filegear-ie.me`,
+	`filegear-jp.me`,
+	`filegear-sg.me`,
+	`filegear.me`,
+	`fin.ci`,
+	`firebaseapp.com`,
+	`firewall-gateway.com`,
+	`firewall-gateway.de`,
+	`firewall-gateway.net`,
+	`firewalledreplit.co`,
+	`fireweb.app`,
+	`firm.dk`,
+	`firm.ng`,
+	`flap.id`,
+	`fldrv.com`,
+	`flier.jp`,
+	`floppy.jp`,
+	`flt.cloud.muni.cz`,
+	`fly.dev`,
+	`flynnhosting.net`,
+	`fnc.fr-par.scw.cloud`,
+	`fnwk.site`,
+	`folionetwork.site`,
+	`fool.jp`,
+	`for-better.biz`,
+	`for-more.biz`,
+	`for-our.info`,
+	`for-some.biz`,
+	`for-the.biz`,
+	`forgeblocks.com`,
+	`forgot.her.name`,
+	`forgot.his.name`,
+	`forte.id`,
+	`forumz.info`,
+	`fr-1.paas.massivegrid.net`,
+	`fr-par-1.baremetal.scw.cloud`,
+	`fr-par-2.baremetal.scw.cloud`,
+	`fr.eu.org`,
+	`fra1-de.cloudjiffy.net`,
+	`framer.app`,
+	`framer.media`,
+	`framer.photos`,
+	`framer.website`,
+	`framer.wiki`,
+	`framercanvas.com`,
+	`free.hr`,
+	`freebox-os.com`,
+	`freebox-os.fr`,
+	`freeboxos.com`,
+	`freeboxos.fr`,
+	`freeddns.org`,
+	`freeddns.us`,
+	`freedesktop.org`,
+	`freemyip.com`,
+	`freesite.host`,
+	`freetls.fastly.net`,
+	`frenchkiss.jp`,
+	`from-ak.com`,
+	`from-al.com`,
+	`from-ar.com`,
+	`from-az.net`,
+	`from-ca.com`,
+	`from-co.net`,
+	`from-ct.com`,
+	`from-dc.com`,
+	`from-de.com`,
+	`from-fl.com`,
+	`from-ga.com`,
+	`from-hi.com`,
+	`from-ia.com`,
+	`from-id.com`,
+	`from-il.com`,
+	`from-in.com`,
+	`from-ks.com`,
+	`from-ky.com`,
+	`from-la.net`,
+	`from-ma.com`,
+	`from-md.com`,
+	`from-me.org`,
+	`from-mi.com`,
+	`from-mn.com`,
+	`from-mo.com`,
+	`from-ms.com`,
+	`from-mt.com`,
+	`from-nc.com`,
+	`from-nd.com`,
+	`from-ne.com`,
+	`from-nh.com`,
+	`from-nj.com`,
+	`from-nm.com`,
+	`from-nv.com`,
+	`from-ny.net`,
+	`from-oh.com`,
+	`from-ok.com`,
+	`from-or.com`,
+	`from-pa.com`,
+	`from-pr.com`,
+	`from-ri.com`,
+	`from-sc.com`,
+	`from-sd.com`,
+	`from-tn.com`,
+	`from-tx.com`,
+	`from-ut.com`,
+	`from-va.com`,
+	`from-vt.com`,
+	`from-wa.com`,
+	`from-wi.com`,
+	`from-wv.com`,
+	`from-wy.com`,
+	`ftpaccess.cc`,
+	`fuettertdasnetz.de`,
+	`functions.fnc.fr-par.scw.cloud`,
+	`futurehosting.at`,
+	`futuremailing.at`,
+	`g.vbrplsbx.io`,
+	`game-host.org`,
+	`game-server.cc`,
+	`gb.net`,
+	`gda.pl`,
+	`gdansk.pl`,
+	`gdynia.pl`,
+	`geekgalaxy.com`,
+	`gehirn.ne.jp`,
+	`gen.ng`,
+	`gentapps.com`,
+	`gentlentapis.com`,
+	`georgia.su`,
+	`getmyip.com`,
+	`gets-it.net`,
+	`gg.ax`,
+	`ghost.io`,
+	`giize.com`,
+	`girlfriend.jp`,
+	`girly.jp`,
+	`git-pages.rit.edu`,
+	`git-repos.de`,
+	`gitapp.si`,
+	`github.io`,
+	`githubpreview.dev`,
+	`githubusercontent.com`,
+	`gitlab.io`,
+	`gitpage.si`,
+	`gleeze.com`,
+	`glitch.me`,
+	`gliwice.pl`,
+	`global.prod.fastly.net`,
+	`global.ssl.fastly.net`,
+	`gloomy.jp`,
+	`glug.org.uk`,
+	`go.dyndns.org`,
+	`go.leg.br`,
+	`goip.de`,
+	`golffan.us`,
+	`gonna.jp`,
+	`googleapis.com`,
+	`googlecode.com`,
+	`gotdns.ch`,
+	`gotdns.com`,
+	`gotdns.org`,
+	`gotpantheon.com`,
+	`goupile.fr`,
+	`gov.nl`,
+	`gov.ru`,
+	`gov.scot`,
+	`gr.com`,
+	`gr.eu.org`,
+	`graphox.us`,
+	`greater.jp`,
+	`groks-the.info`,
+	`groks-this.info`,
+	`grozny.ru`,
+	`grozny.su`,
+	`gsj.bz`,
+	`gv.vc`,
+	`günstigbestellen.de`,
+	`günstigliefern.de`,
+	`hacca.jp`,
+	`half.host`,
+	`ham-radio-op.net`,
+	`handcrafted.jp`,
+	`hashbang.sh`,
+	`hasura-app.io`,
+	`hasura.app`,
+	`hb.cldmail.ru`,
+	`health-carereform.com`,
+	`heavy.jp`,
+	`hepforge.org`,
+	`her.jp`,
+	`here-for-more.info`,
+	`herokuapp.com`,
+	`herokussl.com`,
+	`heteml.net`,
+	`hicam.net`,
+	`hidora.com`,
+	`hiho.jp`,
+	`hippy.jp`,
+	`hk.com`,
+	`hk.org`,
+	`hlx.live`,
+	`hlx.page`,
+	`hlx3.page`,
+	`hobby-site.com`,
+	`hobby-site.org`,
+	`holy.jp`,
+	`home-webserver.de`,
+	`home.dyndns.org`,
+	`homedns.org`,
+	`homeftp.net`,
+	`homeftp.org`,
+	`homeip.net`,
+	`homelink.one`,
+	`homelinux.com`,
+	`homelinux.net`,
+	`homelinux.org`,
+	`homeoffice.gov.uk`,
+	`homesecuritymac.com`,
+	`homesecuritypc.com`,
+	`homesklep.pl`,
+	`homeunix.com`,
+	`homeunix.net`,
+	`homeunix.org`,
+	`hoplix.shop`,
+	`hopto.me`,
+	`hopto.org`,
+	`hosp.uk`,
+	`hostedpi.com`,
+	`hosting-cluster.nl`,
+	`hostyhosting.io`,
+	`hotelwithflight.com`,
+	`hr.eu.org`,
+	`hra.health`,
+	`hs.run`,
+	`hs.zone`,
+	`httpbin.org`,
+	`hu.com`,
+	`hu.eu.org`,
+	`hu.net`,
+	`hungry.jp`,
+	`hzc.io`,
+	`häkkinen.fi`,
+	`i234.me`,
+	`iamallama.com`,
+	`ibxos.it`,
+	`icurus.jp`,
+	`id.firewalledreplit.co`,
+	`id.forgerock.io`,
+	`id.repl.co`,
+	`ie.eu.org`,
+	`iki.fi`,
+	`il.eu.org`,
+	`iliadboxos.it`,
+	`ilovecollege.info`,
+	`impertrix.com`,
+	`impertrixcdn.com`,
+	`in-berlin.de`,
+	`in-brb.de`,
+	`in-butter.de`,
+	`in-dsl.de`,
+	`in-dsl.net`,
+	`in-dsl.org`,
+	`in-the-band.net`,
+	`in-vpn.de`,
+	`in-vpn.net`,
+	`in-vpn.org`,
+	`in.eu.org`,
+	`in.net`,
+	`inc.hk`,
+	`independent-commission.uk`,
+	`independent-inquest.uk`,
+	`independent-inquiry.uk`,
+	`independent-panel.uk`,
+	`independent-review.uk`,
+	`indie.porn`,
+	`inf.ua`,
+	`info.at`,
+	`info.cx`,
+	`instance.datadetect.com`,
+	`instances.spawn.cc`,
+	`instantcloud.cn`,
+	`int.eu.org`,
+	`int.ru`,
+	`internet-dns.de`,
+	`io.kg`,
+	`iobb.net`,
+	`iopsys.se`,
+	`ip.linodeusercontent.com`,
+	`ipifony.net`,
+	`is-a-anarchist.com`,
+	`is-a-blogger.com`,
+	`is-a-bookkeeper.com`,
+	`is-a-bruinsfan.org`,
+	`is-a-bulls-fan.com`,
+	`is-a-candidate.org`,
+	`is-a-caterer.com`,
+	`is-a-celticsfan.org`,
+	`is-a-chef.com`,
+	`is-a-chef.net`,
+	`is-a-chef.org`,
+	`is-a-conservative.com`,
+	`is-a-cpa.com`,
+	`is-a-cubicle-slave.com`,
+	`is-a-democrat.com`,
+	`is-a-designer.com`,
+	`is-a-doctor.com`,
+	`is-a-financialadvisor.com`,
+	`is-a-geek.com`,
+	`is-a-geek.net`,
+	`is-a-geek.org`,
+	`is-a-green.com`,
+	`is-a-guru.com`,
+	`is-a-hard-worker.com`,
+	`is-a-hunter.com`,
+	`is-a-knight.org`,
+	`is-a-landscaper.com`,
+	`is-a-lawyer.com`,
+	`is-a-liberal.com`,
+	`is-a-libertarian.com`,
+	`is-a-linux-user.org`,
+	`is-a-llama.com`,
+	`is-a-musician.com`,
+	`is-a-nascarfan.com`,
+	`is-a-nurse.com`,
+	`is-a-painter.com`,
+	`is-a-patsfan.org`,
+	`is-a-personaltrainer.com`,
+	`is-a-photographer.com`,
+	`is-a-player.com`,
+	`is-a-republican.com`,
+	`is-a-rockstar.com`,
+	`is-a-socialist.com`,
+	`is-a-soxfan.org`,
+	`is-a-student.com`,
+	`is-a-teacher.com`,
+	`is-a-techie.com`,
+	`is-a-therapist.com`,
+	`is-an-accountant.com`,
+	`is-an-actor.com`,
+	`is-an-actress.com`,
+	`is-an-anarchist.com`,
+	`is-an-artist.com`,
+	`is-an-engineer.com`,
+	`is-an-entertainer.com`,
+	`is-by.us`,
+	`is-certified.com`,
+	`is-found.org`,
+	`is-gone.com`,
+	`is-into-anime.com`,
+	`is-into-cars.com`,
+	`is-into-cartoons.com`,
+	`is-into-games.com`,
+	`is-leet.com`,
+	`is-lost.org`,
+	`is-not-certified.com`,
+	`is-saved.org`,
+	`is-slick.com`,
+	`is-uberleet.com`,
+	`is-very-bad.org`,
+	`is-very-evil.org`,
+	`is-very-good.org`,
+	`is-very-nice.org`,
+	`is-very-sweet.org`,
+	`is-with-theband.com`,
+	`is.eu.org`,
+	`isa-geek.com`,
+	`isa-geek.net`,
+	`isa-geek.org`,
+	`isa-hockeynut.com`,
+	`iserv.dev`,
+	`iservschule.de`,
+	`issmarterthanyou.com`,
+	`isteingeek.de`,
+	`istmein.de`,
+	`it.com`,
+	`it.eu.org`,
+	`it1.eur.aruba.jenv-aruba.cloud`,
+	`it1.jenv-aruba.cloud`,
+	`itcouldbewor.se`,
+	`itigo.jp`,
+	`ivanovo.su`,
+	`j.layershift.co.uk`,
+	`j.scaleforce.com.cy`,
+	`j.scaleforce.net`,
+	`jambyl.su`,
+	`jc.neen.it`,
+	`jcloud-ver-jpc.ik-server.com`,
+	`jcloud.ik-server.com`,
+	`jcloud.kz`,
+	`jdevcloud.com`,
+	`jed.wafaicloud.com`,
+	`jelastic.dogado.eu`,
+	`jelastic.regruhosting.ru`,
+	`jelastic.saveincloud.net`,
+	`jelastic.team`,
+	`jelastic.tsukaeru.net`,
+	`jele.cloud`,
+	`jele.club`,
+	`jele.host`,
+	`jele.io`,
+	`jele.site`,
+	`jellybean.jp`,
+	`jls-sto1.elastx.net`,
+	`jls-sto2.elastx.net`,
+	`jls-sto3.elastx.net`,
+	`jotelulu.cloud`,
+	`jozi.biz`,
+	`jp.eu.org`,
+	`jp.kg`,
+	`jp.md`,
+	`jp.net`,
+	`jpn.com`,
+	`js.org`,
+	`js.wpenginepowered.com`,
+	`ju.mp`,
+	`k8s.fr-par.scw.cloud`,
+	`k8s.nl-ams.scw.cloud`,
+	`k8s.pl-waw.scw.cloud`,
+	`k8s.scw.cloud`,
+	`kaas.gg`,
+	`kalmykia.ru`,
+	`kalmykia.su`,
+	`kaluga.su`,
+	`kapsi.fi`,
+	`karacol.su`,
+	`karaganda.su`,
+	`karelia.su`,
+	`kasserver.com`,
+	`kawaiishop.jp`,
+	`keliweb.cloud`,
+	`keymachine.de`,
+	`khakassia.su`,
+	`khplay.nl`,
+	`kicks-ass.net`,
+	`kicks-ass.org`,
+	`kikirara.jp`,
+	`kilatiron.com`,
+	`kill.jp`,
+	`kilo.jp`,
+	`kinghost.net`,
+	`knightpoint.systems`,
+	`knowsitall.info`,
+	`knx-server.net`,
+	`koobin.events`,
+	`kozow.com`,
+	`kr.com`,
+	`kr.eu.org`,
+	`krakow.pl`,
+	`krasnik.pl`,
+	`krasnodar.su`,
+	`krellian.net`,
+	`ktistory.com`,
+	`kuleuven.cloud`,
+	`kurgan.su`,
+	`kuron.jp`,
+	`kustanai.ru`,
+	`kustanai.su`,
+	`l-o-g-i-n.de`,
+	`lab.ms`,
+	`land-4-sale.us`,
+	`lcube-server.de`,
+	`leadpages.co`,
+	`lebtimnetz.de`,
+	`leczna.pl`,
+	`leitungsen.de`,
+	`lelux.site`,
+	`lenug.su`,
+	`lib.de.us`,
+	`likes-pie.com`,
+	`likescandy.com`,
+	`lima-city.at`,
+	`lima-city.ch`,
+	`lima-city.de`,
+	`lima-city.rocks`,
+	`lima.zone`,
+	`linkyard-cloud.ch`,
+	`linkyard.cloud`,
+	`littlestar.jp`,
+	`lk3.ru`,
+	`localhost.daplie.me`,
+	`localzone.xyz`,
+	`loginline.app`,
+	`loginline.dev`,
+	`loginline.io`,
+	`loginline.services`,
+	`loginline.site`,
+	`loginto.me`,
+	`logoip.com`,
+	`logoip.de`,
+	`lohmus.me`,
+	`lolipop.io`,
+	`lolipopmc.jp`,
+	`lolitapunk.jp`,
+	`lomo.jp`,
+	`lon-1.paas.massivegrid.net`,
+	`lon-2.paas.massivegrid.net`,
+	`lon.wafaicloud.com`,
+	`london.cloudapps.digital`,
+	`loseyourip.com`,
+	`lovepop.jp`,
+	`lovesick.jp`,
+	`lpages.co`,
+	`lpusercontent.com`,
+	`lt.eu.org`,
+	`ltd.hk`,
+	`ltd.ng`,
+	`ltd.ua`,
+	`lu.eu.org`,
+	`lubartow.pl`,
+	`lublin.pl`,
+	`lug.org.uk`,
+	`lugs.org.uk`,
+	`lv.eu.org`,
+	`lynx.mythic-beasts.com`,
+	`ma.leg.br`,
+	`magnet.page`,
+	`main.jp`,
+	`mangyshlak.su`,
+	`map.fastly.net`,
+	`map.fastlylb.net`,
+	`marine.ru`,
+	`mayfirst.info`,
+	`mayfirst.org`,
+	`mazeplay.com`,
+	`mc.ax`,
+	`mc.eu.org`,
+	`mcdir.me`,
+	`mcdir.ru`,
+	`mcpe.me`,
+	`mcpre.ru`,
+	`me.eu.org`,
+	`me.tc`,
+	`me.vu`,
+	`med.pl`,
+	`mediatech.by`,
+	`mediatech.dev`,
+	`mein-iserv.de`,
+	`mein-vigor.de`,
+	`meinforum.net`,
+	`mel.cloudlets.com.au`,
+	`members.linode.com`,
+	`memset.net`,
+	`merseine.nu`,
+	`messerli.app`,
+	`messwithdns.com`,
+	`meteorapp.com`,
+	`mex.com`,
+	`mg.leg.br`,
+	`mil.ru`,
+	`mine.nu`,
+	`miniserver.com`,
+	`minisite.ms`,
+	`mintere.site`,
+	`mircloud.host`,
+	`mircloud.ru`,
+	`mircloud.us`,
+	`misconfused.org`,
+	`mk.eu.org`,
+	`mlbfan.org`,
+	`mmafan.biz`,
+	`mo-siemens.io`,
+	`mock.pstmn.io`,
+	`mods.jp`,
+	`mond.jp`,
+	`mongolian.jp`,
+	`moo.jp`,
+	`moonscale.net`,
+	`mordovia.ru`,
+	`mordovia.su`,
+	`mozilla-iot.org`,
+	`ms.leg.br`,
+	`msk.ru`,
+	`msk.su`,
+	`mt.eu.org`,
+	`mt.leg.br`,
+	`murmansk.su`,
+	`musician.io`,
+	`my-firewall.org`,
+	`my-gateway.de`,
+	`my-router.de`,
+	`my-vigor.de`,
+	`my-wan.de`,
+	`my.eu.org`,
+	`myactivedirectory.com`,
+	`myamaze.net`,
+	`myasustor.com`,
+	`mycd.eu`,
+	`mycloud.by`,
+	`mydatto.com`,
+	`mydatto.net`,
+	`myddns.rocks`,
+	`mydissent.net`,
+	`mydobiss.com`,
+	`mydrobo.com`,
+	`myds.me`,
+	`myeffect.net`,
+	`myfast.host`,
+	`myfast.space`,
+	`myfirewall.org`,
+	`myforum.community`,
+	`myfritz.net`,
+	`myftp.biz`,
+	`myftp.org`,
+	`myhome-server.de`,
+	`myiphost.com`,
+	`myjino.ru`,
+	`mymailer.com.tw`,
+	`mymediapc.net`,
+	`mypep.link`,
+	`mypets.ws`,
+	`myphotos.cc`,
+	`mypi.co`,
+	`mypsx.net`,
+	`myqnapcloud.com`,
+	`mysecuritycamera.com`,
+	`mysecuritycamera.net`,
+	`mysecuritycamera.org`,
+	`myshopblocks.com`,
+	`myshopify.com`,
+	`myspreadshop.at`,
+	`myspreadshop.be`,
+	`myspreadshop.ca`,
+	`myspreadshop.ch`,
+	`myspreadshop.co.uk`,
+	`myspreadshop.com`,
+	`myspreadshop.com.au`,
+	`myspreadshop.de`,
+	`myspreadshop.dk`,
+	`myspreadshop.es`,
+	`myspreadshop.fi`,
+	`myspreadshop.fr`,
+	`myspreadshop.ie`,
+	`myspreadshop.it`,
+	`myspreadshop.net`,
+	`myspreadshop.nl`,
+	`myspreadshop.no`,
+	`myspreadshop.pl`,
+	`myspreadshop.se`,
+	`mytabit.co.il`,
+	`mytabit.com`,
+	`mytis.ru`,
+	`mytuleap.com`,
+	`myvnc.com`,
+	`mywire.org`,
+	`n4t.co`,
+	`na4u.ru`,
+	`nalchik.ru`,
+	`nalchik.su`,
+	`namaste.jp`,
+	`name.pm`,
+	`navoi.su`,
+	`neat-url.com`,
+	`neko.am`,
+	`nerdpol.ovh`,
+	`net-freaks.com`,
+	`net.eu.org`,
+	`net.ru`,
+	`netlify.app`,
+	`nflfan.org`,
+	`nfshost.com`,
+	`ng.eu.org`,
+	`ngo.ng`,
+	`ngrok.io`,
+	`nh-serv.co.uk`,
+	`nhlfan.net`,
+	`nid.io`,
+	`nikita.jp`,
+	`njs.jelastic.vps-host.net`,
+	`nl-ams-1.baremetal.scw.cloud`,
+	`nl.ci`,
+	`nl.eu.org`,
+	`no-ip.biz`,
+	`no-ip.ca`,
+	`no-ip.co.uk`,
+	`no-ip.info`,
+	`no-ip.net`,
+	`no-ip.org`,
+	`no.com`,
+	`no.eu.org`,
+	`nobushi.jp`,
+	`nodes.k8s.fr-par.scw.cloud`,
+	`nodes.k8s.nl-ams.scw.cloud`,
+	`nodes.k8s.pl-waw.scw.cloud`,
+	`nog.community`,
+	`noho.st`,
+	`nohost.me`,
+	`noip.me`,
+	`noip.us`,
+	`noop.app`,
+	`noor.jp`,
+	`nordeste-idc.saveincloud.net`,
+	`north-kazakhstan.su`,
+	`noticeable.news`,
+	`nov.ru`,
+	`nov.su`,
+	`novecore.site`,
+	`now-dns.net`,
+	`now-dns.org`,
+	`now-dns.top`,
+	`now.sh`,
+	`nsupdate.info`,
+	`ntdll.top`,
+	`ny-1.paas.massivegrid.net`,
+	`ny-2.paas.massivegrid.net`,
+	`nyaa.am`,
+	`nyan.to`,
+	`nyc.mn`,
+	`nz.basketball`,
+	`nz.eu.org`,
+	`obninsk.su`,
+	`ocelot.mythic-beasts.com`,
+	`of.je`,
+	`office-on-the.net`,
+	`official.academy`,
+	`official.ec`,
+	`omg.lol`,
+	`omniwe.site`,
+	`on-aptible.com`,
+	`on-the-web.tv`,
+	`on-web.fr`,
+	`onavstack.net`,
+	`oncilla.mythic-beasts.com`,
+	`ondigitalocean.app`,
+	`onfabrica.com`,
+	`onflashdrive.app`,
+	`online.th`,
+	`onporter.run`,
+	`onred.one`,
+	`onrender.com`,
+	`onthewifi.com`,
+	`onza.mythic-beasts.com`,
+	`ooguy.com`,
+	`oops.jp`,
+	`opencraft.hosting`,
+	`opensocial.site`,
+	`operaunite.com`,
+	`orangecloud.tn`,
+	`org.ru`,
+	`org.yt`,
+	`orsites.com`,
+	`orx.biz`,
+	`outsystemscloud.com`,
+	`own.pm`,
+	`ownip.net`,
+	`ownprovider.com`,
+	`ox.rs`,
+	`oxa.cloud`,
+	`oy.lc`,
+	`oya.to`,
+	`pa.leg.br`,
+	`paas.beebyte.io`,
+	`paas.datacenter.fi`,
+	`paas.hosted-by-previder.com`,
+	`paas.massivegrid.com`,
+	`pagefrontapp.com`,
+	`pages.dev`,
+	`pages.it.hs-heilbronn.de`,
+	`pages.torproject.net`,
+	`pages.wiardweb.com`,
+	`pagespeedmobilizer.com`,
+	`pagexl.com`,
+	`panel.gg`,
+	`pantheonsite.io`,
+	`parallel.jp`,
+	`parasite.jp`,
+	`paris.eu.org`,
+	`pb.leg.br`,
+	`pcloud.host`,
+	`pdns.page`,
+	`pe.leg.br`,
+	`pecori.jp`,
+	`peewee.jp`,
+	`penne.jp`,
+	`penza.su`,
+	`pepper.jp`,
+	`perma.jp`,
+	`perspecta.cloud`,
+	`pgafan.net`,
+	`pgfog.com`,
+	`phx.enscaled.us`,
+	`pi.leg.br`,
+	`pigboat.jp`,
+	`pimienta.org`,
+	`pinoko.jp`,
+	`pixolino.com`,
+	`pl.eu.org`,
+	`platform0.app`,
+	`platter-app.com`,
+	`platter-app.dev`,
+	`platterp.us`,
+	`playstation-cloud.com`,
+	`plesk.page`,
+	`pleskns.com`,
+	`podzone.net`,
+	`podzone.org`,
+	`point2this.com`,
+	`pointto.us`,
+	`poivron.org`,
+	`pokrovsk.su`,
+	`poniatowa.pl`,
+	`postman-echo.com`,
+	`potager.org`,
+	`poznan.pl`,
+	`pp.ru`,
+	`pp.ua`,
+	`pr.leg.br`,
+	`prequalifyme.today`,
+	`primetel.cloud`,
+	`priv.at`,
+	`priv.instances.scw.cloud`,
+	`privatelink.snowflake.app`,
+	`privatizehealthinsurance.net`,
+	`pro.typeform.com`,
+	`protonet.io`,
+	`prvcy.page`,
+	`pstmn.io`,
+	`pt.eu.org`,
+	`pub.instances.scw.cloud`,
+	`public-inquiry.uk`,
+	`publishproxy.com`,
+	`pubtls.org`,
+	`punyu.jp`,
+	`pupu.jp`,
+	`pussycat.jp`,
+	`pya.jp`,
+	`pyatigorsk.ru`,
+	`pymnt.uk`,
+	`pythonanywhere.com`,
+	`q-a.eu.org`,
+	`qa2.com`,
+	`qbuser.com`,
+	`qc.com`,
+	`qcx.io`,
+	`qoto.io`,
+	`qualifioapp.com`,
+	`quicksytes.com`,
+	`r.cdn77.net`,
+	`r2.dev`,
+	`rackmaze.com`,
+	`rackmaze.net`,
+	`radio.am`,
+	`radio.fm`,
+	`raffleentry.org.uk`,
+	`rag-cloud-ch.hosteur.com`,
+	`rag-cloud.hosteur.com`,
+	`raindrop.jp`,
+	`ras.ru`,
+	`ravendb.cloud`,
+	`ravendb.community`,
+	`ravendb.me`,
+	`ravendb.run`,
+	`ravpage.co.il`,
+	`rdv.to`,
+	`read-books.org`,
+	`readmyblog.org`,
+	`readthedocs.io`,
+	`readymade.jp`,
+	`realm.cz`,
+	`redirectme.net`,
+	`reg.dk`,
+	`remotewd.com`,
+	`repl.co`,
+	`repl.run`,
+	`reservd.com`,
+	`reservd.dev.thingdust.io`,
+	`reservd.disrec.thingdust.io`,
+	`reservd.testing.thingdust.io`,
+	`reserve-online.com`,
+	`reserve-online.net`,
+	`resindevice.io`,
+	`rhcloud.com`,
+	`ric.jelastic.vps-host.net`,
+	`rj.leg.br`,
+	`rn.leg.br`,
+	`ro.eu.org`,
+	`ro.im`,
+	`ro.leg.br`,
+	`rocky.page`,
+	`router.management`,
+	`royal-commission.uk`,
+	`rr.leg.br`,
+	`rs.ba`,
+	`rs.leg.br`,
+	`rsc.cdn77.org`,
+	`ru.com`,
+	`ru.eu.org`,
+	`ru.net`,
+	`run.app`,
+	`ryd.wafaicloud.com`,
+	`s3-ap-northeast-1.amazonaws.com`,
+	`s3-ap-northeast-2.amazonaws.com`,
+	`s3-ap-south-1.amazonaws.com`,
+	`s3-ap-southeast-1.amazonaws.com`,
+	`s3-ap-southeast-2.amazonaws.com`,
+	`s3-ca-central-1.amazonaws.com`,
+	`s3-eu-central-1.amazonaws.com`,
+	`s3-eu-west-1.amazonaws.com`,
+	`s3-eu-west-2.amazonaws.com`,
+	`s3-eu-west-3.amazonaws.com`,
+	`s3-external-1.amazonaws.com`,
+	`s3-fips-us-gov-west-1.amazonaws.com`,
+	`s3-sa-east-1.amazonaws.com`,
+	`s3-us-east-2.amazonaws.com`,
+	`s3-us-gov-west-1.amazonaws.com`,
+	`s3-us-west-1.amazonaws.com`,
+	`s3-us-west-2.amazonaws.com`,
+	`s3-website-ap-northeast-1.amazonaws.com`,
+	`s3-website-ap-southeast-1.amazonaws.com`,
+	`s3-website-ap-southeast-2.amazonaws.com`,
+	`s3-website-eu-west-1.amazonaws.com`,
+	`s3-website-sa-east-1.amazonaws.com`,
+	`s3-website-us-east-1.amazonaws.com`,
+	`s3-website-us-west-1.amazonaws.com`,
+	`s3-website-us-west-2.amazonaws.com`,
+	`s3-website.ap-northeast-2.amazonaws.com`,
+	`s3-website.ap-south-1.amazonaws.com`,
+	`s3-website.ca-central-1.amazonaws.com`,
+	`s3-website.eu-central-1.amazonaws.com`,
+	`s3-website.eu-west-2.amazonaws.com`,
+	`s3-website.eu-west-3.amazonaws.com`,
+	`s3-website.fr-par.scw.cloud`,
+	`s3-website.nl-ams.scw.cloud`,
+	`s3-website.pl-waw.scw.cloud`,
+	`s3-website.us-east-2.amazonaws.com`,
+	`s3.amazonaws.com`,
+	`s3.ap-northeast-2.amazonaws.com`,
+	`s3.ap-south-1.amazonaws.com`,
+	`s3.ca-central-1.amazonaws.com`,
+	`s3.cn-north-1.amazonaws.com.cn`,
+	`s3.dualstack.ap-northeast-1.amazonaws.com`,
+	`s3.dualstack.ap-northeast-2.amazonaws.com`,
+	`s3.dualstack.ap-south-1.amazonaws.com`,
+	`s3.dualstack.ap-southeast-1.amazonaws.com`,
+	`s3.dualstack.ap-southeast-2.amazonaws.com`,
+	`s3.dualstack.ca-central-1.amazonaws.com`,
+	`s3.dualstack.eu-central-1.amazonaws.com`,
+	`s3.dualstack.eu-west-1.amazonaws.com`,
+	`s3.dualstack.eu-west-2.amazonaws.com`,
+	`s3.dualstack.eu-west-3.amazonaws.com`,
+	`s3.dualstack.sa-east-1.amazonaws.com`,
+	`s3.dualstack.us-east-1.amazonaws.com`,
+	`s3.dualstack.us-east-2.amazonaws.com`,
+	`s3.eu-central-1.amazonaws.com`,
+	`s3.eu-west-2.amazonaws.com`,
+	`s3.eu-west-3.amazonaws.com`,
+	`s3.fr-par.scw.cloud`,
+	`s3.nl-ams.scw.cloud`,
+	`s3.pl-waw.scw.cloud`,
+	`s3.teckids.org`,
+	`s3.us-east-2.amazonaws.com`,
+	`sa-east-1.elasticbeanstalk.com`,
+	`sa.com`,
+	`sadist.jp`,
+	`sandcats.io`,
+	`saves-the-whales.com`,
+	`sc.leg.br`,
+	`scalebook.scw.cloud`,
+	`sch.so`,
+	`sch.tf`,
+	`sch.wf`,
+	`schokokeks.net`,
+	`schoolbus.jp`,
+	`schulplattform.de`,
+	`schulserver.de`,
+	`scrapper-site.net`,
+	`scrapping.cc`,
+	`scrysec.com`,
+	`sdscloud.pl`,
+	`se.eu.org`,
+	`se.leg.br`,
+	`se.net`,
+	`secaas.hk`,
+	`secret.jp`,
+	`securitytactics.com`,
+	`seidat.net`,
+	`sekd1.beebyteapp.io`,
+	`selfip.biz`,
+	`selfip.com`,
+	`selfip.info`,
+	`selfip.net`,
+	`selfip.org`,
+	`sellfy.store`,
+	`sells-for-less.com`,
+	`sells-for-u.com`,
+	`sells-it.net`,
+	`sellsyourhome.org`,
+	`senseering.net`,
+	`servebbs.com`,
+	`servebbs.net`,
+	`servebbs.org`,
+	`servebeer.com`,
+	`serveblog.net`,
+	`servecounterstrike.com`,
+	`serveexchange.com`,
+	`serveftp.com`,
+	`serveftp.net`,
+	`serveftp.org`,
+	`servegame.com`,
+	`servegame.org`,
+	`servehalflife.com`,
+	`servehttp.com`,
+	`servehumour.com`,
+	`serveirc.com`,
+	`serveminecraft.net`,
+	`servemp3.com`,
+	`servep2p.com`,
+	`servepics.com`,
+	`servequake.com`,
+	`servers.run`,
+	`servesarcasm.com`,
+	`service.gov.scot`,
+	`service.gov.uk`,
+	`service.one`,
+	`sg-1.paas.massivegrid.net`,
+	`shacknet.nu`,
+	`shiftcrypto.dev`,
+	`shiftcrypto.io`,
+	`shiftedit.io`,
+	`shop.brendly.rs`,
+	`shop.ro`,
+	`shop.th`,
+	`shoparena.pl`,
+	`shopitsite.com`,
+	`shopselect.net`,
+	`shopware.store`,
+	`shw.io`,
+	`si.eu.org`,
+	`siiites.com`,
+	`simple-url.com`,
+	`simplesite.com`,
+	`simplesite.com.br`,
+	`simplesite.gr`,
+	`simplesite.pl`,
+	`sinaapp.com`,
+	`site.tb-hosting.com`,
+	`site.transip.me`,
+	`siteleaf.net`,
+	`sites.static.land`,
+	`sk.eu.org`,
+	`skygearapp.com`,
+	`small-web.org`,
+	`smartlabeling.scw.cloud`,
+	`smushcdn.com`,
+	`snowflake.app`,
+	`soc.srcf.net`,
+	`sochi.su`,
+	`sopot.pl`,
+	`soundcast.me`,
+	`sp.leg.br`,
+	`space-to-rent.com`,
+	`spacekit.io`,
+	`spb.ru`,
+	`spb.su`,
+	`spdns.de`,
+	`spdns.eu`,
+	`spdns.org`,
+	`sphinx.mythic-beasts.com`,
+	`square7.ch`,
+	`square7.de`,
+	`square7.net`,
+	`srht.site`,
+	`ssl.origin.cdn77-secure.org`,
+	`staba.jp`,
+	`stackhero-network.com`,
+	`stage.nodeart.io`,
+	`staging.onred.one`,
+	`static-access.net`,
+	`static.land`,
+	`static.observableusercontent.com`,
+	`storage.yandexcloud.net`,
+	`store.dk`,
+	`storebase.store`,
+	`storj.farm`,
+	`streamlit.app`,
+	`streamlitapp.com`,
+	`stripper.jp`,
+	`stuff-4-sale.org`,
+	`stuff-4-sale.us`,
+	`stufftoread.com`,
+	`su.paba.se`,
+	`sub.jp`,
+	`sunnyday.jp`,
+	`supabase.co`,
+	`supabase.in`,
+	`supabase.net`,
+	`supersale.jp`,
+	`svn-repos.de`,
+	`sweetpepper.org`,
+	`swidnik.pl`,
+	`syncloud.it`,
+	`syno-ds.de`,
+	`synology-diskstation.de`,
+	`synology-ds.de`,
+	`synology.me`,
+	`sytes.net`,
+	`t3l3p0rt.net`,
+	`tabitorder.co.il`,
+	`taifun-dns.de`,
+	`tashkent.su`,
+	`tcp4.me`,
+	`teaches-yoga.com`,
+	`tech.orange`,
+	`tele.amune.org`,
+	`telebit.app`,
+	`telebit.io`,
+	`temp-dns.com`,
+	`tempurl.host`,
+	`termez.su`,
+	`test-iserv.de`,
+	`test.ru`,
+	`theshop.jp`,
+	`theworkpc.com`,
+	`thick.jp`,
+	`thingdustdata.com`,
+	`thruhere.net`,
+	`tickets.io`,
+	`tlon.network`,
+	`tn.oxa.cloud`,
+	`to.gt`,
+	`to.leg.br`,
+	`to.md`,
+	`togliatti.su`,
+	`tonkotsu.jp`,
+	`toolforge.org`,
+	`torproject.net`,
+	`townnews-staging.com`,
+	`tr.eu.org`,
+	`traeumtgerade.de`,
+	`trafficplex.cloud`,
+	`translate.goog`,
+	`translated.page`,
+	`troitsk.su`,
+	`try-snowplow.com`,
+	`trycloudflare.com`,
+	`ts.net`,
+	`tselinograd.su`,
+	`tula.su`,
+	`tuleap-partners.com`,
+	`tunk.org`,
+	`tuva.su`,
+	`tuxfamily.org`,
+	`tv.kg`,
+	`twmail.cc`,
+	`twmail.net`,
+	`twmail.org`,
+	`typedream.app`,
+	`u.channelsdvr.net`,
+	`u2-local.xnbay.com`,
+	`u2.xnbay.com`,
+	`ua.rs`,
+	`uber.space`,
+	`ufcfan.org`,
+	`ui.nabu.casa`,
+	`uk.com`,
+	`uk.eu.org`,
+	`uk.kg`,
+	`uk.net`,
+	`uk.oxa.cloud`,
+	`uk.primetel.cloud`,
+	`uk.reclaim.cloud`,
+	`uk0.bigv.io`,
+	`under.jp`,
+	`uni5.net`,
+	`unicloud.pl`,
+	`unusualperson.com`,
+	`upaas.kazteleport.kz`,
+	`upli.io`,
+	`upper.jp`,
+	`url.tw`,
+	`urown.cloud`,
+	`us-1.evennode.com`,
+	`us-2.evennode.com`,
+	`us-3.evennode.com`,
+	`us-4.evennode.com`,
+	`us-east-1.amazonaws.com`,
+	`us-east-1.elasticbeanstalk.com`,
+	`us-east-2.elasticbeanstalk.com`,
+	`us-gov-west-1.elasticbeanstalk.com`,
+	`us-west-1.elasticbeanstalk.com`,
+	`us-west-2.elasticbeanstalk.com`,
+	`us.ax`,
+	`us.com`,
+	`us.eu.org`,
+	`us.kg`,
+	`us.org`,
+	`us.platform.sh`,
+	`us.reclaim.cloud`,
+	`user.aseinet.ne.jp`,
+	`user.party.eus`,
+	`user.srcf.net`,
+	`usercontent.jp`,
+	`users.scale.virtualcloud.com.br`,
+	`usr.cloud.muni.cz`,
+	`utwente.io`,
+	`uwu.ai`,
+	`uy.com`,
+	`v-info.info`,
+	`v.ua`,
+	`vapor.cloud`,
+	`vaporcloud.io`,
+	`velvet.jp`,
+	`vercel.app`,
+	`vercel.dev`,
+	`verse.jp`,
+	`versus.jp`,
+	`vfs.cloud9.af-south-1.amazonaws.com`,
+	`vfs.cloud9.ap-east-1.amazonaws.com`,
+	`vfs.cloud9.ap-northeast-1.amazonaws.com`,
+	`vfs.cloud9.ap-northeast-2.amazonaws.com`,
+	`vfs.cloud9.ap-northeast-3.amazonaws.com`,
+	`vfs.cloud9.ap-south-1.amazonaws.com`,
+	`vfs.cloud9.ap-southeast-1.amazonaws.com`,
+	`vfs.cloud9.ap-southeast-2.amazonaws.com`,
+	`vfs.cloud9.ca-central-1.amazonaws.com`,
+	`vfs.cloud9.eu-central-1.amazonaws.com`,
+	`vfs.cloud9.eu-north-1.amazonaws.com`,
+	`vfs.cloud9.eu-south-1.amazonaws.com`,
+	`vfs.cloud9.eu-west-1.amazonaws.com`,
+	`vfs.cloud9.eu-west-2.amazonaws.com`,
+	`vfs.cloud9.eu-west-3.amazonaws.com`,
+	`vfs.cloud9.me-south-1.amazonaws.com`,
+	`vfs.cloud9.sa-east-1.amazonaws.com`,
+	`vfs.cloud9.us-east-1.amazonaws.com`,
+	`vfs.cloud9.us-east-2.amazonaws.com`,
+	`vfs.cloud9.us-west-1.amazonaws.com`,
+	`vfs.cloud9.us-west-2.amazonaws.com`,
+	`vip.jelastic.cloud`,
+	`vipsinaapp.com`,
+	`virtual-user.de`,
+	`virtualserver.io`,
+	`virtualuser.de`,
+	`vivian.jp`,
+	`vladikavkaz.ru`,
+	`vladikavkaz.su`,
+	`vladimir.ru`,
+	`vladimir.su`,
+	`vm.bytemark.co.uk`,
+	`vologda.su`,
+	`voorloper.cloud`,
+	`vp4.me`,
+	`vpndns.net`,
+	`vpnplus.to`,
+	`vps-host.net`,
+	`vps.mcdir.ru`,
+	`vs.mythic-beasts.com`,
+	`vxl.sh`,
+	`wafflecell.com`,
+	`watson.jp`,
+	`we.bs`,
+	`we.tc`,
+	`web.app`,
+	`web.in`,
+	`webhop.biz`,
+	`webhop.info`,
+	`webhop.me`,
+	`webhop.net`,
+	`webhop.org`,
+	`webhosting.be`,
+	`weblike.jp`,
+	`webredirect.org`,
+	`website.yandexcloud.net`,
+	`webspace.rocks`,
+	`webthings.io`,
+	`webview-assets.cloud9.af-south-1.amazonaws.com`,
+	`webview-assets.cloud9.ap-east-1.amazonaws.com`,
+	`webview-assets.cloud9.ap-northeast-1.amazonaws.com`,
+	`webview-assets.cloud9.ap-northeast-2.amazonaws.com`,
+	`webview-assets.cloud9.ap-northeast-3.amazonaws.com`,
+	`webview-assets.cloud9.ap-south-1.amazonaws.com`,
+	`webview-assets.cloud9.ap-southeast-1.amazonaws.com`,
+	`webview-assets.cloud9.ap-southeast-2.amazonaws.com`,
+	`webview-assets.cloud9.ca-central-1.amazonaws.com`,
+	`webview-assets.cloud9.eu-central-1.amazonaws.com`,
+	`webview-assets.cloud9.eu-north-1.amazonaws.com`,
+	`webview-assets.cloud9.eu-south-1.amazonaws.com`,
+	`webview-assets.cloud9.eu-west-1.amazonaws.com`,
+	`webview-assets.cloud9.eu-west-2.amazonaws.com`,
+	`webview-assets.cloud9.eu-west-3.amazonaws.com`,
+	`webview-assets.cloud9.me-south-1.amazonaws.com`,
+	`webview-assets.cloud9.sa-east-1.amazonaws.com`,
+	`webview-assets.cloud9.us-east-1.amazonaws.com`,
+	`webview-assets.cloud9.us-east-2.amazonaws.com`,
+	`webview-assets.cloud9.us-west-1.amazonaws.com`,
+	`webview-assets.cloud9.us-west-2.amazonaws.com`,
+	`wedeploy.io`,
+	`wedeploy.me`,
+	`wedeploy.sh`,
+	`weeklylottery.org.uk`,
+	`wellbeingzone.co.uk`,
+	`wellbeingzone.eu`,
+	`west1-us.cloudjiffy.net`,
+	`westeurope.azurestaticapps.net`,
+	`westus2.azurestaticapps.net`,
+	`whitesnow.jp`,
+	`whm.fr-par.scw.cloud`,
+	`whm.nl-ams.scw.cloud`,
+	`wien.funkfeuer.at`,
+	`withgoogle.com`,
+	`withyoutube.com`,
+	`wixsite.com`,
+	`wmcloud.org`,
+	`wmflabs.org`,
+	`wnext.app`,
+	`woltlab-demo.com`,
+	`workers.dev`,
+	`workisboring.com`,
+	`worse-than.tv`,
+	`wpdevcloud.com`,
+	`wpenginepowered.com`,
+	`wphostedmail.com`,
+	`wpmucdn.com`,
+	`wpmudev.host`,
+	`writesthisblog.com`,
+	`wroc.pl`,
+	`x.mythic-beasts.com`,
+	`x443.pw`,
+	`xen.prgmr.com`,
+	`xnbay.com`,
+	`xs4all.space`,
+	`xx.gl`,
+	`xy.ax`,
+	`yali.mythic-beasts.com`,
+	`yandexcloud.net`,
+	`ybo.faith`,
+	`ybo.party`,
+	`ybo.review`,
+	`ybo.science`,
+	`ybo.trade`,
+	`ynh.fr`,
+	`yolasite.com`,
+	`yombo.me`,
+	`za.bz`,
+	`za.com`,
+	`za.net`,
+	`za.org`,
+	`zakopane.pl`,
+	`zapto.org`,
+	`zapto.xyz`,
+	`zombie.jp`,
+	`биз.рус`,
+	`ком.рус`,
+	`крым.рус`,
+	`мир.рус`,
+	`мск.рус`,
+	`орг.рус`,
+	`самара.рус`,
+	`сочи.рус`,
+	`спб.рус`,
+	`я.рус`,
+}
+
+// PrivateWildcardTLDs is the private domains section counterpart of WildcardTLDs.
+var PrivateWildcardTLDs = []string{
+	`0emm.com`,
+	`advisor.ws`,
+	`alces.network`,
+	`awdev.ca`,
+	`azurecontainer.io`,
+	`backyards.banzaicloud.io`,
+	`banzai.cloud`,
+	`beget.app`,
+	`build.run`,
+	`builder.code.com`,
+	`bzz.dapps.earth`,
+	`cloud.metacentrum.cz`,
+	`cloudera.site`,
+	`cns.joyent.com`,
+	`code.run`,
+	`compute-1.amazonaws.com`,
+	`compute.amazonaws.com`,
+	`compute.amazonaws.com.cn`,
+	`compute.estate`,
+	`cryptonomic.net`,
+	`customer-oci.com`,
+	`dapps.earth`,
+	`database.run`,
+	`dev-builder.code.com`,
+	`dev.adobeaemcloud.com`,
+	`devcdnaccesso.com`,
+	`developer.app`,
+	`digitaloceanspaces.com`,
+	`diher.solutions`,
+	`dweb.link`,
+	`elb.amazonaws.com`,
+	`elb.amazonaws.com.cn`,
+	`ex.futurecms.at`,
+	`ex.ortsinfo.at`,
+	`firenet.ch`,
+	`frusky.de`,
+	`futurecms.at`,
+	`gateway.dev`,
+	`hosting.myjino.ru`,
+	`hosting.ovh.net`,
+	`in.futurecms.at`,
+	`kunden.ortsinfo.at`,
+	`landing.myjino.ru`,
+	`lcl.dev`,
+	`lclstage.dev`,
+	`linodeobjects.com`,
+	`magentosite.cloud`,
+	`migration.run`,
+	`moonscale.io`,
+	`nodebalancer.linode.com`,
+	`northflank.app`,
+	`oci.customer-oci.com`,
+	`ocp.customer-oci.com`,
+	`ocs.customer-oci.com`,
+	`on-acorn.io`,
+	`on-k3s.io`,
+	`on-rancher.cloud`,
+	`on-rio.io`,
+	`otap.co`,
+	`owo.codes`,
+	`paywhirl.com`,
+	`platformsh.site`,
+	`quipelements.com`,
+	`r.appspot.com`,
+	`rss.my.id`,
+	`s5y.io`,
+	`sensiosite.cloud`,
+	`spectrum.myjino.ru`,
+	`statics.cloud`,
+	`stg-builder.code.com`,
+	`stg.dev`,
+	`stgstage.dev`,
+	`stolos.io`,
+	`svc.firenet.ch`,
+	`sys.qcx.io`,
+	`telebit.xyz`,
+	`transurl.be`,
+	`transurl.eu`,
+	`transurl.nl`,
+	`triton.zone`,
+	`tst.site`,
+	`uberspace.de`,
+	`user.fm`,
+	`user.localcert.dev`,
+	`usercontent.goog`,
+	`vps.myjino.ru`,
+	`vultrobjects.com`,
+	`webhare.dev`,
+	`webpaas.ovh.net`,
+}
+
+// PrivateExceptionTLDs is the private domains section counterpart of ExceptionTLDs.
+var PrivateExceptionTLDs = []string{}
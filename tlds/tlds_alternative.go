@@ -0,0 +1,51 @@
+package tlds
+
+// ENSTLDs is a sorted list of the TLDs of the Ethereum Name Service, resolved on the Ethereum
+// blockchain rather than in the DNS.
+// The list is maintained by hand, from:
+//   - https://docs.ens.domains/
+var ENSTLDs = []string{
+	`eth`, // Ethereum Name Service
+}
+
+// OpenNICTLDs is a sorted list of the TLDs of the OpenNIC alternative DNS root.
+// The list is maintained by hand, from:
+//   - https://wiki.opennic.org/opennic/dot
+var OpenNICTLDs = []string{
+	`bbs`,
+	`chan`,
+	`cyb`,
+	`dyn`,
+	`epic`,
+	`geek`,
+	`gopher`,
+	`indy`,
+	`libre`,
+	`neo`,
+	`null`,
+	`o`,
+	`oss`,
+	`oz`,
+	`parody`,
+	`pirate`,
+}
+
+// UnstoppableDomainsTLDs is a sorted list of the TLDs of Unstoppable Domains, resolved on the
+// Ethereum and Polygon blockchains rather than in the DNS.
+// The list is maintained by hand, from:
+//   - https://unstoppabledomains.com/
+var UnstoppableDomainsTLDs = []string{
+	`888`,
+	`bitcoin`,
+	`blockchain`,
+	`coin`,
+	`crypto`,
+	`dao`,
+	`hi`,
+	`klever`,
+	`nft`,
+	`polygon`,
+	`wallet`,
+	`x`,
+	`zil`,
+}
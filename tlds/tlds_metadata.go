@@ -0,0 +1,360 @@
+// This file is autogenerated by https://github.com/hueristiq/hqgourl/blob/main/generate/tlds/main.go. Please do not edit manually.
+
+package tlds
+
+// Provenance of the TLDs metadata: the source it is generated from, the date the source was fetched,
+// and the hex-encoded SHA-256 hash of the source. They are empty if the metadata is derived from the
+// public suffix list alone, see PublicSuffixListSource.
+const (
+	RootZoneDatabaseSource       = ``
+	RootZoneDatabaseSourceDate   = ``
+	RootZoneDatabaseSourceSHA256 = ``
+)
+
+// metadata maps TLDs, in their Unicode form, to their metadata.
+// The metadata is fetched from:
+//   - https://publicsuffix.org/list/public_suffix_list.dat, for its ccTLDs and the legacy TLDs only,
+//     whose types are known without the root zone database
+var metadata = map[string]TLDMetadata{
+	`ac`:          {Type: TLDTypeCountryCode, CountryCode: `AC`},
+	`ad`:          {Type: TLDTypeCountryCode, CountryCode: `AD`},
+	`ae`:          {Type: TLDTypeCountryCode, CountryCode: `AE`},
+	`aero`:        {Type: TLDTypeSponsored, CountryCode: ``},
+	`af`:          {Type: TLDTypeCountryCode, CountryCode: `AF`},
+	`ag`:          {Type: TLDTypeCountryCode, CountryCode: `AG`},
+	`ai`:          {Type: TLDTypeCountryCode, CountryCode: `AI`},
+	`al`:          {Type: TLDTypeCountryCode, CountryCode: `AL`},
+	`am`:          {Type: TLDTypeCountryCode, CountryCode: `AM`},
+	`ao`:          {Type: TLDTypeCountryCode, CountryCode: `AO`},
+	`aq`:          {Type: TLDTypeCountryCode, CountryCode: `AQ`},
+	`ar`:          {Type: TLDTypeCountryCode, CountryCode: `AR`},
+	`arpa`:        {Type: TLDTypeInfrastructure, CountryCode: ``},
+	`as`:          {Type: TLDTypeCountryCode, CountryCode: `AS`},
+	`asia`:        {Type: TLDTypeSponsored, CountryCode: ``},
+	`at`:          {Type: TLDTypeCountryCode, CountryCode: `AT`},
+	`au`:          {Type: TLDTypeCountryCode, CountryCode: `AU`},
+	`aw`:          {Type: TLDTypeCountryCode, CountryCode: `AW`},
+	`ax`:          {Type: TLDTypeCountryCode, CountryCode: `AX`},
+	`az`:          {Type: TLDTypeCountryCode, CountryCode: `AZ`},
+	`ba`:          {Type: TLDTypeCountryCode, CountryCode: `BA`},
+	`bb`:          {Type: TLDTypeCountryCode, CountryCode: `BB`},
+	`bd`:          {Type: TLDTypeCountryCode, CountryCode: `BD`},
+	`be`:          {Type: TLDTypeCountryCode, CountryCode: `BE`},
+	`bf`:          {Type: TLDTypeCountryCode, CountryCode: `BF`},
+	`bg`:          {Type: TLDTypeCountryCode, CountryCode: `BG`},
+	`bh`:          {Type: TLDTypeCountryCode, CountryCode: `BH`},
+	`bi`:          {Type: TLDTypeCountryCode, CountryCode: `BI`},
+	`biz`:         {Type: TLDTypeGenericRestricted, CountryCode: ``},
+	`bj`:          {Type: TLDTypeCountryCode, CountryCode: `BJ`},
+	`bm`:          {Type: TLDTypeCountryCode, CountryCode: `BM`},
+	`bn`:          {Type: TLDTypeCountryCode, CountryCode: `BN`},
+	`bo`:          {Type: TLDTypeCountryCode, CountryCode: `BO`},
+	`br`:          {Type: TLDTypeCountryCode, CountryCode: `BR`},
+	`bs`:          {Type: TLDTypeCountryCode, CountryCode: `BS`},
+	`bt`:          {Type: TLDTypeCountryCode, CountryCode: `BT`},
+	`bv`:          {Type: TLDTypeCountryCode, CountryCode: `BV`},
+	`bw`:          {Type: TLDTypeCountryCode, CountryCode: `BW`},
+	`by`:          {Type: TLDTypeCountryCode, CountryCode: `BY`},
+	`bz`:          {Type: TLDTypeCountryCode, CountryCode: `BZ`},
+	`ca`:          {Type: TLDTypeCountryCode, CountryCode: `CA`},
+	`cat`:         {Type: TLDTypeSponsored, CountryCode: ``},
+	`cc`:          {Type: TLDTypeCountryCode, CountryCode: `CC`},
+	`cd`:          {Type: TLDTypeCountryCode, CountryCode: `CD`},
+	`cf`:          {Type: TLDTypeCountryCode, CountryCode: `CF`},
+	`cg`:          {Type: TLDTypeCountryCode, CountryCode: `CG`},
+	`ch`:          {Type: TLDTypeCountryCode, CountryCode: `CH`},
+	`ci`:          {Type: TLDTypeCountryCode, CountryCode: `CI`},
+	`ck`:          {Type: TLDTypeCountryCode, CountryCode: `CK`},
+	`cl`:          {Type: TLDTypeCountryCode, CountryCode: `CL`},
+	`cm`:          {Type: TLDTypeCountryCode, CountryCode: `CM`},
+	`cn`:          {Type: TLDTypeCountryCode, CountryCode: `CN`},
+	`co`:          {Type: TLDTypeCountryCode, CountryCode: `CO`},
+	`com`:         {Type: TLDTypeGeneric, CountryCode: ``},
+	`coop`:        {Type: TLDTypeSponsored, CountryCode: ``},
+	`cr`:          {Type: TLDTypeCountryCode, CountryCode: `CR`},
+	`cu`:          {Type: TLDTypeCountryCode, CountryCode: `CU`},
+	`cv`:          {Type: TLDTypeCountryCode, CountryCode: `CV`},
+	`cw`:          {Type: TLDTypeCountryCode, CountryCode: `CW`},
+	`cx`:          {Type: TLDTypeCountryCode, CountryCode: `CX`},
+	`cy`:          {Type: TLDTypeCountryCode, CountryCode: `CY`},
+	`cz`:          {Type: TLDTypeCountryCode, CountryCode: `CZ`},
+	`de`:          {Type: TLDTypeCountryCode, CountryCode: `DE`},
+	`dj`:          {Type: TLDTypeCountryCode, CountryCode: `DJ`},
+	`dk`:          {Type: TLDTypeCountryCode, CountryCode: `DK`},
+	`dm`:          {Type: TLDTypeCountryCode, CountryCode: `DM`},
+	`do`:          {Type: TLDTypeCountryCode, CountryCode: `DO`},
+	`dz`:          {Type: TLDTypeCountryCode, CountryCode: `DZ`},
+	`ec`:          {Type: TLDTypeCountryCode, CountryCode: `EC`},
+	`edu`:         {Type: TLDTypeSponsored, CountryCode: ``},
+	`ee`:          {Type: TLDTypeCountryCode, CountryCode: `EE`},
+	`eg`:          {Type: TLDTypeCountryCode, CountryCode: `EG`},
+	`er`:          {Type: TLDTypeCountryCode, CountryCode: `ER`},
+	`es`:          {Type: TLDTypeCountryCode, CountryCode: `ES`},
+	`et`:          {Type: TLDTypeCountryCode, CountryCode: `ET`},
+	`eu`:          {Type: TLDTypeCountryCode, CountryCode: `EU`},
+	`fi`:          {Type: TLDTypeCountryCode, CountryCode: `FI`},
+	`fj`:          {Type: TLDTypeCountryCode, CountryCode: `FJ`},
+	`fk`:          {Type: TLDTypeCountryCode, CountryCode: `FK`},
+	`fm`:          {Type: TLDTypeCountryCode, CountryCode: `FM`},
+	`fo`:          {Type: TLDTypeCountryCode, CountryCode: `FO`},
+	`fr`:          {Type: TLDTypeCountryCode, CountryCode: `FR`},
+	`ga`:          {Type: TLDTypeCountryCode, CountryCode: `GA`},
+	`gb`:          {Type: TLDTypeCountryCode, CountryCode: `GB`},
+	`gd`:          {Type: TLDTypeCountryCode, CountryCode: `GD`},
+	`ge`:          {Type: TLDTypeCountryCode, CountryCode: `GE`},
+	`gf`:          {Type: TLDTypeCountryCode, CountryCode: `GF`},
+	`gg`:          {Type: TLDTypeCountryCode, CountryCode: `GG`},
+	`gh`:          {Type: TLDTypeCountryCode, CountryCode: `GH`},
+	`gi`:          {Type: TLDTypeCountryCode, CountryCode: `GI`},
+	`gl`:          {Type: TLDTypeCountryCode, CountryCode: `GL`},
+	`gm`:          {Type: TLDTypeCountryCode, CountryCode: `GM`},
+	`gn`:          {Type: TLDTypeCountryCode, CountryCode: `GN`},
+	`gov`:         {Type: TLDTypeSponsored, CountryCode: ``},
+	`gp`:          {Type: TLDTypeCountryCode, CountryCode: `GP`},
+	`gq`:          {Type: TLDTypeCountryCode, CountryCode: `GQ`},
+	`gr`:          {Type: TLDTypeCountryCode, CountryCode: `GR`},
+	`gs`:          {Type: TLDTypeCountryCode, CountryCode: `GS`},
+	`gt`:          {Type: TLDTypeCountryCode, CountryCode: `GT`},
+	`gu`:          {Type: TLDTypeCountryCode, CountryCode: `GU`},
+	`gw`:          {Type: TLDTypeCountryCode, CountryCode: `GW`},
+	`gy`:          {Type: TLDTypeCountryCode, CountryCode: `GY`},
+	`hk`:          {Type: TLDTypeCountryCode, CountryCode: `HK`},
+	`hm`:          {Type: TLDTypeCountryCode, CountryCode: `HM`},
+	`hn`:          {Type: TLDTypeCountryCode, CountryCode: `HN`},
+	`hr`:          {Type: TLDTypeCountryCode, CountryCode: `HR`},
+	`ht`:          {Type: TLDTypeCountryCode, CountryCode: `HT`},
+	`hu`:          {Type: TLDTypeCountryCode, CountryCode: `HU`},
+	`id`:          {Type: TLDTypeCountryCode, CountryCode: `ID`},
+	`ie`:          {Type: TLDTypeCountryCode, CountryCode: `IE`},
+	`il`:          {Type: TLDTypeCountryCode, CountryCode: `IL`},
+	`im`:          {Type: TLDTypeCountryCode, CountryCode: `IM`},
+	`in`:          {Type: TLDTypeCountryCode, CountryCode: `IN`},
+	`info`:        {Type: TLDTypeGeneric, CountryCode: ``},
+	`int`:         {Type: TLDTypeSponsored, CountryCode: ``},
+	`io`:          {Type: TLDTypeCountryCode, CountryCode: `IO`},
+	`iq`:          {Type: TLDTypeCountryCode, CountryCode: `IQ`},
+	`ir`:          {Type: TLDTypeCountryCode, CountryCode: `IR`},
+	`is`:          {Type: TLDTypeCountryCode, CountryCode: `IS`},
+	`it`:          {Type: TLDTypeCountryCode, CountryCode: `IT`},
+	`je`:          {Type: TLDTypeCountryCode, CountryCode: `JE`},
+	`jm`:          {Type: TLDTypeCountryCode, CountryCode: `JM`},
+	`jo`:          {Type: TLDTypeCountryCode, CountryCode: `JO`},
+	`jobs`:        {Type: TLDTypeSponsored, CountryCode: ``},
+	`jp`:          {Type: TLDTypeCountryCode, CountryCode: `JP`},
+	`ke`:          {Type: TLDTypeCountryCode, CountryCode: `KE`},
+	`kg`:          {Type: TLDTypeCountryCode, CountryCode: `KG`},
+	`kh`:          {Type: TLDTypeCountryCode, CountryCode: `KH`},
+	`ki`:          {Type: TLDTypeCountryCode, CountryCode: `KI`},
+	`km`:          {Type: TLDTypeCountryCode, CountryCode: `KM`},
+	`kn`:          {Type: TLDTypeCountryCode, CountryCode: `KN`},
+	`kp`:          {Type: TLDTypeCountryCode, CountryCode: `KP`},
+	`kr`:          {Type: TLDTypeCountryCode, CountryCode: `KR`},
+	`kw`:          {Type: TLDTypeCountryCode, CountryCode: `KW`},
+	`ky`:          {Type: TLDTypeCountryCode, CountryCode: `KY`},
+	`kz`:          {Type: TLDTypeCountryCode, CountryCode: `KZ`},
+	`la`:          {Type: TLDTypeCountryCode, CountryCode: `LA`},
+	`lb`:          {Type: TLDTypeCountryCode, CountryCode: `LB`},
+	`lc`:          {Type: TLDTypeCountryCode, CountryCode: `LC`},
+	`li`:          {Type: TLDTypeCountryCode, CountryCode: `LI`},
+	`lk`:          {Type: TLDTypeCountryCode, CountryCode: `LK`},
+	`lr`:          {Type: TLDTypeCountryCode, CountryCode: `LR`},
+	`ls`:          {Type: TLDTypeCountryCode, CountryCode: `LS`},
+	`lt`:          {Type: TLDTypeCountryCode, CountryCode: `LT`},
+	`lu`:          {Type: TLDTypeCountryCode, CountryCode: `LU`},
+	`lv`:          {Type: TLDTypeCountryCode, CountryCode: `LV`},
+	`ly`:          {Type: TLDTypeCountryCode, CountryCode: `LY`},
+	`ma`:          {Type: TLDTypeCountryCode, CountryCode: `MA`},
+	`mc`:          {Type: TLDTypeCountryCode, CountryCode: `MC`},
+	`md`:          {Type: TLDTypeCountryCode, CountryCode: `MD`},
+	`me`:          {Type: TLDTypeCountryCode, CountryCode: `ME`},
+	`mg`:          {Type: TLDTypeCountryCode, CountryCode: `MG`},
+	`mh`:          {Type: TLDTypeCountryCode, CountryCode: `MH`},
+	`mil`:         {Type: TLDTypeSponsored, CountryCode: ``},
+	`mk`:          {Type: TLDTypeCountryCode, CountryCode: `MK`},
+	`ml`:          {Type: TLDTypeCountryCode, CountryCode: `ML`},
+	`mm`:          {Type: TLDTypeCountryCode, CountryCode: `MM`},
+	`mn`:          {Type: TLDTypeCountryCode, CountryCode: `MN`},
+	`mo`:          {Type: TLDTypeCountryCode, CountryCode: `MO`},
+	`mobi`:        {Type: TLDTypeSponsored, CountryCode: ``},
+	`mp`:          {Type: TLDTypeCountryCode, CountryCode: `MP`},
+	`mq`:          {Type: TLDTypeCountryCode, CountryCode: `MQ`},
+	`mr`:          {Type: TLDTypeCountryCode, CountryCode: `MR`},
+	`ms`:          {Type: TLDTypeCountryCode, CountryCode: `MS`},
+	`mt`:          {Type: TLDTypeCountryCode, CountryCode: `MT`},
+	`mu`:          {Type: TLDTypeCountryCode, CountryCode: `MU`},
+	`museum`:      {Type: TLDTypeSponsored, CountryCode: ``},
+	`mv`:          {Type: TLDTypeCountryCode, CountryCode: `MV`},
+	`mw`:          {Type: TLDTypeCountryCode, CountryCode: `MW`},
+	`mx`:          {Type: TLDTypeCountryCode, CountryCode: `MX`},
+	`my`:          {Type: TLDTypeCountryCode, CountryCode: `MY`},
+	`mz`:          {Type: TLDTypeCountryCode, CountryCode: `MZ`},
+	`na`:          {Type: TLDTypeCountryCode, CountryCode: `NA`},
+	`name`:        {Type: TLDTypeGenericRestricted, CountryCode: ``},
+	`nc`:          {Type: TLDTypeCountryCode, CountryCode: `NC`},
+	`ne`:          {Type: TLDTypeCountryCode, CountryCode: `NE`},
+	`net`:         {Type: TLDTypeGeneric, CountryCode: ``},
+	`nf`:          {Type: TLDTypeCountryCode, CountryCode: `NF`},
+	`ng`:          {Type: TLDTypeCountryCode, CountryCode: `NG`},
+	`ni`:          {Type: TLDTypeCountryCode, CountryCode: `NI`},
+	`nl`:          {Type: TLDTypeCountryCode, CountryCode: `NL`},
+	`no`:          {Type: TLDTypeCountryCode, CountryCode: `NO`},
+	`np`:          {Type: TLDTypeCountryCode, CountryCode: `NP`},
+	`nr`:          {Type: TLDTypeCountryCode, CountryCode: `NR`},
+	`nu`:          {Type: TLDTypeCountryCode, CountryCode: `NU`},
+	`nz`:          {Type: TLDTypeCountryCode, CountryCode: `NZ`},
+	`om`:          {Type: TLDTypeCountryCode, CountryCode: `OM`},
+	`org`:         {Type: TLDTypeGeneric, CountryCode: ``},
+	`pa`:          {Type: TLDTypeCountryCode, CountryCode: `PA`},
+	`pe`:          {Type: TLDTypeCountryCode, CountryCode: `PE`},
+	`pf`:          {Type: TLDTypeCountryCode, CountryCode: `PF`},
+	`pg`:          {Type: TLDTypeCountryCode, CountryCode: `PG`},
+	`ph`:          {Type: TLDTypeCountryCode, CountryCode: `PH`},
+	`pk`:          {Type: TLDTypeCountryCode, CountryCode: `PK`},
+	`pl`:          {Type: TLDTypeCountryCode, CountryCode: `PL`},
+	`pm`:          {Type: TLDTypeCountryCode, CountryCode: `PM`},
+	`pn`:          {Type: TLDTypeCountryCode, CountryCode: `PN`},
+	`post`:        {Type: TLDTypeSponsored, CountryCode: ``},
+	`pr`:          {Type: TLDTypeCountryCode, CountryCode: `PR`},
+	`pro`:         {Type: TLDTypeGenericRestricted, CountryCode: ``},
+	`ps`:          {Type: TLDTypeCountryCode, CountryCode: `PS`},
+	`pt`:          {Type: TLDTypeCountryCode, CountryCode: `PT`},
+	`pw`:          {Type: TLDTypeCountryCode, CountryCode: `PW`},
+	`py`:          {Type: TLDTypeCountryCode, CountryCode: `PY`},
+	`qa`:          {Type: TLDTypeCountryCode, CountryCode: `QA`},
+	`re`:          {Type: TLDTypeCountryCode, CountryCode: `RE`},
+	`ro`:          {Type: TLDTypeCountryCode, CountryCode: `RO`},
+	`rs`:          {Type: TLDTypeCountryCode, CountryCode: `RS`},
+	`ru`:          {Type: TLDTypeCountryCode, CountryCode: `RU`},
+	`rw`:          {Type: TLDTypeCountryCode, CountryCode: `RW`},
+	`sa`:          {Type: TLDTypeCountryCode, CountryCode: `SA`},
+	`sb`:          {Type: TLDTypeCountryCode, CountryCode: `SB`},
+	`sc`:          {Type: TLDTypeCountryCode, CountryCode: `SC`},
+	`sd`:          {Type: TLDTypeCountryCode, CountryCode: `SD`},
+	`se`:          {Type: TLDTypeCountryCode, CountryCode: `SE`},
+	`sg`:          {Type: TLDTypeCountryCode, CountryCode: `SG`},
+	`sh`:          {Type: TLDTypeCountryCode, CountryCode: `SH`},
+	`si`:          {Type: TLDTypeCountryCode, CountryCode: `SI`},
+	`sj`:          {Type: TLDTypeCountryCode, CountryCode: `SJ`},
+	`sk`:          {Type: TLDTypeCountryCode, CountryCode: `SK`},
+	`sl`:          {Type: TLDTypeCountryCode, CountryCode: `SL`},
+	`sm`:          {Type: TLDTypeCountryCode, CountryCode: `SM`},
+	`sn`:          {Type: TLDTypeCountryCode, CountryCode: `SN`},
+	`so`:          {Type: TLDTypeCountryCode, CountryCode: `SO`},
+	`sr`:          {Type: TLDTypeCountryCode, CountryCode: `SR`},
+	`ss`:          {Type: TLDTypeCountryCode, CountryCode: `SS`},
+	`st`:          {Type: TLDTypeCountryCode, CountryCode: `ST`},
+	`su`:          {Type: TLDTypeCountryCode, CountryCode: `SU`},
+	`sv`:          {Type: TLDTypeCountryCode, CountryCode: `SV`},
+	`sx`:          {Type: TLDTypeCountryCode, CountryCode: `SX`},
+	`sy`:          {Type: TLDTypeCountryCode, CountryCode: `SY`},
+	`sz`:          {Type: TLDTypeCountryCode, CountryCode: `SZ`},
+	`tc`:          {Type: TLDTypeCountryCode, CountryCode: `TC`},
+	`td`:          {Type: TLDTypeCountryCode, CountryCode: `TD`},
+	`tel`:         {Type: TLDTypeSponsored, CountryCode: ``},
+	`tf`:          {Type: TLDTypeCountryCode, CountryCode: `TF`},
+	`tg`:          {Type: TLDTypeCountryCode, CountryCode: `TG`},
+	`th`:          {Type: TLDTypeCountryCode, CountryCode: `TH`},
+	`tj`:          {Type: TLDTypeCountryCode, CountryCode: `TJ`},
+	`tk`:          {Type: TLDTypeCountryCode, CountryCode: `TK`},
+	`tl`:          {Type: TLDTypeCountryCode, CountryCode: `TL`},
+	`tm`:          {Type: TLDTypeCountryCode, CountryCode: `TM`},
+	`tn`:          {Type: TLDTypeCountryCode, CountryCode: `TN`},
+	`to`:          {Type: TLDTypeCountryCode, CountryCode: `TO`},
+	`tr`:          {Type: TLDTypeCountryCode, CountryCode: `TR`},
+	`travel`:      {Type: TLDTypeSponsored, CountryCode: ``},
+	`tt`:          {Type: TLDTypeCountryCode, CountryCode: `TT`},
+	`tv`:          {Type: TLDTypeCountryCode, CountryCode: `TV`},
+	`tw`:          {Type: TLDTypeCountryCode, CountryCode: `TW`},
+	`tz`:          {Type: TLDTypeCountryCode, CountryCode: `TZ`},
+	`ua`:          {Type: TLDTypeCountryCode, CountryCode: `UA`},
+	`ug`:          {Type: TLDTypeCountryCode, CountryCode: `UG`},
+	`uk`:          {Type: TLDTypeCountryCode, CountryCode: `GB`},
+	`us`:          {Type: TLDTypeCountryCode, CountryCode: `US`},
+	`uy`:          {Type: TLDTypeCountryCode, CountryCode: `UY`},
+	`uz`:          {Type: TLDTypeCountryCode, CountryCode: `UZ`},
+	`va`:          {Type: TLDTypeCountryCode, CountryCode: `VA`},
+	`vc`:          {Type: TLDTypeCountryCode, CountryCode: `VC`},
+	`ve`:          {Type: TLDTypeCountryCode, CountryCode: `VE`},
+	`vg`:          {Type: TLDTypeCountryCode, CountryCode: `VG`},
+	`vi`:          {Type: TLDTypeCountryCode, CountryCode: `VI`},
+	`vn`:          {Type: TLDTypeCountryCode, CountryCode: `VN`},
+	`vu`:          {Type: TLDTypeCountryCode, CountryCode: `VU`},
+	`wf`:          {Type: TLDTypeCountryCode, CountryCode: `WF`},
+	`ws`:          {Type: TLDTypeCountryCode, CountryCode: `WS`},
+	`xxx`:         {Type: TLDTypeSponsored, CountryCode: ``},
+	`ye`:          {Type: TLDTypeCountryCode, CountryCode: `YE`},
+	`yt`:          {Type: TLDTypeCountryCode, CountryCode: `YT`},
+	`za`:          {Type: TLDTypeCountryCode, CountryCode: `ZA`},
+	`zm`:          {Type: TLDTypeCountryCode, CountryCode: `ZM`},
+	`zw`:          {Type: TLDTypeCountryCode, CountryCode: `ZW`},
+	`ελ`:          {Type: TLDTypeCountryCode, CountryCode: `GR`},
+	`ευ`:          {Type: TLDTypeCountryCode, CountryCode: `EU`},
+	`бг`:          {Type: TLDTypeCountryCode, CountryCode: `BG`},
+	`бел`:         {Type: TLDTypeCountryCode, CountryCode: `BY`},
+	`ею`:          {Type: TLDTypeCountryCode, CountryCode: `EU`},
+	`мкд`:         {Type: TLDTypeCountryCode, CountryCode: `MK`},
+	`мон`:         {Type: TLDTypeCountryCode, CountryCode: `MN`},
+	`рф`:          {Type: TLDTypeCountryCode, CountryCode: `RU`},
+	`срб`:         {Type: TLDTypeCountryCode, CountryCode: `RS`},
+	`укр`:         {Type: TLDTypeCountryCode, CountryCode: `UA`},
+	`қаз`:         {Type: TLDTypeCountryCode, CountryCode: `KZ`},
+	`հայ`:         {Type: TLDTypeCountryCode, CountryCode: `AM`},
+	`ישראל`:       {Type: TLDTypeCountryCode, CountryCode: `IL`},
+	`الاردن`:      {Type: TLDTypeCountryCode, CountryCode: `JO`},
+	`البحرين`:     {Type: TLDTypeCountryCode, CountryCode: `BH`},
+	`الجزائر`:     {Type: TLDTypeCountryCode, CountryCode: `DZ`},
+	`السعودية`:    {Type: TLDTypeCountryCode, CountryCode: `SA`},
+	`السعوديه`:    {Type: TLDTypeCountryCode, CountryCode: `SA`},
+	`السعودیة`:    {Type: TLDTypeCountryCode, CountryCode: `SA`},
+	`السعودیۃ`:    {Type: TLDTypeCountryCode, CountryCode: `SA`},
+	`المغرب`:      {Type: TLDTypeCountryCode, CountryCode: `MA`},
+	`اليمن`:       {Type: TLDTypeCountryCode, CountryCode: `YE`},
+	`امارات`:      {Type: TLDTypeCountryCode, CountryCode: `AE`},
+	`ايران`:       {Type: TLDTypeCountryCode, CountryCode: `IR`},
+	`ایران`:       {Type: TLDTypeCountryCode, CountryCode: `IR`},
+	`بارت`:        {Type: TLDTypeCountryCode, CountryCode: `IN`},
+	`بھارت`:       {Type: TLDTypeCountryCode, CountryCode: `IN`},
+	`تونس`:        {Type: TLDTypeCountryCode, CountryCode: `TN`},
+	`سودان`:       {Type: TLDTypeCountryCode, CountryCode: `SD`},
+	`سوريا`:       {Type: TLDTypeCountryCode, CountryCode: `SY`},
+	`سورية`:       {Type: TLDTypeCountryCode, CountryCode: `SY`},
+	`عراق`:        {Type: TLDTypeCountryCode, CountryCode: `IQ`},
+	`عمان`:        {Type: TLDTypeCountryCode, CountryCode: `OM`},
+	`فلسطين`:      {Type: TLDTypeCountryCode, CountryCode: `PS`},
+	`قطر`:         {Type: TLDTypeCountryCode, CountryCode: `QA`},
+	`مصر`:         {Type: TLDTypeCountryCode, CountryCode: `EG`},
+	`مليسيا`:      {Type: TLDTypeCountryCode, CountryCode: `MY`},
+	`موريتانيا`:   {Type: TLDTypeCountryCode, CountryCode: `MR`},
+	`پاكستان`:     {Type: TLDTypeCountryCode, CountryCode: `PK`},
+	`پاکستان`:     {Type: TLDTypeCountryCode, CountryCode: `PK`},
+	`ڀارت`:        {Type: TLDTypeCountryCode, CountryCode: `IN`},
+	`भारत`:        {Type: TLDTypeCountryCode, CountryCode: `IN`},
+	`भारतम्`:      {Type: TLDTypeCountryCode, CountryCode: `IN`},
+	`भारोत`:       {Type: TLDTypeCountryCode, CountryCode: `IN`},
+	`বাংলা`:       {Type: TLDTypeCountryCode, CountryCode: `BD`},
+	`ভারত`:        {Type: TLDTypeCountryCode, CountryCode: `IN`},
+	`ভাৰত`:        {Type: TLDTypeCountryCode, CountryCode: `IN`},
+	`ਭਾਰਤ`:        {Type: TLDTypeCountryCode, CountryCode: `IN`},
+	`ભારત`:        {Type: TLDTypeCountryCode, CountryCode: `IN`},
+	`ଭାରତ`:        {Type: TLDTypeCountryCode, CountryCode: `IN`},
+	`இந்தியா`:     {Type: TLDTypeCountryCode, CountryCode: `IN`},
+	`இலங்கை`:      {Type: TLDTypeCountryCode, CountryCode: `LK`},
+	`சிங்கப்பூர்`: {Type: TLDTypeCountryCode, CountryCode: `SG`},
+	`భారత్`:       {Type: TLDTypeCountryCode, CountryCode: `IN`},
+	`ಭಾರತ`:        {Type: TLDTypeCountryCode, CountryCode: `IN`},
+	`ഭാരതം`:       {Type: TLDTypeCountryCode, CountryCode: `IN`},
+	`ලංකා`:        {Type: TLDTypeCountryCode, CountryCode: `LK`},
+	`ไทย`:         {Type: TLDTypeCountryCode, CountryCode: `TH`},
+	`ລາວ`:         {Type: TLDTypeCountryCode, CountryCode: `LA`},
+	`გე`:          {Type: TLDTypeCountryCode, CountryCode: `GE`},
+	`中国`:          {Type: TLDTypeCountryCode, CountryCode: `CN`},
+	`中國`:          {Type: TLDTypeCountryCode, CountryCode: `CN`},
+	`台湾`:          {Type: TLDTypeCountryCode, CountryCode: `TW`},
+	`台灣`:          {Type: TLDTypeCountryCode, CountryCode: `TW`},
+	`新加坡`:         {Type: TLDTypeCountryCode, CountryCode: `SG`},
+	`澳門`:          {Type: TLDTypeCountryCode, CountryCode: `MO`},
+	`澳门`:          {Type: TLDTypeCountryCode, CountryCode: `MO`},
+	`臺灣`:          {Type: TLDTypeCountryCode, CountryCode: `TW`},
+	`香港`:          {Type: TLDTypeCountryCode, CountryCode: `HK`},
+	`한국`:          {Type: TLDTypeCountryCode, CountryCode: `KR`},
+}
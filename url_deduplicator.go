@@ -0,0 +1,268 @@
+package hqgourl
+
+import (
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// DeduplicationRules is a bit set of the rules by which a URLDeduplicator collapses URLs into
+// groups, e.g. of URLs of the same endpoint.
+type DeduplicationRules uint
+
+const (
+	// DeduplicateParameterValues collapses URLs that differ only in the values of their query
+	// parameters, e.g. "?id=3&x=1" and "?id=4&x=2".
+	DeduplicateParameterValues DeduplicationRules = 1 << iota
+	// DeduplicateParameterOrder collapses URLs that differ only in the order of their query
+	// parameters, e.g. "?id=3&x=1" and "?x=1&id=3".
+	DeduplicateParameterOrder
+	// DeduplicateNumericSegments collapses URLs that differ only in numeric path segments, e.g.
+	// "/item/1" and "/item/2".
+	DeduplicateNumericSegments
+	// DeduplicateUUIDSegments collapses URLs that differ only in UUID path segments, e.g.
+	// "/user/123e4567-e89b-12d3-a456-426614174000".
+	DeduplicateUUIDSegments
+	// DeduplicateHashSegments collapses URLs that differ only in hash path segments, hexadecimal
+	// MD5, SHA-1, SHA-256 or SHA-512 digests, e.g. "/file/d41d8cd98f00b204e9800998ecf8427e".
+	DeduplicateHashSegments
+	// DeduplicateStaticAssets drops the URLs of static assets, e.g. images, stylesheets and fonts,
+	// by the extension of their path, see URLDeduplicatorWithStaticExtensions.
+	DeduplicateStaticAssets
+)
+
+// DeduplicationDefault is the set of all the deduplication rules.
+const DeduplicationDefault = DeduplicateParameterValues | DeduplicateParameterOrder | DeduplicateNumericSegments |
+	DeduplicateUUIDSegments | DeduplicateHashSegments | DeduplicateStaticAssets
+
+// DefaultStaticExtensions is the list of the extensions, without their leading dot, of the static
+// assets dropped with DeduplicateStaticAssets, unless URLDeduplicatorWithStaticExtensions sets others.
+var DefaultStaticExtensions = []string{
+	"avi", "bmp", "css", "eot", "gif", "ico", "jpeg", "jpg", "mp3", "mp4", "otf", "pdf",
+	"png", "scss", "svg", "tif", "tiff", "ttf", "webp", "woff", "woff2",
+}
+
+// URLDeduplicator deduplicates streams of URLs semantically, as uro does: it collapses URLs into
+// groups by its DeduplicationRules, after normalizing them, and keeps the first URL of each group
+// as its representative. A URLDeduplicator is safe for concurrent use by multiple goroutines.
+type URLDeduplicator struct {
+	rules            DeduplicationRules
+	staticExtensions map[string]struct{}
+
+	up *URLParser     // URLParser used for parsing the URLs.
+	n  *URLNormalizer // URLNormalizer the URLs are normalized with, before grouping.
+
+	mutex sync.Mutex
+	seen  map[string]struct{}
+}
+
+// Add adds rawURL to the stream and reports whether it is the first URL of its group, i.e. whether
+// to keep it. URLs of static assets, if dropped, are never kept. URLs that cannot be parsed are
+// reported with the error of the URLParser.
+func (d *URLDeduplicator) Add(rawURL string) (keep bool, err error) {
+	parsedURL, err := d.up.Parse(rawURL)
+	if err != nil {
+		return
+	}
+
+	if d.rules&DeduplicateStaticAssets != 0 {
+		if _, ok := d.staticExtensions[strings.ToLower(strings.TrimPrefix(parsedURL.Extension, "."))]; ok {
+			return
+		}
+	}
+
+	key := d.key(parsedURL)
+
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	if _, ok := d.seen[key]; ok {
+		return
+	}
+
+	d.seen[key] = struct{}{}
+
+	return true, nil
+}
+
+// Len returns the number of groups, i.e. of URLs kept so far.
+func (d *URLDeduplicator) Len() (length int) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	return len(d.seen)
+}
+
+// Reset forgets the URLs added so far, to deduplicate another stream.
+func (d *URLDeduplicator) Reset() {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	d.seen = map[string]struct{}{}
+}
+
+// key returns the key of the group of parsedURL: its normalized form, with the path segments and
+// query parameters collapsed as per the DeduplicationRules.
+func (d *URLDeduplicator) key(parsedURL *URL) (key string) {
+	normalized := d.n.Normalize(parsedURL)
+
+	u, err := url.Parse(normalized)
+	if err != nil {
+		return normalized
+	}
+
+	segments := strings.Split(u.EscapedPath(), "/")
+
+	for i, segment := range segments {
+		switch {
+		case d.rules&DeduplicateNumericSegments != 0 && isNumericSegment(segment):
+			segments[i] = "{number}"
+		case d.rules&DeduplicateUUIDSegments != 0 && isUUIDSegment(segment):
+			segments[i] = "{uuid}"
+		case d.rules&DeduplicateHashSegments != 0 && isHashSegment(segment):
+			segments[i] = "{hash}"
+		}
+	}
+
+	query := ParseQuery(u.RawQuery)
+	parameters := make([]string, len(query))
+
+	for i, parameter := range query {
+		parameters[i] = parameter.String()
+
+		if d.rules&DeduplicateParameterValues != 0 {
+			parameters[i] = parameter.RawKey
+		}
+	}
+
+	if d.rules&DeduplicateParameterOrder != 0 {
+		sort.Strings(parameters)
+	}
+
+	u.RawPath, u.Path = "", ""
+	u.RawQuery, u.ForceQuery = "", false
+
+	return u.String() + strings.Join(segments, "/") + "?" + strings.Join(parameters, "&")
+}
+
+// URLDeduplicatorOptionsFunc defines a function type for configuring a URLDeduplicator.
+type URLDeduplicatorOptionsFunc func(*URLDeduplicator)
+
+// URLDeduplicatorInterface defines the interface for URL deduplication functionality.
+type URLDeduplicatorInterface interface {
+	Add(rawURL string) (keep bool, err error)
+	Len() (length int)
+	Reset()
+}
+
+var _ URLDeduplicatorInterface = &URLDeduplicator{}
+
+// NewURLDeduplicator creates a URLDeduplicator, of the DeduplicationDefault rules, normalizing
+// URLs with NormalizationUsuallySafe, unless options set others.
+func NewURLDeduplicator(opts ...URLDeduplicatorOptionsFunc) (d *URLDeduplicator) {
+	d = &URLDeduplicator{
+		rules: DeduplicationDefault,
+		seen:  map[string]struct{}{},
+	}
+
+	URLDeduplicatorWithStaticExtensions(DefaultStaticExtensions...)(d)
+
+	for _, opt := range opts {
+		opt(d)
+	}
+
+	if d.up == nil {
+		d.up = NewURLParser()
+	}
+
+	if d.n == nil {
+		d.n = NewURLNormalizer(URLNormalizerWithFlags(NormalizationUsuallySafe))
+	}
+
+	return
+}
+
+// URLDeduplicatorWithRules sets the deduplication rules, e.g. DeduplicationDefault &^
+// DeduplicateStaticAssets to keep static assets.
+func URLDeduplicatorWithRules(rules DeduplicationRules) URLDeduplicatorOptionsFunc {
+	return func(d *URLDeduplicator) {
+		d.rules = rules
+	}
+}
+
+// URLDeduplicatorWithStaticExtensions sets the extensions, without their leading dot, of the
+// static assets dropped with DeduplicateStaticAssets, instead of DefaultStaticExtensions.
+func URLDeduplicatorWithStaticExtensions(extensions ...string) URLDeduplicatorOptionsFunc {
+	return func(d *URLDeduplicator) {
+		d.staticExtensions = map[string]struct{}{}
+
+		for _, extension := range extensions {
+			d.staticExtensions[strings.ToLower(strings.TrimPrefix(extension, "."))] = struct{}{}
+		}
+	}
+}
+
+// URLDeduplicatorWithURLParser sets the URLParser URLs are parsed with, e.g. to set a default scheme.
+func URLDeduplicatorWithURLParser(up *URLParser) URLDeduplicatorOptionsFunc {
+	return func(d *URLDeduplicator) {
+		d.up = up
+	}
+}
+
+// URLDeduplicatorWithURLNormalizer sets the URLNormalizer URLs are normalized with before grouping.
+func URLDeduplicatorWithURLNormalizer(n *URLNormalizer) URLDeduplicatorOptionsFunc {
+	return func(d *URLDeduplicator) {
+		d.n = n
+	}
+}
+
+// isNumericSegment tells whether segment is a number, e.g. "42".
+func isNumericSegment(segment string) (ok bool) {
+	for i := 0; i < len(segment); i++ {
+		if segment[i] < '0' || segment[i] > '9' {
+			return false
+		}
+	}
+
+	return segment != ""
+}
+
+// isUUIDSegment tells whether segment is a UUID, e.g. "123e4567-e89b-12d3-a456-426614174000".
+func isUUIDSegment(segment string) (ok bool) {
+	if len(segment) != 36 {
+		return
+	}
+
+	for i := 0; i < len(segment); i++ {
+		switch i {
+		case 8, 13, 18, 23:
+			if segment[i] != '-' {
+				return false
+			}
+		default:
+			if !isHex(segment[i]) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// isHashSegment tells whether segment is a hexadecimal MD5, SHA-1, SHA-256 or SHA-512 digest.
+func isHashSegment(segment string) (ok bool) {
+	switch len(segment) {
+	case 32, 40, 64, 128:
+	default:
+		return
+	}
+
+	for i := 0; i < len(segment); i++ {
+		if !isHex(segment[i]) {
+			return false
+		}
+	}
+
+	return true
+}
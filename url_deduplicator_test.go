@@ -0,0 +1,217 @@
+package hqgourl_test
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/hueristiq/hqgourl"
+)
+
+func TestURLDeduplicator_Add(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name         string
+		rules        hqgourl.DeduplicationRules
+		rawURLs      []string
+		expectedKept []string
+	}{
+		{
+			"ParameterValues",
+			hqgourl.DeduplicateParameterValues,
+			[]string{
+				"https://example.com/page?id=3&x=1",
+				"https://example.com/page?id=4&x=2",
+				"https://example.com/page?id=4",
+				"https://example.com/page?x=2&id=4",
+			},
+			[]string{
+				"https://example.com/page?id=3&x=1",
+				"https://example.com/page?id=4",
+				"https://example.com/page?x=2&id=4",
+			},
+		},
+		{
+			"ParameterOrder",
+			hqgourl.DeduplicateParameterOrder,
+			[]string{
+				"https://example.com/page?id=3&x=1",
+				"https://example.com/page?x=1&id=3",
+				"https://example.com/page?x=2&id=3",
+			},
+			[]string{
+				"https://example.com/page?id=3&x=1",
+				"https://example.com/page?x=2&id=3",
+			},
+		},
+		{
+			"PathSegments",
+			hqgourl.DeduplicateNumericSegments | hqgourl.DeduplicateUUIDSegments | hqgourl.DeduplicateHashSegments,
+			[]string{
+				"https://example.com/item/1/edit",
+				"https://example.com/item/42/edit",
+				"https://example.com/item/v1/edit",
+				"https://example.com/user/123e4567-e89b-12d3-a456-426614174000",
+				"https://example.com/user/00000000-0000-0000-0000-000000000000",
+				"https://example.com/file/d41d8cd98f00b204e9800998ecf8427e",
+				"https://example.com/file/da39a3ee5e6b4b0d3255bfef95601890afd80709",
+				"https://example.com/file/d41d8cd98f00b204",
+			},
+			[]string{
+				"https://example.com/item/1/edit",
+				"https://example.com/item/v1/edit",
+				"https://example.com/user/123e4567-e89b-12d3-a456-426614174000",
+				"https://example.com/file/d41d8cd98f00b204e9800998ecf8427e",
+				"https://example.com/file/d41d8cd98f00b204",
+			},
+		},
+		{
+			"StaticAssets",
+			hqgourl.DeduplicateStaticAssets,
+			[]string{
+				"https://example.com/logo.png",
+				"https://example.com/style.CSS?v=2",
+				"https://example.com/app.js",
+				"https://example.com/index.html",
+			},
+			[]string{
+				"https://example.com/app.js",
+				"https://example.com/index.html",
+			},
+		},
+		{
+			"Normalization",
+			0,
+			[]string{
+				"HTTPS://Example.COM:443/a/./b",
+				"https://example.com/a/b",
+				"https://example.com/a/b#top",
+				"http://example.com/a/b",
+			},
+			[]string{
+				"HTTPS://Example.COM:443/a/./b",
+				"http://example.com/a/b",
+			},
+		},
+		{
+			"Default",
+			hqgourl.DeduplicationDefault,
+			[]string{
+				"https://example.com/post/1?utm_source=a&ref=b",
+				"https://example.com/post/2?ref=c&utm_source=d",
+				"https://example.com/post/2/comments",
+				"https://example.com/img/2.jpg",
+				"https://example.org/post/1?utm_source=a&ref=b",
+			},
+			[]string{
+				"https://example.com/post/1?utm_source=a&ref=b",
+				"https://example.com/post/2/comments",
+				"https://example.org/post/1?utm_source=a&ref=b",
+			},
+		},
+	}
+
+	for _, c := range cases {
+		c := c
+
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			d := hqgourl.NewURLDeduplicator(hqgourl.URLDeduplicatorWithRules(c.rules))
+
+			var kept []string
+
+			for _, rawURL := range c.rawURLs {
+				keep, err := d.Add(rawURL)
+				if err != nil {
+					t.Fatalf("Add(%q) error = %v", rawURL, err)
+				}
+
+				if keep {
+					kept = append(kept, rawURL)
+				}
+			}
+
+			if !reflect.DeepEqual(kept, c.expectedKept) {
+				t.Errorf("kept = %q, want %q", kept, c.expectedKept)
+			}
+
+			if d.Len() != len(c.expectedKept) {
+				t.Errorf("Len() = %d, want %d", d.Len(), len(c.expectedKept))
+			}
+		})
+	}
+}
+
+func TestURLDeduplicator_Options(t *testing.T) {
+	t.Parallel()
+
+	d := hqgourl.NewURLDeduplicator(hqgourl.URLDeduplicatorWithStaticExtensions(".js"))
+
+	for rawURL, expectedKeep := range map[string]bool{
+		"https://example.com/app.js":   false,
+		"https://example.com/logo.png": true,
+	} {
+		keep, err := d.Add(rawURL)
+		if err != nil {
+			t.Fatalf("Add(%q) error = %v", rawURL, err)
+		}
+
+		if keep != expectedKeep {
+			t.Errorf("Add(%q) = %v, want %v", rawURL, keep, expectedKeep)
+		}
+	}
+
+	d.Reset()
+
+	if keep, _ := d.Add("https://example.com/logo.png"); !keep || d.Len() != 1 {
+		t.Errorf("Add() after Reset() = %v, Len() = %d, want true, 1", keep, d.Len())
+	}
+
+	if _, err := d.Add("http://example.com:99999/"); err == nil {
+		t.Errorf("Add() error = nil, want an error")
+	}
+}
+
+func TestURLDeduplicatorConcurrency(t *testing.T) {
+	t.Parallel()
+
+	d := hqgourl.NewURLDeduplicator()
+
+	var (
+		wg    sync.WaitGroup
+		mutex sync.Mutex
+		kept  int
+	)
+
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for j := 0; j < 100; j++ {
+				keep, err := d.Add(fmt.Sprintf("https://example.com/item/%d?page=%d", j, j))
+				if err != nil {
+					t.Errorf("Add() error = %v", err)
+
+					return
+				}
+
+				if keep {
+					mutex.Lock()
+					kept++
+					mutex.Unlock()
+				}
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	if kept != 1 {
+		t.Errorf("kept = %d, want 1", kept)
+	}
+}
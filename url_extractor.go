@@ -12,12 +12,16 @@ import (
 
 // URLExtractor is a struct that configures the URL extraction process.
 // It allows specifying whether to include URL schemes and hosts in the extraction and supports
-// custom regex patterns for these components.
+// custom regex patterns for these components. A URLExtractor is read-only once created, so it is
+// safe for concurrent use by multiple goroutines, as are the *regexp.Regexp it compiles.
 type URLExtractor struct {
 	withScheme        bool   // Indicates if the scheme part is mandatory in the URLs to be extracted.
 	withSchemePattern string // Custom regex pattern for matching URL schemes, if provided.
 	withHost          bool   // Indicates if the host part is mandatory in the URLs to be extracted.
 	withHostPattern   string // Custom regex pattern for matching URL hosts, if provided.
+
+	withAdditionalTLDs []string // TLDs to match in addition to the default ones.
+	withoutTLDs        []string // Default TLDs not to match.
 }
 
 // CompileRegex compiles a regex pattern based on the URLExtractor configuration.
@@ -33,17 +37,16 @@ func (e *URLExtractor) CompileRegex() (regex *regexp.Regexp) {
 
 	var asciiTLDs, unicodeTLDs []string
 
-	for i, tld := range tlds.TLDs {
+	for _, tld := range e.TLDs() {
 		if tld[0] >= utf8.RuneSelf {
-			asciiTLDs = tlds.TLDs[:i:i]
-			unicodeTLDs = tlds.TLDs[i:]
-
-			break
+			unicodeTLDs = append(unicodeTLDs, tld)
+		} else {
+			asciiTLDs = append(asciiTLDs, tld)
 		}
 	}
 
 	punycode := `xn--[a-z0-9-]+`
-	knownTLDPattern := `(?:(?i)` + punycode + `|` + anyOf(append(asciiTLDs, tlds.PseudoTLDs...)...) + `\b|` + anyOf(unicodeTLDs...) + `)`
+	knownTLDPattern := `(?:(?i)` + punycode + `|` + anyOf(asciiTLDs...) + `\b|` + anyOf(unicodeTLDs...) + `)`
 	domainPattern := `(?:` + _subdomainPattern + knownTLDPattern + `|localhost)`
 
 	hostWithoutPortPattern := `(?:` + domainPattern + `|\[` + URLExtractorIPv6Pattern + `\]|\b` + URLExtractorIPv4Pattern + `\b)`
@@ -90,6 +93,76 @@ func (e *URLExtractor) CompileRegex() (regex *regexp.Regexp) {
 	return
 }
 
+// TLDs returns the TLDs the URLExtractor matches domains with: the standard and pseudo-TLDs, plus
+// the additional TLDs, minus the removed ones. TLDs are rules, normalized and removed as the
+// DomainParser does, so that extraction agrees with parsing: "COM" and "xn--55qx5d.cn" remove "com"
+// and "公司.cn", and "*.ck" removes the wildcard rule of "ck". Wildcard rules ("*.<TLD>") are matched
+// through their TLD, the wildcard label being matched as any subdomain, and exception rules
+// ("!<domain>") are ignored, their parent TLD already being matched.
+func (e *URLExtractor) TLDs() (TLDs []string) {
+	rules := map[string]suffixRule{}
+
+	TLDs = []string{}
+
+	add := func(rule string) {
+		suffix, kind := splitPublicSuffixRule(rule)
+
+		suffix = normalizeSuffix(suffix)
+
+		if _, ok := rules[suffix]; !ok {
+			TLDs = append(TLDs, suffix)
+		}
+
+		rules[suffix] |= kind
+	}
+
+	for _, TLD := range tlds.TLDs {
+		add(TLD)
+	}
+
+	for _, TLD := range tlds.WildcardTLDs {
+		add("*." + TLD)
+	}
+
+	for _, TLD := range tlds.PseudoTLDs {
+		add(TLD)
+	}
+
+	for _, rule := range e.withAdditionalTLDs {
+		add(rule)
+	}
+
+	for _, rule := range e.withoutTLDs {
+		suffix, kind := splitPublicSuffixRule(rule)
+
+		suffix = normalizeSuffix(suffix)
+
+		rules[suffix] &^= kind
+	}
+
+	matched := TLDs[:0]
+
+	for _, TLD := range TLDs {
+		if rules[TLD]&(suffixRuleNormal|suffixRuleWildcard) != 0 {
+			matched = append(matched, TLD)
+		}
+	}
+
+	return matched
+}
+
+// normalizeSuffix returns suffix with its labels in the form of the DomainParser's suffix trie,
+// lowercased and in their Unicode form.
+func normalizeSuffix(suffix string) (normalized string) {
+	labels := strings.Split(suffix, ".")
+
+	for i, label := range labels {
+		labels[i] = toUnicodeLabel(label)
+	}
+
+	return strings.Join(labels, ".")
+}
+
 // URLExtractorOptionsFunc defines a function type for configuring URLExtractor instances.
 // This approach allows for flexible and fluent configuration of the extractor.
 type URLExtractorOptionsFunc func(*URLExtractor)
@@ -97,6 +170,7 @@ type URLExtractorOptionsFunc func(*URLExtractor)
 // URLExtractorInterface defines the interface for URLExtractor, ensuring it implements certain methods.
 type URLExtractorInterface interface {
 	CompileRegex() (regex *regexp.Regexp)
+	TLDs() (TLDs []string)
 }
 
 const (
@@ -234,6 +308,24 @@ func URLExtractorWithHostPattern(pattern string) URLExtractorOptionsFunc {
 	}
 }
 
+// URLExtractorWithAdditionalTLDs returns an option function to match domains with TLDs in addition
+// to the default ones, e.g. an internal suffix like "corp.internal". It is the extraction counterpart
+// of DomainParserWithAdditionalTLDs, pass both the same TLDs for extraction to agree with parsing.
+func URLExtractorWithAdditionalTLDs(TLDs ...string) URLExtractorOptionsFunc {
+	return func(e *URLExtractor) {
+		e.withAdditionalTLDs = append(e.withAdditionalTLDs, TLDs...)
+	}
+}
+
+// URLExtractorWithoutTLDs returns an option function not to match domains with some of the default
+// TLDs, e.g. tlds.PseudoTLDs. It is the extraction counterpart of DomainParserWithoutTLDs, pass both
+// the same TLDs for extraction to agree with parsing.
+func URLExtractorWithoutTLDs(TLDs ...string) URLExtractorOptionsFunc {
+	return func(e *URLExtractor) {
+		e.withoutTLDs = append(e.withoutTLDs, TLDs...)
+	}
+}
+
 // anyOf is a helper function that constructs a regex pattern for a set of strings.
 // It simplifies the creation of regex patterns by automatically escaping and joining the provided strings.
 func anyOf(strs ...string) string {
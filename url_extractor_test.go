@@ -1,9 +1,11 @@
 package hqgourl_test
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/hueristiq/hqgourl"
+	"github.com/hueristiq/hqgourl/tlds"
 )
 
 func TestNewExtractor(t *testing.T) {
@@ -651,3 +653,102 @@ func equalSlices(a, b []string) bool {
 
 	return true
 }
+
+func TestURLExtractionWithAdditionalAndRemovedTLDs(t *testing.T) {
+	t.Parallel()
+
+	additionalTLDs := []string{"corp.internal"}
+	removedTLDs := tlds.PseudoTLDs
+
+	extractor := hqgourl.NewURLExtractor(
+		hqgourl.URLExtractorWithHost(),
+		hqgourl.URLExtractorWithAdditionalTLDs(additionalTLDs...),
+		hqgourl.URLExtractorWithoutTLDs(removedTLDs...),
+	)
+
+	regex := extractor.CompileRegex()
+
+	text := `
+	https://www.example.corp.internal/path
+	www.example.corp.internal
+	printer.local
+	www.example.test
+	www.example.com
+	`
+
+	want := []string{
+		"https://www.example.corp.internal/path",
+		"www.example.corp.internal",
+		"www.example.com",
+	}
+
+	got := regex.FindAllString(text, -1)
+
+	if !equalSlices(got, want) {
+		t.Errorf("Extracted URLs = %v, want %v", got, want)
+	}
+
+	dp := hqgourl.NewDomainParser(
+		hqgourl.DomainParserWithAdditionalTLDs(additionalTLDs...),
+		hqgourl.DomainParserWithoutTLDs(removedTLDs...),
+	)
+
+	if parsedDomain, _ := dp.Parse("www.example.corp.internal"); parsedDomain.TopLevel != "corp.internal" {
+		t.Errorf("Parse(%q).TopLevel = %q, want %q", "www.example.corp.internal", parsedDomain.TopLevel, "corp.internal")
+	}
+}
+
+func TestURLExtractor_TLDsAgreeWithDomainParser(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		removedTLDs      []string
+		TLD              string
+		expectedMatched  bool
+		domain           string
+		expectedTopLevel string
+	}{
+		{[]string{"COM"}, "com", false, "www.example.com", ""},
+		{[]string{"xn--55qx5d.cn"}, "公司.cn", false, "www.example.公司.cn", "cn"},
+		{[]string{"公司.CN"}, "公司.cn", false, "www.example.xn--55qx5d.cn", "cn"},
+		{[]string{"*.ck"}, "ck", true, "www.example.ck", "ck"},
+		{[]string{"ck", "*.ck"}, "ck", false, "www.example.ck", ""},
+		{[]string{"!www.ck"}, "ck", true, "example.www.ck", "www.ck"},
+	}
+
+	for _, c := range cases {
+		c := c
+
+		t.Run(fmt.Sprintf("Without(%q)", c.removedTLDs), func(t *testing.T) {
+			t.Parallel()
+
+			extractor := hqgourl.NewURLExtractor(hqgourl.URLExtractorWithoutTLDs(c.removedTLDs...))
+
+			matched := false
+
+			for _, TLD := range extractor.TLDs() {
+				if TLD == c.TLD {
+					matched = true
+				}
+			}
+
+			if matched != c.expectedMatched {
+				t.Errorf("TLDs() has %q = %v, want %v", c.TLD, matched, c.expectedMatched)
+			}
+
+			dp := hqgourl.NewDomainParser(
+				hqgourl.DomainParserWithoutTLDs(c.removedTLDs...),
+				hqgourl.DomainParserWithUnknownTLDPolicy(hqgourl.UnknownTLDPolicyRoot),
+			)
+
+			parsedDomain, err := dp.Parse(c.domain)
+			if err != nil {
+				t.Fatalf("Parse(%q) error = %v", c.domain, err)
+			}
+
+			if parsedDomain.TopLevel != c.expectedTopLevel {
+				t.Errorf("Parse(%q).TopLevel = %q, want %q", c.domain, parsedDomain.TopLevel, c.expectedTopLevel)
+			}
+		})
+	}
+}
@@ -0,0 +1,383 @@
+package hqgourl
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hueristiq/hqgourl/schemes"
+)
+
+// NormalizationFlags is a bit set of URL normalization steps, as per RFC 3986 section 6, each
+// selectable individually. The NormalizationSafe, NormalizationUsuallySafe and NormalizationUnsafe
+// presets group them by how safe they are, i.e. whether the normalized URL is sure to identify the
+// same resource.
+type NormalizationFlags uint
+
+const (
+	// NormalizeLowercaseScheme lowercases the scheme, e.g. "HTTP://example.com" to "http://example.com".
+	NormalizeLowercaseScheme NormalizationFlags = 1 << iota
+	// NormalizeLowercaseHost lowercases the host, e.g. "http://Example.COM" to "http://example.com".
+	NormalizeLowercaseHost
+	// NormalizeUppercasePercentEncoding uppercases the hexadecimal digits of percent-encodings,
+	// e.g. "/a%3a" to "/a%3A".
+	NormalizeUppercasePercentEncoding
+	// NormalizeDecodeUnreservedPercentEncoding decodes the percent-encodings of unreserved characters,
+	// letters, digits, "-", ".", "_" and "~", e.g. "/%7Euser" to "/~user".
+	NormalizeDecodeUnreservedPercentEncoding
+	// NormalizeRemoveDefaultPort removes the port if it is the default port of the scheme, e.g.
+	// "http://example.com:80" to "http://example.com".
+	NormalizeRemoveDefaultPort
+	// NormalizeRemoveDotSegments removes the "." and ".." segments of the path, e.g. "/a/./b/../c"
+	// to "/a/c".
+	NormalizeRemoveDotSegments
+	// NormalizeAddRootPath adds the root path to URLs with a host and an empty path, e.g.
+	// "http://example.com" to "http://example.com/".
+	NormalizeAddRootPath
+	// NormalizeRemoveEmptyQuery removes an empty query, e.g. "http://example.com/?" to
+	// "http://example.com/".
+	NormalizeRemoveEmptyQuery
+	// NormalizeRemoveFragment removes the fragment, e.g. "http://example.com/#top" to
+	// "http://example.com/".
+	NormalizeRemoveFragment
+	// NormalizeHostToASCII converts internationalized hosts to their ASCII form, e.g.
+	// "http://例子.公司.cn" to "http://xn--fsqu00a.xn--55qx5d.cn".
+	NormalizeHostToASCII
+	// NormalizeHostToUnicode converts internationalized hosts to their Unicode form, e.g.
+	// "http://xn--fsqu00a.xn--55qx5d.cn" to "http://例子.公司.cn". It is ignored with NormalizeHostToASCII.
+	NormalizeHostToUnicode
+	// NormalizeRemoveDuplicateSlashes collapses the repeated slashes of the path, e.g. "/a//b" to "/a/b".
+	NormalizeRemoveDuplicateSlashes
+	// NormalizeSortQuery sorts the query parameters by key, keeping the order of parameters of the
+	// same key, e.g. "?b=1&a=2" to "?a=2&b=1".
+	NormalizeSortQuery
+)
+
+const (
+	// NormalizationSafe is the preset of the normalizations that never change the semantics of a URL.
+	NormalizationSafe = NormalizeLowercaseScheme | NormalizeLowercaseHost | NormalizeUppercasePercentEncoding |
+		NormalizeDecodeUnreservedPercentEncoding | NormalizeRemoveDefaultPort
+	// NormalizationUsuallySafe is the preset of NormalizationSafe, plus the normalizations that
+	// change the semantics of a URL for few servers, if any.
+	NormalizationUsuallySafe = NormalizationSafe | NormalizeRemoveDotSegments | NormalizeAddRootPath |
+		NormalizeRemoveEmptyQuery | NormalizeRemoveFragment | NormalizeHostToASCII
+	// NormalizationUnsafe is the preset of NormalizationUsuallySafe, plus the normalizations that
+	// may change the semantics of a URL, but rarely do in practice.
+	NormalizationUnsafe = NormalizationUsuallySafe | NormalizeRemoveDuplicateSlashes | NormalizeSortQuery
+)
+
+// URLNormalizer normalizes URLs, e.g. to compare or deduplicate them, applying the steps of its
+// NormalizationFlags. A URLNormalizer is read-only once created, so it is safe for concurrent use
+// by multiple goroutines.
+type URLNormalizer struct {
+	flags NormalizationFlags
+
+	up      *URLParser        // URLParser used for parsing the URLs to normalize.
+	schemes *schemes.Registry // Registry of the schemes, for their default ports.
+}
+
+// Normalize returns the normalized form of parsedURL. parsedURL is not modified.
+func (n *URLNormalizer) Normalize(parsedURL *URL) (normalized string) {
+	var b strings.Builder
+
+	scheme := parsedURL.Scheme
+
+	if n.flags&NormalizeLowercaseScheme != 0 {
+		scheme = strings.ToLower(scheme)
+	}
+
+	if scheme != "" {
+		b.WriteString(scheme)
+		b.WriteByte(':')
+	}
+
+	if parsedURL.Opaque != "" {
+		b.WriteString(n.normalizePercentEncoding(parsedURL.Opaque))
+	} else {
+		// As net/url, an authority, possibly empty, e.g. "file:///path", unless parsed without one.
+		hasAuthority := parsedURL.Host != "" || parsedURL.User != nil ||
+			(scheme != "" && parsedURL.Path != "" && !parsedURL.OmitHost)
+
+		if hasAuthority {
+			b.WriteString("//")
+
+			if parsedURL.User != nil {
+				b.WriteString(parsedURL.User.String())
+				b.WriteByte('@')
+			}
+
+			b.WriteString(n.normalizeHost(parsedURL))
+
+			if parsedURL.PortExplicit && !n.isDefaultPort(scheme, parsedURL.Port) {
+				b.WriteByte(':')
+				b.WriteString(strconv.Itoa(parsedURL.Port))
+			}
+		}
+
+		b.WriteString(n.normalizePath(parsedURL.EscapedPath(), parsedURL.Host != ""))
+	}
+
+	query := n.normalizeQuery(parsedURL.RawQuery)
+
+	if query != "" || (parsedURL.ForceQuery && n.flags&NormalizeRemoveEmptyQuery == 0) {
+		b.WriteByte('?')
+		b.WriteString(query)
+	}
+
+	if parsedURL.Fragment != "" && n.flags&NormalizeRemoveFragment == 0 {
+		b.WriteByte('#')
+		b.WriteString(n.normalizePercentEncoding(parsedURL.EscapedFragment()))
+	}
+
+	return b.String()
+}
+
+// NormalizeString parses rawURL and returns its normalized form.
+func (n *URLNormalizer) NormalizeString(rawURL string) (normalized string, err error) {
+	parsedURL, err := n.up.Parse(rawURL)
+	if err != nil {
+		return
+	}
+
+	return n.Normalize(parsedURL), nil
+}
+
+// normalizeHost returns the host of parsedURL, without its port, normalized.
+func (n *URLNormalizer) normalizeHost(parsedURL *URL) (host string) {
+	host = parsedURL.Host
+
+	switch {
+	case parsedURL.HostKind != HostKindDomain && parsedURL.HostKind != HostKindSingleLabel:
+	case n.flags&NormalizeHostToASCII != 0:
+		if ASCIIHost, err := idnaProfile.ToASCII(host); err == nil {
+			host = ASCIIHost
+		}
+	case n.flags&NormalizeHostToUnicode != 0:
+		if unicodeHost, err := idnaProfile.ToUnicode(host); err == nil {
+			host = unicodeHost
+		}
+	}
+
+	zone := ""
+
+	// The zone of an IPv6 address is case-sensitive, and its "%" percent-encoded in URLs.
+	if i := strings.IndexByte(host, '%'); i != -1 && parsedURL.HostKind == HostKindIPv6 {
+		host, zone = host[:i], "%25"+host[i+1:]
+	}
+
+	if n.flags&NormalizeLowercaseHost != 0 {
+		host = strings.ToLower(host)
+	}
+
+	return host + zone
+}
+
+// isDefaultPort tells whether port is to be removed as the default port of scheme.
+func (n *URLNormalizer) isDefaultPort(scheme string, port int) (ok bool) {
+	if n.flags&NormalizeRemoveDefaultPort == 0 {
+		return
+	}
+
+	registered, ok := n.schemes.Lookup(scheme)
+
+	return ok && registered.DefaultPort != 0 && registered.DefaultPort == port
+}
+
+// normalizePath returns the escaped path normalized.
+func (n *URLNormalizer) normalizePath(path string, hasHost bool) (normalized string) {
+	normalized = n.normalizePercentEncoding(path)
+
+	if n.flags&NormalizeRemoveDuplicateSlashes != 0 {
+		for strings.Contains(normalized, "//") {
+			normalized = strings.ReplaceAll(normalized, "//", "/")
+		}
+	}
+
+	if n.flags&NormalizeRemoveDotSegments != 0 {
+		normalized = removeDotSegments(normalized)
+	}
+
+	if n.flags&NormalizeAddRootPath != 0 && hasHost && normalized == "" {
+		normalized = "/"
+	}
+
+	return
+}
+
+// normalizeQuery returns the raw query normalized.
+func (n *URLNormalizer) normalizeQuery(query string) (normalized string) {
+	normalized = n.normalizePercentEncoding(query)
+
+	if n.flags&NormalizeSortQuery != 0 && normalized != "" {
+		parameters := strings.Split(normalized, "&")
+
+		sort.SliceStable(parameters, func(i, j int) bool {
+			keyI, _, _ := strings.Cut(parameters[i], "=")
+			keyJ, _, _ := strings.Cut(parameters[j], "=")
+
+			return keyI < keyJ
+		})
+
+		normalized = strings.Join(parameters, "&")
+	}
+
+	return
+}
+
+// normalizePercentEncoding returns s with its percent-encodings uppercased and those of unreserved
+// characters decoded, as per the NormalizationFlags. Invalid percent-encodings are kept as they are.
+func (n *URLNormalizer) normalizePercentEncoding(s string) (normalized string) {
+	if n.flags&(NormalizeUppercasePercentEncoding|NormalizeDecodeUnreservedPercentEncoding) == 0 ||
+		!strings.Contains(s, "%") {
+		return s
+	}
+
+	var b strings.Builder
+
+	for i := 0; i < len(s); i++ {
+		if s[i] != '%' || i+2 >= len(s) || !isHex(s[i+1]) || !isHex(s[i+2]) {
+			b.WriteByte(s[i])
+
+			continue
+		}
+
+		c := unhex(s[i+1])<<4 | unhex(s[i+2])
+
+		switch {
+		case n.flags&NormalizeDecodeUnreservedPercentEncoding != 0 && isUnreserved(c):
+			b.WriteByte(c)
+		case n.flags&NormalizeUppercasePercentEncoding != 0:
+			b.WriteString(strings.ToUpper(s[i : i+3]))
+		default:
+			b.WriteString(s[i : i+3])
+		}
+
+		i += 2
+	}
+
+	return b.String()
+}
+
+// URLNormalizerOptionsFunc defines a function type for configuring a URLNormalizer.
+type URLNormalizerOptionsFunc func(*URLNormalizer)
+
+// URLNormalizerInterface defines the interface for URL normalization functionality.
+type URLNormalizerInterface interface {
+	Normalize(parsedURL *URL) (normalized string)
+	NormalizeString(rawURL string) (normalized string, err error)
+}
+
+var _ URLNormalizerInterface = &URLNormalizer{}
+
+// NewURLNormalizer creates a URLNormalizer, of the NormalizationSafe normalizations unless
+// URLNormalizerWithFlags sets others.
+func NewURLNormalizer(opts ...URLNormalizerOptionsFunc) (n *URLNormalizer) {
+	n = &URLNormalizer{
+		flags:   NormalizationSafe,
+		schemes: schemes.DefaultRegistry,
+	}
+
+	for _, opt := range opts {
+		opt(n)
+	}
+
+	if n.up == nil {
+		n.up = NewURLParser(URLParserWithSchemeRegistry(n.schemes))
+	}
+
+	return
+}
+
+// URLNormalizerWithFlags sets the normalizations to apply, e.g. NormalizationUsuallySafe, or
+// NormalizationSafe | NormalizeRemoveFragment.
+func URLNormalizerWithFlags(flags NormalizationFlags) URLNormalizerOptionsFunc {
+	return func(n *URLNormalizer) {
+		n.flags = flags
+	}
+}
+
+// URLNormalizerWithURLParser sets the URLParser NormalizeString parses URLs with, e.g. to set a
+// default scheme.
+func URLNormalizerWithURLParser(up *URLParser) URLNormalizerOptionsFunc {
+	return func(n *URLNormalizer) {
+		n.up = up
+	}
+}
+
+// URLNormalizerWithSchemeRegistry sets the registry of the schemes, used for their default ports,
+// instead of schemes.DefaultRegistry.
+func URLNormalizerWithSchemeRegistry(registry *schemes.Registry) URLNormalizerOptionsFunc {
+	return func(n *URLNormalizer) {
+		n.schemes = registry
+	}
+}
+
+// removeDotSegments removes the "." and ".." segments of path, as per RFC 3986 section 5.2.4.
+func removeDotSegments(path string) (output string) {
+	if !strings.Contains(path, ".") {
+		return path
+	}
+
+	var segments []string
+
+	input := path
+
+	for input != "" {
+		switch {
+		case strings.HasPrefix(input, "../"):
+			input = input[3:]
+		case strings.HasPrefix(input, "./"):
+			input = input[2:]
+		case strings.HasPrefix(input, "/./"):
+			input = input[2:]
+		case input == "/.":
+			input = "/"
+		case strings.HasPrefix(input, "/../"):
+			input = input[3:]
+
+			if len(segments) > 0 {
+				segments = segments[:len(segments)-1]
+			}
+		case input == "/..":
+			input = "/"
+
+			if len(segments) > 0 {
+				segments = segments[:len(segments)-1]
+			}
+		case input == "." || input == "..":
+			input = ""
+		default:
+			// Move the first segment, with its leading "/" if any, to the output.
+			end := strings.IndexByte(input[1:], '/') + 1
+			if end == 0 {
+				end = len(input)
+			}
+
+			segments = append(segments, input[:end])
+
+			input = input[end:]
+		}
+	}
+
+	return strings.Join(segments, "")
+}
+
+// isUnreserved tells whether c is an unreserved character, as per RFC 3986 section 2.3.
+func isUnreserved(c byte) (ok bool) {
+	return 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || '0' <= c && c <= '9' ||
+		c == '-' || c == '.' || c == '_' || c == '~'
+}
+
+// unhex returns the value of the hexadecimal digit c.
+func unhex(c byte) (value byte) {
+	switch {
+	case '0' <= c && c <= '9':
+		value = c - '0'
+	case 'a' <= c && c <= 'f':
+		value = c - 'a' + 10
+	case 'A' <= c && c <= 'F':
+		value = c - 'A' + 10
+	}
+
+	return
+}
@@ -0,0 +1,83 @@
+package hqgourl_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hueristiq/hqgourl"
+)
+
+func TestURLNormalizer_NormalizeString(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		rawURL             string
+		flags              hqgourl.NormalizationFlags
+		expectedNormalized string
+	}{
+		// No normalization, the URL is reassembled as it is.
+		{"HTTP://Example.COM:80/a/./b/%7e?#top", 0, "http://Example.COM:80/a/./b/%7e?#top"},
+		{"file:///etc/hosts", 0, "file:///etc/hosts"},
+		{"mailto:user@example.com", 0, "mailto:user@example.com"},
+		// Individual steps.
+		{"http://Example.COM/", hqgourl.NormalizeLowercaseHost, "http://example.com/"},
+		{"http://[FE80::1%25En0]/", hqgourl.NormalizeLowercaseHost, "http://[fe80::1%25En0]/"},
+		{"http://example.com/a%3ab%7e", hqgourl.NormalizeUppercasePercentEncoding, "http://example.com/a%3Ab%7E"},
+		{"http://example.com/%7Euser/%41%3A?q=%2d", hqgourl.NormalizeDecodeUnreservedPercentEncoding, "http://example.com/~user/A%3A?q=-"},
+		{"http://example.com:80/", hqgourl.NormalizeRemoveDefaultPort, "http://example.com/"},
+		{"https://example.com:80/", hqgourl.NormalizeRemoveDefaultPort, "https://example.com:80/"},
+		{"http://example.com/a/./b/../c/", hqgourl.NormalizeRemoveDotSegments, "http://example.com/a/c/"},
+		{"http://example.com/../a/b/..", hqgourl.NormalizeRemoveDotSegments, "http://example.com/a/"},
+		{"http://example.com", hqgourl.NormalizeAddRootPath, "http://example.com/"},
+		{"http://example.com/?", hqgourl.NormalizeRemoveEmptyQuery, "http://example.com/"},
+		{"http://example.com/?#", hqgourl.NormalizeRemoveEmptyQuery, "http://example.com/"},
+		{"http://example.com/#top", hqgourl.NormalizeRemoveFragment, "http://example.com/"},
+		{"http://例子.公司.cn/", hqgourl.NormalizeHostToASCII, "http://xn--fsqu00a.xn--55qx5d.cn/"},
+		{"http://xn--fsqu00a.xn--55qx5d.cn/", hqgourl.NormalizeHostToUnicode, "http://例子.公司.cn/"},
+		{"http://example.com//a///b", hqgourl.NormalizeRemoveDuplicateSlashes, "http://example.com/a/b"},
+		{"http://example.com/?b=1&a=2&b=0", hqgourl.NormalizeSortQuery, "http://example.com/?a=2&b=1&b=0"},
+		// Presets.
+		{"HTTP://Example.COM:80/a/./b/%7e%3a?#top", hqgourl.NormalizationSafe, "http://example.com/a/./b/~%3A?#top"},
+		{"HTTP://Example.COM:80/a/./b/%7e%3a?#top", hqgourl.NormalizationUsuallySafe, "http://example.com/a/b/~%3A"},
+		{"http://Example.COM:80//a/../b?y=1&x=2#top", hqgourl.NormalizationUnsafe, "http://example.com/b?x=2&y=1"},
+	}
+
+	for _, c := range cases {
+		c := c
+
+		t.Run(fmt.Sprintf("NormalizeString(%q)", c.rawURL), func(t *testing.T) {
+			t.Parallel()
+
+			n := hqgourl.NewURLNormalizer(hqgourl.URLNormalizerWithFlags(c.flags))
+
+			normalized, err := n.NormalizeString(c.rawURL)
+			if err != nil {
+				t.Fatalf("NormalizeString(%q) error = %v", c.rawURL, err)
+			}
+
+			if normalized != c.expectedNormalized {
+				t.Errorf("NormalizeString(%q) = %q, want %q", c.rawURL, normalized, c.expectedNormalized)
+			}
+		})
+	}
+}
+
+func TestURLNormalizer_Normalize(t *testing.T) {
+	t.Parallel()
+
+	parsedURL, err := hqgourl.NewURLParser().Parse("HTTP://Example.COM:80/%7e")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	n := hqgourl.NewURLNormalizer()
+
+	if normalized := n.Normalize(parsedURL); normalized != "http://example.com/~" {
+		t.Errorf("Normalize() = %q, want %q", normalized, "http://example.com/~")
+	}
+
+	// The parsed URL is left as it is.
+	if parsedURL.Host != "Example.COM" || parsedURL.Port != 80 {
+		t.Errorf("Normalize() modified the URL to %+v", parsedURL)
+	}
+}
@@ -1,12 +1,15 @@
 package hqgourl
 
 import (
+	"errors"
 	"fmt"
+	"net/netip"
 	"net/url"
 	"path"
-	"regexp"
 	"strconv"
 	"strings"
+
+	"github.com/hueristiq/hqgourl/schemes"
 )
 
 // URL extends the standard net/url URL struct with additional domain-related fields.
@@ -15,18 +18,27 @@ import (
 type URL struct {
 	*url.URL // Embedding the standard URL struct for base functionalities.
 
-	Domain    *Domain
-	Port      int    // Port number used in the URL.
-	Extension string // File extension derived from the URL path.
+	Domain        *Domain    // Domain of the host, for HostKindDomain hosts only.
+	HostKind      HostKind   // Kind of the host: domain, IPv4 or IPv6 address, single label, invalid or none.
+	Addr          netip.Addr // Address of the host, with its zone if any, for HostKindIPv4 and HostKindIPv6 hosts only.
+	Port          int        // Port number used in the URL, 0 if implicit.
+	PortExplicit  bool       // Whether the URL specifies its port, e.g. "https://example.com:443" but not "https://example.com".
+	EffectivePort int        // Port of the URL: Port if explicit, the default port of its scheme otherwise, 0 if unknown.
+	Extension     string     // File extension derived from the URL path.
+	Parameters    Query      // Parameters of the query, in order and lossless, to be written back to RawQuery with String.
 }
 
 // URLParser encapsulates the logic for parsing URLs with additional domain-specific information.
 // It enhances the standard URL parsing with the extraction of subdomain, root domain, and TLD.
 // It also handles the addition of a default scheme if one is not present in the input URL.
+// A URLParser is safe for concurrent use by multiple goroutines, as long as WithDefaultScheme
+// is not called while parsing. The URLs it returns belong to the caller.
 type URLParser struct {
 	scheme string // DefaultScheme is the default URL scheme to use if not specified in the URL.
 
 	dp *DomainParser // DomainParser used for parsing the domain-specific details.
+
+	schemes *schemes.Registry // Registry of the schemes, for their default ports.
 }
 
 // WithDefaultScheme allows setting a default scheme for the URLParser.
@@ -41,44 +53,129 @@ func (up *URLParser) DefaultScheme() (scheme string) {
 }
 
 // Parse takes a raw URL string and parses it into a URL struct.
-// It adds domain-specific details like subdomain, root domain, and TLD to the parsed URL.
+// It adds domain-specific details like subdomain, root domain, and TLD to the parsed URL, and
+// tells the kind of its host, with the address of IP hosts.
 // The method also ensures a default scheme is set if the URL does not specify one.
+// URLs it cannot parse are reported with a *URLParseError, wrapping one of the ErrURL* sentinel
+// errors, or the error of net/url for problems no sentinel describes. On error, parsedURL is nil.
 func (up *URLParser) Parse(rawURL string) (parsedURL *URL, err error) {
-	parsedURL = &URL{}
+	input := rawURL
 
 	// Add default scheme if necessary
 	if up.scheme != "" {
 		rawURL = addScheme(rawURL, up.scheme)
 	}
 
+	// The default scheme is only ever prepended, offsets in the input are shifted by its length.
+	shift := len(rawURL) - len(input)
+
+	newError := func(component string, offset int, err error) *URLParseError {
+		if offset -= shift; offset < 0 {
+			offset = 0
+		}
+
+		return &URLParseError{URL: input, Component: component, Offset: offset, Err: err}
+	}
+
+	if component, offset, checkErr := checkURL(rawURL); checkErr != nil {
+		err = newError(component, offset, checkErr)
+
+		return
+	}
+
+	parsedURL = &URL{}
+
 	// Standard URL parsing
 	parsedURL.URL, err = url.Parse(rawURL)
 	if err != nil {
-		err = fmt.Errorf("error parsing URL: %w", err)
+		// Tell invalid hosts, e.g. with characters not allowed, from other problems.
+		if host, offset, ok := authorityHost(rawURL); ok {
+			if _, hostErr := url.Parse("//" + host); hostErr != nil {
+				err = newError(host, offset, ErrURLInvalidHost)
 
-		return
+				return nil, err
+			}
+		}
+
+		err = newError(input, shift, err)
+
+		return nil, err
 	}
 
 	// Split host and port, and handle errors
-	parsedURL.Host, parsedURL.Port, err = splitHostPort(parsedURL.Host)
+	address := parsedURL.Host
+
+	parsedURL.Host, parsedURL.Port, parsedURL.PortExplicit, err = splitHostPort(address)
 	if err != nil {
-		err = fmt.Errorf("error splitting host and port: %w", err)
+		component, offset := hostPortComponent(address, err)
 
-		return
+		err = newError(component, strings.Index(rawURL, address)+offset, err)
+
+		return nil, err
+	}
+
+	parsedURL.EffectivePort = parsedURL.Port
+
+	// An implicit port is the default port of the scheme, if known.
+	if scheme, ok := up.schemes.Lookup(parsedURL.Scheme); ok && !parsedURL.PortExplicit {
+		parsedURL.EffectivePort = scheme.DefaultPort
 	}
 
-	domainRegex := regexp.MustCompile(`(?i)(?:[a-z0-9](?:[a-z0-9-]{0,61}[a-z0-9])?\.)+[a-z]{2,}`)
+	parsedURL.HostKind, parsedURL.Addr = parseHost(parsedURL.Host)
 
-	if domainRegex.MatchString(parsedURL.Host) {
-		parsedURL.Domain = up.dp.Parse(parsedURL.Host)
+	// Only domains are split into subdomain, root domain and TLD.
+	if parsedURL.HostKind == HostKindDomain {
+		parsedURL.Domain, err = up.dp.Parse(parsedURL.Host)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	// Extract file extension from the path
 	parsedURL.Extension = path.Ext(parsedURL.Path)
 
+	// Parse the query, in order, unlike url.URL.Query
+	parsedURL.Parameters = ParseQuery(parsedURL.RawQuery)
+
 	return
 }
 
+// URLParseError is the error returned by URLParser.Parse for URLs it cannot parse. It records what
+// is wrong, as one of the ErrURL* sentinel errors, with the offending component of the URL and its
+// byte offset in the input.
+type URLParseError struct {
+	URL       string // URL being parsed, as input, i.e. without the default scheme.
+	Component string // Offending component, e.g. the port "99999" of "https://example.com:99999".
+	Offset    int    // Byte offset of Component in URL.
+	Err       error  // Sentinel error of what is wrong, or the error of net/url.
+}
+
+// Error returns a description of what is wrong, and where.
+func (e *URLParseError) Error() string {
+	return fmt.Sprintf("error parsing URL %q: %s: %q at offset %d", e.URL, e.Err, e.Component, e.Offset)
+}
+
+// Unwrap returns the sentinel error of what is wrong, for use with errors.Is.
+func (e *URLParseError) Unwrap() error {
+	return e.Err
+}
+
+var (
+	// ErrURLInvalidScheme is the error of a scheme that is empty or not a letter followed by
+	// letters, digits, "+", "-" and ".", e.g. "1http" in "1http://example.com".
+	ErrURLInvalidScheme = errors.New("invalid scheme")
+	// ErrURLInvalidHost is the error of a host with characters not allowed, e.g. "exa mple.com", or
+	// with colons outside of the brackets of an IPv6 address, e.g. "example.com:80:80".
+	ErrURLInvalidHost = errors.New("invalid host")
+	// ErrURLInvalidPort is the error of a port that is not a number, e.g. "abc" in "example.com:abc".
+	ErrURLInvalidPort = errors.New("invalid port")
+	// ErrURLPortOutOfRange is the error of a port above 65535, e.g. "99999" in "example.com:99999".
+	ErrURLPortOutOfRange = errors.New("port out of range")
+	// ErrURLInvalidPercentEncoding is the error of a "%" not followed by two hexadecimal digits,
+	// e.g. "%zz" in "/%zz". As in net/url, the query is not checked.
+	ErrURLInvalidPercentEncoding = errors.New("invalid percent-encoding")
+)
+
 // URLParserOptionsFunc defines a function type for configuring a URLParser.
 type URLParserOptionsFunc func(*URLParser)
 
@@ -95,8 +192,12 @@ var _ URLParserInterface = &URLParser{}
 
 // NewURLParser creates a new URLParser with the given options.
 // It initializes a DomainParser for parsing domain details and applies any additional configuration options.
+// The DomainParser shares the suffix index of all DomainParsers with the default options, so creating
+// URLParsers, e.g. one per request or per goroutine, is cheap.
 func NewURLParser(opts ...URLParserOptionsFunc) (up *URLParser) {
-	up = &URLParser{}
+	up = &URLParser{
+		schemes: schemes.DefaultRegistry,
+	}
 
 	// Initialize the DomainParser
 	dp := NewDomainParser()
@@ -118,6 +219,14 @@ func URLParserWithDefaultScheme(scheme string) URLParserOptionsFunc {
 	}
 }
 
+// URLParserWithSchemeRegistry returns a URLParserOptionsFunc to set the registry of the schemes,
+// used for their default ports, instead of schemes.DefaultRegistry.
+func URLParserWithSchemeRegistry(registry *schemes.Registry) URLParserOptionsFunc {
+	return func(up *URLParser) {
+		up.schemes = registry
+	}
+}
+
 // addScheme is a helper function that adds a scheme to the URL if it's missing.
 // This ensures that the URL is parsed correctly as a network address rather than a relative path.
 // This makes net/url.Parse() not put both host and path into the (relative) path.
@@ -138,27 +247,160 @@ func addScheme(inURL, scheme string) (outURL string) {
 
 // splitHostPort separates the host and port in a network address.
 // It is designed to handle both IPv4 and IPv6 addresses and gracefully manages URLs without a port.
-// Unlike net.SplitHostPort(), it doesn't remove brackets from [IPv6] hosts.
-func splitHostPort(address string) (host string, port int, err error) {
+// Unlike net.SplitHostPort(), it doesn't remove brackets from [IPv6] hosts. An empty port, as in
+// "example.com:", is allowed by RFC 3986 and is not explicit. Other ports must be numbers from 0 to
+// 65535, or the error is ErrURLInvalidPort or ErrURLPortOutOfRange. Hosts with colons, other than
+// bracketed IPv6 addresses, are ErrURLInvalidHost.
+func splitHostPort(address string) (host string, port int, explicit bool, err error) {
 	host = address
 
+	i := portIndex(address)
+	if i == -1 {
+		return
+	}
+
+	// Split the host and port
+	host, rawPort := address[:i], address[i+1:]
+
+	// Colons are only allowed in bracketed IPv6 hosts, e.g. not in "example.com:80:80".
+	if strings.Contains(host, ":") && !(strings.HasPrefix(host, "[") && strings.HasSuffix(host, "]")) {
+		return address, 0, false, ErrURLInvalidHost
+	}
+
+	if rawPort == "" {
+		return
+	}
+
+	for j := 0; j < len(rawPort); j++ {
+		if rawPort[j] < '0' || rawPort[j] > '9' {
+			return host, 0, false, ErrURLInvalidPort
+		}
+	}
+
+	if port, err = strconv.Atoi(rawPort); err != nil || port > 65535 {
+		return host, 0, false, ErrURLPortOutOfRange
+	}
+
+	return host, port, true, nil
+}
+
+// portIndex returns the index of the colon separating the host from the port in address, or -1
+// if it has no port.
+func portIndex(address string) (i int) {
 	// Check for the last colon, which should separate host and port
-	i := strings.LastIndex(address, ":")
+	i = strings.LastIndex(address, ":")
 	if i == -1 {
 		return
 	}
 
 	// Handle IPv6 addresses enclosed in brackets
 	if strings.HasPrefix(address, "[") && strings.Contains(address[i:], "]") {
-		return
+		return -1
 	}
 
-	// Split the host and port
-	host = address[:i]
+	return
+}
 
-	if port, err = strconv.Atoi(address[i+1:]); err != nil {
-		return
+// checkURL checks what net/url leaves unchecked, or reports with no position: the scheme, the
+// percent-encodings outside of the query, and the port. It returns the sentinel error of the first
+// problem found, with the offending component of rawURL and its offset.
+func checkURL(rawURL string) (component string, offset int, err error) {
+	// A scheme is whatever precedes the first colon, unless a path, query or fragment starts first.
+	if i := strings.IndexAny(rawURL, ":/?#"); i != -1 && rawURL[i] == ':' && !isScheme(rawURL[:i]) {
+		return rawURL[:i], 0, ErrURLInvalidScheme
+	}
+
+	query, fragment := len(rawURL), len(rawURL)
+
+	if i := strings.IndexByte(rawURL, '#'); i != -1 {
+		query, fragment = i, i
+	}
+
+	if i := strings.IndexByte(rawURL[:fragment], '?'); i != -1 {
+		query = i
+	}
+
+	for i := 0; i < len(rawURL); i++ {
+		if i == query {
+			i = fragment
+		}
+
+		if i < len(rawURL) && rawURL[i] == '%' && (i+2 >= len(rawURL) || !isHex(rawURL[i+1]) || !isHex(rawURL[i+2])) {
+			end := i + 3
+			if end > len(rawURL) {
+				end = len(rawURL)
+			}
+
+			return rawURL[i:end], i, ErrURLInvalidPercentEncoding
+		}
+	}
+
+	if host, hostOffset, ok := authorityHost(rawURL); ok {
+		if _, _, _, err = splitHostPort(host); err != nil {
+			component, offset = hostPortComponent(host, err)
+
+			return component, hostOffset + offset, err
+		}
 	}
 
 	return
 }
+
+// hostPortComponent returns the component of address, a host with its port, splitHostPort fails
+// on with err, and its offset in address: the whole address for an invalid host, else the port.
+func hostPortComponent(address string, err error) (component string, offset int) {
+	if errors.Is(err, ErrURLInvalidHost) {
+		return address, 0
+	}
+
+	i := portIndex(address)
+
+	return address[i+1:], i + 1
+}
+
+// authorityHost returns the host of rawURL, with its port, and its offset, if rawURL has an
+// authority, i.e. a "//" after its scheme, if any.
+func authorityHost(rawURL string) (host string, offset int, ok bool) {
+	if i := strings.IndexAny(rawURL, ":/?#"); i != -1 && rawURL[i] == ':' {
+		offset = i + 1
+	}
+
+	if !strings.HasPrefix(rawURL[offset:], "//") {
+		return
+	}
+
+	offset += 2
+
+	host = rawURL[offset:]
+
+	if i := strings.IndexAny(host, "/?#"); i != -1 {
+		host = host[:i]
+	}
+
+	// The host follows the user information, if any.
+	if i := strings.LastIndexByte(host, '@'); i != -1 {
+		host, offset = host[i+1:], offset+i+1
+	}
+
+	return host, offset, true
+}
+
+// isScheme tells whether scheme is a valid URL scheme: a letter followed by letters, digits, "+",
+// "-" and ".".
+func isScheme(scheme string) (ok bool) {
+	for i := 0; i < len(scheme); i++ {
+		switch c := scheme[i]; {
+		case 'a' <= c && c <= 'z', 'A' <= c && c <= 'Z':
+		case i > 0 && ('0' <= c && c <= '9' || c == '+' || c == '-' || c == '.'):
+		default:
+			return false
+		}
+	}
+
+	return scheme != ""
+}
+
+// isHex tells whether c is a hexadecimal digit.
+func isHex(c byte) (ok bool) {
+	return '0' <= c && c <= '9' || 'a' <= c && c <= 'f' || 'A' <= c && c <= 'F'
+}
@@ -1,12 +1,15 @@
 package hqgourl_test
 
 import (
+	"errors"
 	"fmt"
 	"net/url"
 	"reflect"
+	"sync"
 	"testing"
 
 	"github.com/hueristiq/hqgourl"
+	"github.com/hueristiq/hqgourl/schemes"
 )
 
 func TestNewURLParser(t *testing.T) {
@@ -51,10 +54,14 @@ func TestURLParser_Parse(t *testing.T) {
 					Host:   "example.com",
 				},
 				Domain: &hqgourl.Domain{
-					Sub:      "",
-					Root:     "example",
-					TopLevel: "com",
+					Sub:             "",
+					Root:            "example",
+					TopLevel:        "com",
+					TopLevelSection: hqgourl.TLDSectionICANN,
+					TopLevelKnown:   true,
 				},
+				HostKind:      hqgourl.HostKindDomain,
+				EffectivePort: 80,
 			},
 			false,
 		},
@@ -67,10 +74,14 @@ func TestURLParser_Parse(t *testing.T) {
 					Host:   "example.com",
 				},
 				Domain: &hqgourl.Domain{
-					Sub:      "",
-					Root:     "example",
-					TopLevel: "com",
+					Sub:             "",
+					Root:            "example",
+					TopLevel:        "com",
+					TopLevelSection: hqgourl.TLDSectionICANN,
+					TopLevelKnown:   true,
 				},
+				HostKind:      hqgourl.HostKindDomain,
+				EffectivePort: 80,
 			},
 			false,
 		},
@@ -84,11 +95,15 @@ func TestURLParser_Parse(t *testing.T) {
 					Path:   "/path/file.html",
 				},
 				Domain: &hqgourl.Domain{
-					Sub:      "",
-					Root:     "example",
-					TopLevel: "com",
+					Sub:             "",
+					Root:            "example",
+					TopLevel:        "com",
+					TopLevelSection: hqgourl.TLDSectionICANN,
+					TopLevelKnown:   true,
 				},
-				Extension: ".html",
+				HostKind:      hqgourl.HostKindDomain,
+				EffectivePort: 80,
+				Extension:     ".html",
 			},
 			false,
 		},
@@ -118,3 +133,199 @@ func TestURLParser_Parse(t *testing.T) {
 		})
 	}
 }
+
+func TestURLParser_ParseErrors(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		rawURL            string
+		defaultScheme     string
+		expectedErr       error
+		expectedComponent string
+		expectedOffset    int
+	}{
+		{"http://example.com:abc/", "", hqgourl.ErrURLInvalidPort, "abc", 19},
+		{"http://example.com:-1", "", hqgourl.ErrURLInvalidPort, "-1", 19},
+		{"http://[2001:db8::1]:8o", "", hqgourl.ErrURLInvalidPort, "8o", 21},
+		{"example.com:abc", "http", hqgourl.ErrURLInvalidPort, "abc", 12},
+		{"http://user@example.com:99999", "", hqgourl.ErrURLPortOutOfRange, "99999", 24},
+		{"http://example.com:99999999999999999999", "", hqgourl.ErrURLPortOutOfRange, "99999999999999999999", 19},
+		{"1http://example.com", "", hqgourl.ErrURLInvalidScheme, "1http", 0},
+		{"://example.com", "", hqgourl.ErrURLInvalidScheme, "", 0},
+		{"http://example.com/%zz", "", hqgourl.ErrURLInvalidPercentEncoding, "%zz", 19},
+		{"example.com/a%2", "https", hqgourl.ErrURLInvalidPercentEncoding, "%2", 13},
+		{"http://example.com/#%x", "", hqgourl.ErrURLInvalidPercentEncoding, "%x", 20},
+		{"http://exa mple.com/", "", hqgourl.ErrURLInvalidHost, "exa mple.com", 7},
+		{"http://example.com:80:80/", "", hqgourl.ErrURLInvalidHost, "example.com:80:80", 7},
+		{"http://user@example.com:80:80", "", hqgourl.ErrURLInvalidHost, "example.com:80:80", 12},
+		{"http://[2001:db8::1]:80:80/", "", hqgourl.ErrURLInvalidHost, "[2001:db8::1]:80:80", 7},
+		{"http://example.com:", "", nil, "", 0},
+		{"http://example.com:65535", "", nil, "", 0},
+		{"http://example.com/?q=%zz", "", nil, "", 0},
+	}
+
+	for _, c := range cases {
+		c := c
+
+		t.Run(fmt.Sprintf("Parse(%q)", c.rawURL), func(t *testing.T) {
+			t.Parallel()
+
+			parser := hqgourl.NewURLParser(
+				hqgourl.URLParserWithDefaultScheme(c.defaultScheme),
+			)
+
+			parsedURL, err := parser.Parse(c.rawURL)
+
+			if !errors.Is(err, c.expectedErr) {
+				t.Fatalf("Parse(%q) error = %v, want %v", c.rawURL, err, c.expectedErr)
+			}
+
+			if c.expectedErr == nil {
+				return
+			}
+
+			if parsedURL != nil {
+				t.Errorf("Parse(%q) = %+v, want nil", c.rawURL, parsedURL)
+			}
+
+			var parseErr *hqgourl.URLParseError
+
+			if !errors.As(err, &parseErr) {
+				t.Fatalf("Parse(%q) error = %T, want *hqgourl.URLParseError", c.rawURL, err)
+			}
+
+			if parseErr.URL != c.rawURL || parseErr.Component != c.expectedComponent || parseErr.Offset != c.expectedOffset {
+				t.Errorf("Parse(%q) error = %q at %d in %q, want %q at %d", c.rawURL, parseErr.Component, parseErr.Offset, parseErr.URL, c.expectedComponent, c.expectedOffset)
+			}
+		})
+	}
+}
+
+func TestURLParser_ParsePorts(t *testing.T) {
+	t.Parallel()
+
+	registry := schemes.NewRegistry(schemes.BuiltIn...)
+
+	registry.Register(schemes.Scheme{Name: "X-Custom", DefaultPort: 1234, Hierarchical: true, Authority: true})
+
+	cases := []struct {
+		rawURL                string
+		registry              *schemes.Registry
+		expectedPort          int
+		expectedPortExplicit  bool
+		expectedEffectivePort int
+	}{
+		{"https://example.com", nil, 0, false, 443},
+		{"https://example.com:443", nil, 443, true, 443},
+		{"https://example.com:", nil, 0, false, 443},
+		{"HTTPS://example.com", nil, 0, false, 443},
+		{"http://example.com:8080", nil, 8080, true, 8080},
+		{"wss://[2001:db8::1]/chat", nil, 0, false, 443},
+		{"ftp://example.com:0", nil, 0, true, 0},
+		{"x-custom://example.com", nil, 0, false, 0},
+		{"x-custom://example.com", registry, 0, false, 1234},
+		{"x-custom://example.com:99", registry, 99, true, 99},
+	}
+
+	for _, c := range cases {
+		c := c
+
+		t.Run(fmt.Sprintf("Parse(%q)", c.rawURL), func(t *testing.T) {
+			t.Parallel()
+
+			var opts []hqgourl.URLParserOptionsFunc
+
+			if c.registry != nil {
+				opts = append(opts, hqgourl.URLParserWithSchemeRegistry(c.registry))
+			}
+
+			parsedURL, err := hqgourl.NewURLParser(opts...).Parse(c.rawURL)
+			if err != nil {
+				t.Fatalf("Parse(%q) error = %v", c.rawURL, err)
+			}
+
+			if parsedURL.Port != c.expectedPort || parsedURL.PortExplicit != c.expectedPortExplicit || parsedURL.EffectivePort != c.expectedEffectivePort {
+				t.Errorf("Parse(%q) port = %d, %v, %d, want %d, %v, %d", c.rawURL,
+					parsedURL.Port, parsedURL.PortExplicit, parsedURL.EffectivePort,
+					c.expectedPort, c.expectedPortExplicit, c.expectedEffectivePort)
+			}
+		})
+	}
+}
+
+func TestSchemesLookup(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name           string
+		expectedScheme schemes.Scheme
+		expectedOK     bool
+	}{
+		{"https", schemes.Scheme{Name: "https", DefaultPort: 443, Hierarchical: true, Authority: true, Secure: true, Status: schemes.StatusPermanent}, true},
+		{"SSH", schemes.Scheme{Name: "ssh", DefaultPort: 22, Hierarchical: true, Authority: true, Secure: true, Status: schemes.StatusProvisional}, true},
+		{"mailto", schemes.Scheme{Name: "mailto", Status: schemes.StatusPermanent}, true},
+		{"bitcoin", schemes.Scheme{Name: "bitcoin"}, true},
+		{"machineProvisioningProgressReporter", schemes.Scheme{Name: "machineprovisioningprogressreporter"}, true},
+		{"x-custom", schemes.Scheme{}, false},
+	}
+
+	for _, c := range cases {
+		c := c
+
+		t.Run(fmt.Sprintf("Lookup(%q)", c.name), func(t *testing.T) {
+			t.Parallel()
+
+			scheme, ok := schemes.Lookup(c.name)
+
+			if scheme != c.expectedScheme || ok != c.expectedOK {
+				t.Errorf("Lookup(%q) = %+v, %v, want %+v, %v", c.name, scheme, ok, c.expectedScheme, c.expectedOK)
+			}
+		})
+	}
+}
+
+// TestURLParserConcurrency parses from many goroutines, with URLParsers created per goroutine and
+// with one shared URLParser. Run with -race.
+func TestURLParserConcurrency(t *testing.T) {
+	t.Parallel()
+
+	rawURLs := []string{
+		"https://www.example.com/index.html",
+		"http://blog.example.co.uk:8080/path",
+		"example.github.io/page.php?q=1",
+	}
+
+	shared := hqgourl.NewURLParser(hqgourl.URLParserWithDefaultScheme("http"))
+
+	expected := make([]*hqgourl.URL, len(rawURLs))
+
+	for i, rawURL := range rawURLs {
+		var err error
+
+		if expected[i], err = shared.Parse(rawURL); err != nil {
+			t.Fatalf("Parse(%q) error = %v", rawURL, err)
+		}
+	}
+
+	var wg sync.WaitGroup
+
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			own := hqgourl.NewURLParser(hqgourl.URLParserWithDefaultScheme("http"))
+
+			for i, rawURL := range rawURLs {
+				for _, up := range []*hqgourl.URLParser{own, shared} {
+					if parsedURL, err := up.Parse(rawURL); err != nil || !reflect.DeepEqual(parsedURL, expected[i]) {
+						t.Errorf("Parse(%q) = %+v, %v, want %+v", rawURL, parsedURL, err, expected[i])
+					}
+				}
+			}
+		}()
+	}
+
+	wg.Wait()
+}